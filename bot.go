@@ -18,88 +18,130 @@ import (
 
 var spaceString = string([]byte{0xc2, 0xad, 0xc2, 0xad})
 
-type emojiReactionBotCaches struct {
-	ReactionMessageIDFor map[string]int
-	MessageFor           map[string]*telegram.Message
-	ForwardedFor         map[string]*telegram.Message
-	Mu                   sync.RWMutex
-}
+const (
+	bucketReactionMessageID = "reaction-message-id"
+	bucketMessage           = "message"
+	bucketForwarded         = "forwarded"
+)
 
 type emojiReactionBot struct {
 	*telegram.Bot
-	*emojiReactionBotCaches
+	Store   Store
+	digests digests
+	// statsMu serializes the read-modify-write updates of the stats bucket.
+	statsMu sync.Mutex
+	// karmaMu serializes the karma votes, and the read-modify-write updates of the karma bucket.
+	karmaMu sync.Mutex
+	// pollMu serializes closing timed polls with the edits of their messages (see lockPoll).
+	pollMu sync.Mutex
 }
 
 func (bot *emojiReactionBot) init() {
-	bot.Handle(telegram.OnText, printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
-	bot.Handle(telegram.OnPhoto, printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
-	bot.Handle(telegram.OnAudio, printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
-	bot.Handle(telegram.OnDocument, printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
-	bot.Handle(telegram.OnSticker, printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
-	bot.Handle(telegram.OnVideo, printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
-	bot.Handle(telegram.OnVoice, printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
-	bot.Handle(telegram.OnVideoNote, printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
-	bot.Handle(telegram.OnContact, printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
-	bot.Handle(telegram.OnLocation, printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
-	bot.Handle(telegram.OnVenue, printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
-	bot.Handle(telegram.OnPinned, printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
-	bot.Handle(telegram.OnChannelPost, printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
+	bot.Handle(telegram.OnText, bot.printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
+	bot.Handle(telegram.OnPhoto, bot.printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
+	bot.Handle(telegram.OnAudio, bot.printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
+	bot.Handle(telegram.OnDocument, bot.printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
+	bot.Handle(telegram.OnSticker, bot.printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
+	bot.Handle(telegram.OnVideo, bot.printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
+	bot.Handle(telegram.OnVoice, bot.printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
+	bot.Handle(telegram.OnVideoNote, bot.printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
+	bot.Handle(telegram.OnContact, bot.printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
+	bot.Handle(telegram.OnLocation, bot.printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
+	bot.Handle(telegram.OnVenue, bot.printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
+	bot.Handle(telegram.OnPinned, bot.printAndHandleMessage(bot.addReactionsMessageOrAddReactionOrIgnore))
+	bot.Handle(telegram.OnChannelPost, bot.printAndHandleMessage(bot.handleChannelPost))
 	bot.Handle(telegram.OnCallback, bot.handleCallback)
-	bot.Handle(telegram.OnAddedToGroup, printAndHandleMessage(nil))
+	bot.Handle(telegram.OnQuery, bot.handleQuery)
+	bot.Handle(&telegram.InlineButton{Unique: emojirx.WhoButtonUnique}, bot.handleWho)
+	bot.Handle(telegram.OnAddedToGroup, bot.printAndHandleMessage(nil))
+	bot.Handle("/normalize", bot.printAndHandleMessage(bot.handleNormalize))
+	bot.Handle("/stats", bot.printAndHandleMessage(bot.handleStats))
+	bot.Handle("/settings", bot.printAndHandleMessage(bot.handleSettings))
+	bot.Handle("/allow", bot.printAndHandleMessage(bot.handleAllow))
+	bot.Handle("/block", bot.printAndHandleMessage(bot.handleBlock))
+	bot.Handle("/exclusive", bot.printAndHandleMessage(bot.handleExclusive))
+	bot.Handle("/start", bot.printAndHandleMessage(bot.handleStart))
+	bot.Handle("/notifications", bot.printAndHandleMessage(bot.handleNotifications))
+	bot.Handle("/rule", bot.printAndHandleMessage(bot.handleRule))
+	bot.Handle("/poll", bot.printAndHandleMessage(bot.handlePoll))
+	bot.Handle("/karma", bot.printAndHandleMessage(bot.handleKarma))
+	bot.Handle("/karmatop", bot.printAndHandleMessage(bot.handleKarmaTop))
+	bot.Handle(&telegram.InlineButton{Unique: settingsUnique}, bot.handleSettingsCallback)
 }
 
 func (bot *emojiReactionBot) MessageForRead(chatID int64, messageID int) (*telegram.Message, bool) {
-	k := globalMessageID(chatID, messageID)
-	bot.Mu.RLock()
-	defer bot.Mu.RUnlock()
-	m, ok := bot.MessageFor[k]
-	return m, ok
+	m := &telegram.Message{}
+	return m, bot.storeGet(bucketMessage, globalMessageID(chatID, messageID), m)
 }
 
 func (bot *emojiReactionBot) MessageForWrite(m *telegram.Message) {
-	k := globalMessageID(m.Chat.ID, m.ID)
-	bot.Mu.Lock()
-	defer bot.Mu.Unlock()
-	bot.MessageFor[k] = m
+	bot.storePut(bucketMessage, globalMessageID(m.Chat.ID, m.ID), reactionsMessageFields(m))
+}
+
+// reactionsMessageFields returns a copy of m with only the fields needed to edit it and to parse its reactions.
+func reactionsMessageFields(m *telegram.Message) *telegram.Message {
+	out := &telegram.Message{
+		ID:       m.ID,
+		Chat:     &telegram.Chat{ID: m.Chat.ID},
+		Entities: m.Entities,
+	}
+	for _, row := range m.ReplyMarkup.InlineKeyboard {
+		var outRow []telegram.InlineButton
+		for _, b := range row {
+			outRow = append(outRow, telegram.InlineButton{Text: b.Text, Data: b.Data})
+		}
+		out.ReplyMarkup.InlineKeyboard = append(out.ReplyMarkup.InlineKeyboard, outRow)
+	}
+	return out
 }
 
 func (bot *emojiReactionBot) NotificationForwardCacheRead(chatID int64, messageID int) (*telegram.Message, bool) {
-	k := globalMessageID(chatID, messageID)
-	bot.Mu.RLock()
-	defer bot.Mu.RUnlock()
-	m, ok := bot.ForwardedFor[k]
-	return m, ok
+	m := &telegram.Message{}
+	return m, bot.storeGet(bucketForwarded, globalMessageID(chatID, messageID), m)
 }
 
 func (bot *emojiReactionBot) NotificationForwardCacheWrite(chatID int64, messageID int, forwardedMessage *telegram.Message) {
-	k := globalMessageID(chatID, messageID)
-	bot.Mu.Lock()
-	defer bot.Mu.Unlock()
-	bot.ForwardedFor[k] = forwardedMessage
+	bot.storePut(bucketForwarded, globalMessageID(chatID, messageID), forwardedMessage)
 }
 
 func (bot *emojiReactionBot) ReactionMessageIDForRead(chatID int64, messageID int) (int, bool) {
-	k := globalMessageID(chatID, messageID)
-	bot.Mu.RLock()
-	defer bot.Mu.RUnlock()
-	m, ok := bot.ReactionMessageIDFor[k]
-	return m, ok
+	var id int
+	return id, bot.storeGet(bucketReactionMessageID, globalMessageID(chatID, messageID), &id)
 }
 
 func (bot *emojiReactionBot) ReactionMessageIDForWrite(chatID int64, messageID int, reactionMessageID int) {
-	k := globalMessageID(chatID, messageID)
-	bot.Mu.Lock()
-	defer bot.Mu.Unlock()
-	bot.ReactionMessageIDFor[k] = reactionMessageID
+	bot.storePut(bucketReactionMessageID, globalMessageID(chatID, messageID), reactionMessageID)
+}
+
+func (bot *emojiReactionBot) storeGet(bucket, key string, value interface{}) bool {
+	ok, err := bot.Store.Get(bucket, key, value)
+	if err != nil {
+		log.Printf("store: get %s %s: %v", bucket, key, err)
+		return false
+	}
+	return ok
+}
+
+func (bot *emojiReactionBot) storePut(bucket, key string, value interface{}) {
+	if err := bot.Store.Put(bucket, key, value); err != nil {
+		log.Printf("store: put %s %s: %v", bucket, key, err)
+	}
+}
+
+func (bot *emojiReactionBot) storeDelete(bucket, key string) {
+	if err := bot.Store.Delete(bucket, key); err != nil {
+		log.Printf("store: delete %s %s: %v", bucket, key, err)
+	}
 }
 
 func globalMessageID(chatID int64, messageID int) string {
 	return fmt.Sprintf("%x:%x", chatID, messageID)
 }
 
-func printAndHandleMessage(f func(*telegram.Message)) func(*telegram.Message) {
+func (bot *emojiReactionBot) printAndHandleMessage(f func(*telegram.Message)) func(*telegram.Message) {
 	return func(m *telegram.Message) {
 		jsonOut.Encode(m)
+		bot.UserWrite(m.Sender)
 		if f != nil {
 			f(m)
 		}
@@ -108,54 +150,245 @@ func printAndHandleMessage(f func(*telegram.Message)) func(*telegram.Message) {
 
 func (bot *emojiReactionBot) handleCallback(m *telegram.Callback) {
 	jsonOut.Encode(m)
-	reactions := newReactionSet()
-	if err := reactions.ParseMessage(m.Message); err != nil {
+	bot.UserWrite(m.Sender)
+	if m.Message == nil && m.MessageID == "" {
+		log.Printf("callback %v: no message", m.ID)
+		bot.respond(m, "Sorry, this message can no longer be reacted to.")
+		return
+	}
+	settings := bot.ChatSettings(callbackChatID(m))
+	reactions := bot.newReactionSet(settings)
+	shown, err := bot.parseReactions(m, reactions)
+	if err != nil {
 		log.Printf("callback %v: %v", m.ID, err)
 	}
-	reaction := &emojirx.Single{}
-	if err := reaction.ParseButtonData(m.Data); err != nil {
+	reaction, err := reactions.ParseButtonData(m.Data)
+	if err != nil {
 		log.Printf("callback %v: %v", m.ID, err)
+		bot.respond(m, "Sorry, this button is broken.")
+		return
+	}
+	if _, ok := reactions.Variant(m.Sender.ID, reaction.Emoji); !ok {
+		// adding a reaction: it must be one of the message's buttons (the data could be forged), and allowed
+		if !hasButton(reactions, reaction.Emoji) {
+			log.Printf("callback %v: no button for %q", m.ID, reaction.Emoji)
+			bot.respond(m, "Sorry, this button is broken.")
+			return
+		}
+		if !settings.allows(reaction.Emoji) {
+			bot.respond(m, rejectedToast([]string{reaction.Emoji}))
+			return
+		}
+	}
+	result := reactions.React(m.Sender.ID, []string{reaction.Emoji})
+	if len(result.Rejected) > 0 {
+		bot.respond(m, rejectedReasonToast(result.Rejected[0]))
+		return
 	}
-	added, _ := reactions.AddOrRemove(m.Sender.ID, []string{reaction.Emoji})
 	jsonOut.Encode(reactions)
-	edited, err := bot.Edit(m.Message, reactions.MessageText(), reactions.ReplyMarkup(fmt.Sprint(m.Message.ID), bot.handleCallback), telegram.ModeHTML)
+	edited, err := bot.editReactions(shown, reactions)
+	if err == errPollClosed {
+		bot.respond(m, rejectedReasonToast(emojirx.Rejected{Emoji: reaction.Emoji, Reason: emojirx.RejectedClosed}))
+		return
+	}
 	if err != nil {
 		log.Printf("callback %v: edit: %v", m.ID, err)
-	} else {
-		jsonOut.Encode(edited)
-		bot.MessageForWrite(edited)
+		bot.respond(m, "Sorry, your reaction could not be saved. Please try again.")
+		return
+	}
+	jsonOut.Encode(edited)
+	bot.respond(m, reactionToast(result))
+	if shown.InlineMessageID != "" {
+		// inline messages are in chats the bot may not be in: no stats, and nothing to forward in notifications
+		return
+	}
+	bot.recordReactions(reactions.To.ChatID, reactions.To.ID, m.Sender.ID, result)
+	if len(result.Added) > 0 {
+		bot.notifyOfReaction(reaction.Emoji, m.Sender, reactions.To.ID, reactions.To.ChatID, reactions.To.UserID)
+	}
+}
+
+// shownReactions is a message showing reactions, and where the state of the reactions is kept.
+type shownReactions struct {
+	// Message is nil for inline messages.
+	Message *telegram.Message
+	// InlineMessageID is set for inline messages (see handleQuery), whose state is kept in the store.
+	InlineMessageID string
+	// ChannelPost is set for channel posts with a reaction keyboard (see handleChannelPost),
+	// whose state is kept in the store.
+	ChannelPost bool
+}
+
+// callbackChatID returns the chat of the callback's message, or 0 for inline messages.
+func callbackChatID(c *telegram.Callback) int64 {
+	if c.Message == nil {
+		return 0
+	}
+	return c.Message.Chat.ID
+}
+
+// parseReactions parses the reactions shown by the message of a callback.
+func (bot *emojiReactionBot) parseReactions(c *telegram.Callback, reactions *emojirx.Set) (shownReactions, error) {
+	if c.Message == nil {
+		shown := shownReactions{InlineMessageID: c.MessageID}
+		return shown, bot.parseInlineReactions(c.MessageID, reactions)
 	}
-	if added > 0 {
-		bot.notifyOfReaction(reaction.Emoji, m.Sender, reactions.To.ID, reactions.To.ChatID, &telegram.User{ID: reactions.To.UserID})
+	shown := shownReactions{Message: c.Message}
+	err := reactions.ParseMessage(c.Message)
+	state, ok := bot.ChannelStateRead(c.Message.Chat.ID, c.Message.ID)
+	if !ok {
+		return shown, err
 	}
+	shown.ChannelPost = true
+	if stateErr := reactions.ParseState(state); stateErr != nil {
+		err = stateErr
+	}
+	return shown, err
 }
 
-func (bot *emojiReactionBot) addReactionsMessageTo(m *telegram.Message, reactions *emojirx.Set) {
+// editReactions updates the message, as parsed by parseReactions, to show the reactions.
+func (bot *emojiReactionBot) editReactions(shown shownReactions, reactions *emojirx.Set) (*telegram.Message, error) {
+	if shown.InlineMessageID != "" {
+		inlineMessage := &telegram.StoredMessage{MessageID: shown.InlineMessageID}
+		edited, err := bot.EditReplyMarkup(inlineMessage, reactions.ReplyMarkup(shown.InlineMessageID, bot.handleCallback))
+		if err != nil {
+			return nil, err
+		}
+		bot.InlineStateWrite(shown.InlineMessageID, reactions)
+		return edited, nil
+	}
+	m := shown.Message
+	if shown.ChannelPost {
+		edited, err := bot.EditReplyMarkup(m, reactions.ReplyMarkup(fmt.Sprint(m.ID), bot.handleCallback))
+		if err != nil {
+			return nil, err
+		}
+		bot.ChannelStateWrite(m.Chat.ID, m.ID, reactions)
+		return edited, nil
+	}
+	return bot.editReactionsMessage(m, reactions)
+}
+
+// editReactionsMessage edits one of the bot's reactions messages to show the reactions.
+// It fails with errPollClosed for a timed poll that closed since the message was parsed,
+// so that a reaction to the poll's earlier state does not reopen it.
+func (bot *emojiReactionBot) editReactionsMessage(m *telegram.Message, reactions *emojirx.Set) (*telegram.Message, error) {
+	closed, unlock := bot.lockPoll(m.Chat.ID, m.ID)
+	defer unlock()
+	if closed {
+		return nil, errPollClosed
+	}
+	edited, err := bot.Edit(m, reactions.MessageText(), reactions.ReplyMarkup(fmt.Sprint(m.ID), bot.handleCallback), telegram.ModeHTML)
+	if err != nil {
+		return nil, err
+	}
+	bot.MessageForWrite(edited)
+	bot.PollStateWrite(edited.Chat.ID, edited.ID, reactions)
+	return edited, nil
+}
+
+// respond answers a callback with a toast, which also stops the client's loading indicator.
+func (bot *emojiReactionBot) respond(c *telegram.Callback, text string) {
+	if err := bot.Respond(c, &telegram.CallbackResponse{Text: text}); err != nil {
+		log.Printf("callback %v: respond: %v", c.ID, err)
+	}
+}
+
+func reactionToast(result emojirx.Result) string {
+	switch {
+	case len(result.Added) > 0:
+		return fmt.Sprintf("You reacted %s", strings.Join(result.Added, ""))
+	case len(result.Removed) > 0:
+		return fmt.Sprintf("Removed your %s", strings.Join(result.Removed, ""))
+	}
+	return ""
+}
+
+func rejectedReasonToast(r emojirx.Rejected) string {
+	switch r.Reason {
+	case emojirx.RejectedUserLimit:
+		return fmt.Sprintf("Can't add %s: you have reached the limit of different emoji per user", r.Emoji)
+	case emojirx.RejectedMessageLimit:
+		return fmt.Sprintf("Can't add %s: this message has reached its limit of different emoji", r.Emoji)
+	case emojirx.RejectedFixed:
+		return fmt.Sprintf("Can't add %s: only the emoji on the buttons can be used here", r.Emoji)
+	case emojirx.RejectedClosed:
+		return "Sorry, this poll is closed."
+	case rejectedNotAllowed:
+		return rejectedToast([]string{r.Emoji})
+	}
+	return fmt.Sprintf("Can't add %s", r.Emoji)
+}
+
+// replyRejected tells the user about the reactions AddOrRemove rejected, for reactions sent as replies
+// (which can't be answered with a toast). It replies to the message they reacted to, since theirs is deleted.
+func (bot *emojiReactionBot) replyRejected(m *telegram.Message, result emojirx.Result) {
+	if len(result.Rejected) == 0 {
+		return
+	}
+	lines := []string{displayName(m.Sender) + ":"}
+	for _, r := range result.Rejected {
+		lines = append(lines, rejectedReasonToast(r))
+	}
+	reply, err := bot.Reply(m.ReplyTo, strings.Join(lines, "\n"), telegram.Silent)
+	if err != nil {
+		log.Printf("%v: reply: %v", m.ID, err)
+		return
+	}
+	jsonOut.Encode(reply)
+}
+
+// addReactionsMessageTo adds the reactions of m (a reply) to the message it replies to:
+// to its reactions message if it has one, or else to a new one.
+func (bot *emojiReactionBot) addReactionsMessageTo(m *telegram.Message, settings chatSettings, textEmoji []string) emojirx.Result {
 	if reactionsMessageID, ok := bot.ReactionMessageIDForRead(m.ReplyTo.Chat.ID, m.ReplyTo.ID); ok {
 		if reactionsMessage, ok := bot.MessageForRead(m.Chat.ID, reactionsMessageID); ok {
-			m.ReplyTo = reactionsMessage
-			bot.addReactionOrIgnore(m)
-			return
+			result, _ := bot.react(m, reactionsMessage, settings, textEmoji)
+			return result
 		}
 	}
+	reactions := bot.newReactionSet(settings)
+	textEmoji, notAllowed := allowedOrReacted(settings, reactions, m.Sender.ID, textEmoji)
+	result := reactions.React(m.Sender.ID, textEmoji)
+	result.Rejected = append(notAllowed, result.Rejected...)
+	if len(result.Added) == 0 {
+		return result
+	}
+	sender := m.Sender
 	m = m.ReplyTo
 	reactions.To = &emojirx.To{
 		UserID: m.Sender.ID,
 		ChatID: m.Chat.ID,
 		ID:     m.ID,
 	}
+	if _, err := bot.postReactionsMessage(m, reactions); err != nil {
+		log.Printf("add reaction post: %v", err)
+		return emojirx.Result{Rejected: result.Rejected}
+	}
+	for _, r := range reactions.Slice {
+		if variant, ok := reactions.Variant(sender.ID, r.Emoji); ok {
+			bot.recordReaction(m.Chat.ID, m.ID, sender.ID, variant, 1)
+		}
+	}
+	return result
+}
+
+// postReactionsMessage posts the reactions message for m, as a reply to m.
+func (bot *emojiReactionBot) postReactionsMessage(m *telegram.Message, reactions *emojirx.Set) (*telegram.Message, error) {
 	jsonOut.Encode(reactions)
 	reactionsMessage, err := bot.Reply(m, reactions.MessageText(), reactions.ReplyMarkup(fmt.Sprint(m.ID), bot.handleCallback), telegram.Silent, telegram.ModeHTML)
 	if err != nil {
-		log.Printf("add reaction post: %v", err)
-	} else {
-		jsonOut.Encode(reactionsMessage)
-		bot.ReactionMessageIDForWrite(m.Chat.ID, m.ID, reactionsMessage.ID)
-		bot.MessageForWrite(reactionsMessage)
+		return nil, err
 	}
+	jsonOut.Encode(reactionsMessage)
+	bot.ReactionMessageIDForWrite(m.Chat.ID, m.ID, reactionsMessage.ID)
+	bot.MessageForWrite(reactionsMessage)
+	return reactionsMessage, nil
 }
 
+// partitionEmoji splits s into its emoji, including those written as `:shortcode:`s, and the remaining text.
 func partitionEmoji(s string) ([]string, string) {
+	s = emoji.ResolveShortcodes(s)
 	noSpaceOrPunct := transform.Chain(
 		runes.Remove(runes.In(unicode.Space)),
 		runes.Remove(runes.In(unicode.Punct)),
@@ -171,32 +404,15 @@ func partitionEmoji(s string) ([]string, string) {
 	return add, textWithoutEmoji
 }
 
-func (bot *emojiReactionBot) notifyOfReaction(reaction string, reactingUser *telegram.User, reactionToMessageID int, reactionToChatID int64, recipient telegram.Recipient) {
-	who := fmt.Sprintf("%s %s", reactingUser.FirstName, reactingUser.LastName)
-	if reactingUser.Username != "" {
-		who = fmt.Sprintf("@%s", reactingUser.Username)
+func (bot *emojiReactionBot) notifyOfReaction(reaction string, reactingUser *telegram.User, reactionToMessageID int, reactionToChatID int64, recipientID int) {
+	if !bot.ChatSettings(reactionToChatID).Notify {
+		return
 	}
-	var forwardedMessage *telegram.Message
-	if m, ok := bot.NotificationForwardCacheRead(reactionToChatID, reactionToMessageID); ok {
-		forwardedMessage = m
-		jsonOut.Encode(forwardedMessage)
-	} else {
-		var err error
-		forwardedMessage, err = bot.Forward(recipient, &telegram.Message{ID: reactionToMessageID, Chat: &telegram.Chat{ID: reactionToChatID}}, telegram.Silent)
-		if err != nil {
-			log.Printf("notify: %v", err)
-		} else {
-			jsonOut.Encode(forwardedMessage)
-			bot.NotificationForwardCacheWrite(reactionToChatID, reactionToMessageID, forwardedMessage)
-		}
-	}
-	notification := fmt.Sprintf("%s %s reacted", reaction, who)
-	notificationMessage, err := bot.Reply(forwardedMessage, notification, telegram.Silent)
-	if err != nil {
-		log.Printf("notify: %v", err)
-	} else {
-		jsonOut.Encode(notificationMessage)
+	mode := bot.notifyMode(recipientID)
+	if mode == notifyOff {
+		return
 	}
+	bot.queueNotification(reaction, reactingUser.ID, reactionToChatID, reactionToMessageID, recipientID, notificationWindow(mode))
 }
 
 func isEmojiOnly(m *telegram.Message) bool {
@@ -210,13 +426,37 @@ func isEmojiOnly(m *telegram.Message) bool {
 	return true
 }
 
-func newReactionSet() *emojirx.Set {
+func (bot *emojiReactionBot) newReactionSet(chat chatSettings) *emojirx.Set {
 	out := &emojirx.Set{Previous: &emojirx.Previous{}}
-	out.Config.ButtonRowLength = config.ButtonRowLength
-	out.Config.ButtonRowMinLength = config.ButtonRowMinLength
+	out.Config.ButtonRowLength = chat.ButtonRowLength
+	out.Config.ButtonRowMinLength = chat.ButtonRowMinLength
+	out.Config.OverflowPolicy = config.OverflowPolicy
+	out.Config.Spill = storeSpill{bot.Store}
+	out.Config.OnOverflow = logOverflow
+	out.Config.WhoButton = chat.WhoButton
+	out.Config.MaxEmojiPerUser = chat.MaxEmojiPerUser
+	out.Config.MaxEmojiPerMessage = chat.MaxEmojiPerMessage
+	if chat.Exclusive {
+		out.Mode |= emojirx.ModeExclusive
+	}
+	if chat.Normalize {
+		out.Mode |= emojirx.ModeNormalize
+	}
 	return out
 }
 
+func logOverflow(o emojirx.Overflow) {
+	jsonOut.Encode(o)
+	switch {
+	case o.Ref != "":
+		log.Printf("overflow %x:%x: spilled state to %s", o.To.ChatID, o.To.ID, o.Ref)
+	case o.Err != "":
+		log.Printf("overflow %x:%x: spill: %s; dropped %d users (%s)", o.To.ChatID, o.To.ID, o.Err, len(o.UserIDs), emojirx.OverflowDropOldest)
+	default:
+		log.Printf("overflow %x:%x: dropped %d users (%s)", o.To.ChatID, o.To.ID, len(o.UserIDs), o.Policy)
+	}
+}
+
 func (bot *emojiReactionBot) addReactionOrIgnore(m *telegram.Message) {
 	textEmoji, textWithoutEmoji := partitionEmoji(m.Text)
 	switch {
@@ -228,62 +468,82 @@ func (bot *emojiReactionBot) addReactionOrIgnore(m *telegram.Message) {
 		return // ignore
 	}
 	defer bot.Delete(m)
-	reactions := newReactionSet()
-	reactionsMessage := m.ReplyTo
+	result, to := bot.react(m, m.ReplyTo, bot.ChatSettings(m.Chat.ID), textEmoji)
+	bot.replyRejected(m, result)
+	if len(result.Added) > 0 {
+		bot.notifyOfReaction(strings.Join(result.Added, ""), m.Sender, to.ID, to.ChatID, to.UserID)
+	}
+}
+
+// react applies the sender's reactions in m to a reactions message, and returns what it did and
+// the message the reactions are to. The caller tells the sender about rejected reactions and
+// notifies of added ones.
+func (bot *emojiReactionBot) react(m, reactionsMessage *telegram.Message, settings chatSettings, textEmoji []string) (emojirx.Result, *emojirx.To) {
+	reactions := bot.newReactionSet(settings)
 	if err := reactions.ParseMessage(reactionsMessage); err != nil {
 		log.Printf("%v: %v", m.ID, err)
 	}
-	added, _ := reactions.AddOrRemove(m.Sender.ID, textEmoji)
+	textEmoji, notAllowed := allowedOrReacted(settings, reactions, m.Sender.ID, textEmoji)
+	if len(textEmoji) == 0 {
+		return emojirx.Result{Rejected: notAllowed}, reactions.To
+	}
+	result := reactions.React(m.Sender.ID, textEmoji)
+	result.Rejected = append(notAllowed, result.Rejected...)
+	if len(result.Added) == 0 && len(result.Removed) == 0 {
+		return result, reactions.To
+	}
 	jsonOut.Encode(reactions)
-	edited, err := bot.Edit(reactionsMessage, reactions.MessageText(), reactions.ReplyMarkup(fmt.Sprint(m.ID), bot.handleCallback), telegram.ModeHTML)
+	edited, err := bot.editReactionsMessage(reactionsMessage, reactions)
+	if err == errPollClosed {
+		return emojirx.Result{Rejected: []emojirx.Rejected{{Emoji: strings.Join(textEmoji, ""), Reason: emojirx.RejectedClosed}}}, reactions.To
+	}
 	if err != nil {
 		log.Printf("edit: %v", err)
-	} else {
-		jsonOut.Encode(edited)
-		bot.MessageForWrite(edited)
-		if edited.ReplyTo != nil {
-			bot.ReactionMessageIDForWrite(edited.Chat.ID, edited.ReplyTo.ID, edited.ID)
-		}
+		return emojirx.Result{Rejected: result.Rejected}, reactions.To
 	}
-	if added > 0 {
-		bot.notifyOfReaction(strings.Join(textEmoji, ""), m.Sender, reactions.To.ID, reactions.To.ChatID, reactions.To)
+	jsonOut.Encode(edited)
+	if edited.ReplyTo != nil {
+		bot.ReactionMessageIDForWrite(edited.Chat.ID, edited.ReplyTo.ID, edited.ID)
+	}
+	bot.recordReactions(reactions.To.ChatID, reactions.To.ID, m.Sender.ID, result)
+	return result, reactions.To
+}
+
+// addReactionsMessageReply adds the reactions of m (see addReactionsMessageTo), tells the sender
+// about rejected ones and notifies the author of the replied-to message of the added ones.
+func (bot *emojiReactionBot) addReactionsMessageReply(m *telegram.Message, settings chatSettings, textEmoji []string) {
+	result := bot.addReactionsMessageTo(m, settings, textEmoji)
+	bot.replyRejected(m, result)
+	if len(result.Added) > 0 {
+		bot.notifyOfReaction(
+			strings.Join(result.Added, ""),
+			m.Sender,
+			m.ReplyTo.ID,
+			m.ReplyTo.Chat.ID,
+			m.ReplyTo.Sender.ID,
+		)
 	}
 }
 
 func (bot *emojiReactionBot) addReactionsMessageOrAddReactionOrIgnore(m *telegram.Message) {
 	switch {
-	case m.Sender != nil && m.Sender.ID == bot.Me.ID:
+	case m.Sender == nil || m.Sender.ID == bot.Me.ID:
 		// ignore
+	case m.IsReply() && m.ReplyTo.Sender == nil:
+		// ignore: a reply to a message without a sender, e.g. a channel post
 	case m.IsReply() && m.ReplyTo.Sender.ID == bot.Me.ID:
 		bot.addReactionOrIgnore(m)
+	case m.IsReply() && isKarmaVote(m):
+		delta, _ := karmaVote(m.Text)
+		bot.addKarma(m, delta)
 	case m.IsReply() && len(m.Text) == 1:
 		defer bot.Delete(m)
-		reactions := newReactionSet()
-		added, _ := reactions.AddOrRemove(m.Sender.ID, []string{m.Text})
-		bot.addReactionsMessageTo(m, reactions)
-		if added > 0 {
-			bot.notifyOfReaction(
-				m.Text,
-				m.Sender,
-				m.ReplyTo.ID,
-				m.ReplyTo.Chat.ID,
-				m.ReplyTo.Sender,
-			)
-		}
+		bot.addReactionsMessageReply(m, bot.ChatSettings(m.Chat.ID), []string{m.Text})
 	case m.IsReply() && isEmojiOnly(m):
 		defer bot.Delete(m)
 		textEmoji, _ := partitionEmoji(m.Text)
-		reactions := newReactionSet()
-		added, _ := reactions.AddOrRemove(m.Sender.ID, textEmoji)
-		bot.addReactionsMessageTo(m, reactions)
-		if added > 0 {
-			bot.notifyOfReaction(
-				m.Text,
-				m.Sender,
-				m.ReplyTo.ID,
-				m.ReplyTo.Chat.ID,
-				m.ReplyTo.Sender,
-			)
-		}
+		bot.addReactionsMessageReply(m, bot.ChatSettings(m.Chat.ID), textEmoji)
+	default:
+		bot.addReactionsMessageByRule(m)
 	}
 }
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+	emojirx "github.com/sgreben/telegram-emoji-reactions-bot/pkg/reactions"
+)
+
+const bucketChannel = "channel"
+
+// Channel modes: how the bot attaches a reaction keyboard to new channel posts.
+const (
+	// channelModeEdit adds the keyboard to the post itself. The post's text stays as it is,
+	// so the reactions' state is kept in the store (see ChannelStateRead).
+	channelModeEdit = "edit"
+	// channelModeCompanion posts a reactions message replying to the post.
+	channelModeCompanion = "companion"
+	channelModeOff       = "off"
+)
+
+func parseChannelMode(s string) (string, error) {
+	switch s {
+	case channelModeEdit, channelModeCompanion, channelModeOff:
+		return s, nil
+	}
+	return "", fmt.Errorf("unknown channel mode %q", s)
+}
+
+func parsePalette(s string) ([]string, error) {
+	palette, rest := partitionEmoji(s)
+	if rest != "" || len(palette) == 0 {
+		return nil, fmt.Errorf("palette %q: must be one or more emoji", s)
+	}
+	return uniqueStrings(palette), nil
+}
+
+// ChannelStateRead returns the reactions state of a channel post the bot added a keyboard to (see channelModeEdit).
+func (bot *emojiReactionBot) ChannelStateRead(chatID int64, messageID int) (string, bool) {
+	var state string
+	return state, bot.storeGet(bucketChannel, globalMessageID(chatID, messageID), &state)
+}
+
+func (bot *emojiReactionBot) ChannelStateWrite(chatID int64, messageID int, reactions *emojirx.Set) {
+	bot.storePut(bucketChannel, globalMessageID(chatID, messageID), reactions.State())
+}
+
+// handleChannelPost attaches a keyboard with the palette's emoji to a new channel post.
+// Nobody can reply to a channel post with emoji, so reactions only come from the buttons.
+func (bot *emojiReactionBot) handleChannelPost(m *telegram.Message) {
+	if config.ChannelMode == channelModeOff || len(m.ReplyMarkup.InlineKeyboard) > 0 {
+		return
+	}
+	settings := bot.ChatSettings(m.Chat.ID)
+	palette, _ := settings.filterAllowed(config.ChannelPalette)
+	if len(palette) == 0 {
+		return
+	}
+	reactions := bot.newReactionSet(settings)
+	reactions.Mode |= emojirx.ModeKeep
+	reactions.To = &emojirx.To{ChatID: m.Chat.ID, ID: m.ID}
+	reactions.AddButtons(palette)
+	switch config.ChannelMode {
+	case channelModeEdit:
+		jsonOut.Encode(reactions)
+		edited, err := bot.EditReplyMarkup(m, reactions.ReplyMarkup(fmt.Sprint(m.ID), bot.handleCallback))
+		if err != nil {
+			log.Printf("channel post %v: edit: %v", m.ID, err)
+			return
+		}
+		jsonOut.Encode(edited)
+		bot.ChannelStateWrite(m.Chat.ID, m.ID, reactions)
+	case channelModeCompanion:
+		if _, err := bot.postReactionsMessage(m, reactions); err != nil {
+			log.Printf("channel post %v: reply: %v", m.ID, err)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+)
+
+const bucketChat = "chat"
+
+// chatConfig holds a chat's settings. The zero value is the default for every chat;
+// nil fields fall back to the CLI flags (see chatSettings).
+type chatConfig struct {
+	// Normalize counts skin tone and gender variants of an emoji as the same reaction,
+	// on reactions messages posted after it was turned on (see emojirx.ModeNormalize).
+	Normalize          bool  `json:",omitempty"`
+	ButtonRowLength    *int  `json:",omitempty"`
+	ButtonRowMinLength *int  `json:",omitempty"`
+	WhoButton          *bool `json:",omitempty"`
+	// Notify sends reaction notifications to the authors of the chat's messages.
+	Notify *bool `json:",omitempty"`
+	// Exclusive lets each user hold at most one reaction on new reactions messages.
+	Exclusive bool `json:",omitempty"`
+	// MaxEmojiPerUser and MaxEmojiPerMessage limit the different emoji per user and per message (0: no limit).
+	MaxEmojiPerUser    int `json:",omitempty"`
+	MaxEmojiPerMessage int `json:",omitempty"`
+	// Allow, if not empty, lists the only emoji allowed as reactions. Block lists emoji that are not allowed.
+	Allow []string `json:",omitempty"`
+	Block []string `json:",omitempty"`
+	// Rules add reactions messages to new messages (see chatRule).
+	Rules []chatRule `json:",omitempty"`
+}
+
+// chatSettings is a chat's effective configuration: the CLI flags, overridden by its chatConfig.
+type chatSettings struct {
+	ButtonRowLength    int
+	ButtonRowMinLength int
+	Normalize          bool
+	WhoButton          bool
+	Notify             bool
+	Exclusive          bool
+	MaxEmojiPerUser    int
+	MaxEmojiPerMessage int
+	Allow              []string
+	Block              []string
+}
+
+func (c chatConfig) settings() chatSettings {
+	out := chatSettings{
+		ButtonRowLength:    config.ButtonRowLength,
+		ButtonRowMinLength: config.ButtonRowMinLength,
+		Normalize:          c.Normalize,
+		WhoButton:          config.WhoButton,
+		Notify:             true,
+		Exclusive:          c.Exclusive,
+		MaxEmojiPerUser:    c.MaxEmojiPerUser,
+		MaxEmojiPerMessage: c.MaxEmojiPerMessage,
+		Allow:              c.Allow,
+		Block:              c.Block,
+	}
+	if c.ButtonRowLength != nil {
+		out.ButtonRowLength = *c.ButtonRowLength
+	}
+	if c.ButtonRowMinLength != nil {
+		out.ButtonRowMinLength = *c.ButtonRowMinLength
+	}
+	if c.WhoButton != nil {
+		out.WhoButton = *c.WhoButton
+	}
+	if c.Notify != nil {
+		out.Notify = *c.Notify
+	}
+	return out
+}
+
+func (bot *emojiReactionBot) ChatSettings(chatID int64) chatSettings {
+	return bot.ChatConfigRead(chatID).settings()
+}
+
+func (bot *emojiReactionBot) ChatConfigRead(chatID int64) chatConfig {
+	var c chatConfig
+	bot.storeGet(bucketChat, chatKey(chatID), &c)
+	return c
+}
+
+func (bot *emojiReactionBot) ChatConfigWrite(chatID int64, c chatConfig) {
+	bot.storePut(bucketChat, chatKey(chatID), c)
+}
+
+func chatKey(chatID int64) string {
+	return fmt.Sprintf("%x", chatID)
+}
+
+// isAdmin reports whether the user may change the chat's settings.
+// Everyone may in private chats; in groups and channels only the chat's administrators may.
+func (bot *emojiReactionBot) isAdmin(chat *telegram.Chat, user *telegram.User) bool {
+	if chat.Type == telegram.ChatPrivate {
+		return true
+	}
+	if user == nil {
+		return false
+	}
+	admins, err := bot.AdminsOf(chat)
+	if err != nil {
+		log.Printf("admins of %x: %v", chat.ID, err)
+		return false
+	}
+	for _, admin := range admins {
+		if admin.User != nil && admin.User.ID == user.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// commandReply replies to a command message, logging errors.
+func (bot *emojiReactionBot) commandReply(m *telegram.Message, text string) {
+	reply, err := bot.Reply(m, text, telegram.Silent)
+	if err != nil {
+		log.Printf("%s: reply: %v", m.Text, err)
+		return
+	}
+	jsonOut.Encode(reply)
+}
+
+// handleNormalize handles `/normalize [on|off]`.
+func (bot *emojiReactionBot) handleNormalize(m *telegram.Message) {
+	c := bot.ChatConfigRead(m.Chat.ID)
+	switch arg := strings.TrimSpace(m.Payload); arg {
+	case "":
+	case "on", "off":
+		if !bot.isAdmin(m.Chat, m.Sender) {
+			bot.commandReply(m, "Only chat admins can change this.")
+			return
+		}
+		c.Normalize = arg == "on"
+		bot.ChatConfigWrite(m.Chat.ID, c)
+	default:
+		bot.commandReply(m, "Usage: /normalize on|off")
+		return
+	}
+	if c.Normalize {
+		bot.commandReply(m, "Skin tone and gender variants count as the same reaction on new messages (👍🏽 counts as 👍).")
+	} else {
+		bot.commandReply(m, "Skin tone and gender variants count as separate reactions on new messages.")
+	}
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+)
+
+const bucketDigest = "digest"
+
+// digestEditMaxAge is how long a sent digest is edited to include new reactions, instead of sending a new one.
+const digestEditMaxAge = time.Hour
+
+// digestMaxNames is the number of reactors a digest names before it says "and N others".
+const digestMaxNames = 3
+
+// digest sums up the reactions to one message, for one recipient.
+type digest struct {
+	// Emoji lists the emoji in the order they were first added.
+	Emoji    []string       `json:"e"`
+	Counts   map[string]int `json:"c"`
+	Reactors []int          `json:"u"`
+	// NotificationID and Sent are the ID and time of the notification message showing the digest, once sent.
+	NotificationID int       `json:"n,omitempty"`
+	Sent           time.Time `json:"t,omitempty"`
+}
+
+func (d *digest) add(reaction string, reactorID int) {
+	for _, e := range splitReaction(reaction) {
+		if d.Counts == nil {
+			d.Counts = make(map[string]int)
+		}
+		if d.Counts[e] == 0 {
+			d.Emoji = append(d.Emoji, e)
+		}
+		d.Counts[e]++
+	}
+	for _, id := range d.Reactors {
+		if id == reactorID {
+			return
+		}
+	}
+	d.Reactors = append(d.Reactors, reactorID)
+}
+
+func (d *digest) merge(o digest) {
+	for _, e := range o.Emoji {
+		if d.Counts == nil {
+			d.Counts = make(map[string]int)
+		}
+		if d.Counts[e] == 0 {
+			d.Emoji = append(d.Emoji, e)
+		}
+		d.Counts[e] += o.Counts[e]
+	}
+	for _, id := range o.Reactors {
+		d.add("", id)
+	}
+}
+
+// splitReaction splits the joined emoji of a notification (see notifyOfReaction) into single emoji.
+func splitReaction(reaction string) []string {
+	if reaction == "" {
+		return nil
+	}
+	if emoji, rest := partitionEmoji(reaction); len(emoji) > 0 && rest == "" {
+		return emoji
+	}
+	return []string{reaction}
+}
+
+// text formats the digest as e.g. "👍×5 🔥×2 from @a, @b and 3 others".
+func (d *digest) text(name func(int) string) string {
+	var counts []string
+	for _, e := range d.Emoji {
+		if n := d.Counts[e]; n > 1 {
+			counts = append(counts, fmt.Sprintf("%s×%d", e, n))
+		} else {
+			counts = append(counts, e)
+		}
+	}
+	var names []string
+	for _, id := range d.Reactors {
+		names = append(names, name(id))
+	}
+	if len(names) > digestMaxNames {
+		others := len(names) - (digestMaxNames - 1)
+		names = append(names[:digestMaxNames-1], fmt.Sprintf("%d others", others))
+	}
+	who := strings.Join(names, ", ")
+	if len(names) > 1 {
+		who = strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+	}
+	return fmt.Sprintf("%s from %s", strings.Join(counts, " "), who)
+}
+
+// digests collects the reactions to be sent as notifications, per recipient and message.
+type digests struct {
+	mu      sync.Mutex
+	pending map[string]*digest
+}
+
+func digestKey(recipientID int, chatID int64, messageID int) string {
+	return fmt.Sprintf("%x:%x:%x", recipientID, chatID, messageID)
+}
+
+// notificationWindow returns how long reactions are collected before a notification is sent to a user.
+func notificationWindow(mode string) time.Duration {
+	if mode == notifyDigest {
+		return config.DigestWindow
+	}
+	return config.NotifyWindow
+}
+
+// queueNotification adds a reaction to the recipient's pending digest for the message,
+// which is sent when the recipient's notification window has passed.
+func (bot *emojiReactionBot) queueNotification(reaction string, reactorID int, chatID int64, messageID int, recipientID int, window time.Duration) {
+	key := digestKey(recipientID, chatID, messageID)
+	bot.digests.mu.Lock()
+	defer bot.digests.mu.Unlock()
+	if bot.digests.pending == nil {
+		bot.digests.pending = make(map[string]*digest)
+	}
+	d, ok := bot.digests.pending[key]
+	if !ok {
+		d = &digest{}
+		bot.digests.pending[key] = d
+		flush := func() { bot.flushDigest(key, chatID, messageID, recipientID) }
+		if window > 0 {
+			time.AfterFunc(window, flush)
+		} else {
+			defer func() { go flush() }()
+		}
+	}
+	d.add(reaction, reactorID)
+}
+
+func (bot *emojiReactionBot) flushDigest(key string, chatID int64, messageID int, recipientID int) {
+	bot.digests.mu.Lock()
+	d := bot.digests.pending[key]
+	delete(bot.digests.pending, key)
+	bot.digests.mu.Unlock()
+	if d == nil {
+		return
+	}
+	var sent digest
+	if bot.storeGet(bucketDigest, key, &sent) && sent.NotificationID != 0 && time.Since(sent.Sent) < digestEditMaxAge {
+		sent.merge(*d)
+		notification := &telegram.StoredMessage{MessageID: strconv.Itoa(sent.NotificationID), ChatID: int64(recipientID)}
+		edited, err := bot.Edit(notification, sent.text(bot.UserName))
+		if err == nil {
+			jsonOut.Encode(edited)
+			bot.storePut(bucketDigest, key, sent)
+			bot.notificationSent(recipientID)
+			return
+		}
+		log.Printf("notify: edit digest: %v", err)
+	}
+	notificationMessage, err := bot.sendNotification(d.text(bot.UserName), chatID, messageID, recipientID)
+	if err != nil {
+		log.Printf("notify: %v", err)
+		bot.notificationFailed(recipientID, err)
+		return
+	}
+	jsonOut.Encode(notificationMessage)
+	bot.notificationSent(recipientID)
+	d.NotificationID = notificationMessage.ID
+	d.Sent = time.Now()
+	bot.storePut(bucketDigest, key, d)
+}
+
+// sendNotification forwards the message reacted to to the recipient (once), and replies to the forwarded message with the text.
+func (bot *emojiReactionBot) sendNotification(text string, reactionToChatID int64, reactionToMessageID int, recipientID int) (*telegram.Message, error) {
+	forwardedMessage, ok := bot.NotificationForwardCacheRead(reactionToChatID, reactionToMessageID)
+	if ok {
+		jsonOut.Encode(forwardedMessage)
+	} else {
+		var err error
+		forwardedMessage, err = bot.Forward(&telegram.User{ID: recipientID}, &telegram.Message{ID: reactionToMessageID, Chat: &telegram.Chat{ID: reactionToChatID}}, telegram.Silent)
+		if err != nil {
+			return nil, err
+		}
+		jsonOut.Encode(notificationForward{
+			ChatID:    reactionToChatID,
+			MessageID: reactionToMessageID,
+			Forwarded: forwardedMessage,
+		})
+		bot.NotificationForwardCacheWrite(reactionToChatID, reactionToMessageID, forwardedMessage)
+	}
+	return bot.Reply(forwardedMessage, text, telegram.Silent)
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDigestText(t *testing.T) {
+	name := func(id int) string { return fmt.Sprintf("@u%d", id) }
+	type reaction struct {
+		emoji     string
+		reactorID int
+	}
+	tests := []struct {
+		reactions []reaction
+		want      string
+	}{
+		{[]reaction{{"👍", 1}}, "👍 from @u1"},
+		{[]reaction{{"👍", 1}, {"👍🔥", 2}}, "👍×2 🔥 from @u1 and @u2"},
+		{[]reaction{{"👍", 1}, {"👍", 1}}, "👍×2 from @u1"},
+		{[]reaction{{"👍", 1}, {"🔥", 2}, {"🔥", 3}}, "👍 🔥×2 from @u1, @u2 and @u3"},
+		{[]reaction{{"👍", 1}, {"👍", 2}, {"👍", 3}, {"👍", 4}, {"👍", 5}}, "👍×5 from @u1, @u2 and 3 others"},
+	}
+	for _, tt := range tests {
+		var d digest
+		for _, r := range tt.reactions {
+			d.add(r.emoji, r.reactorID)
+		}
+		if got := d.text(name); got != tt.want {
+			t.Errorf("%v: text = %q, want %q", tt.reactions, got, tt.want)
+		}
+	}
+}
+
+func TestDigestMerge(t *testing.T) {
+	var d, o digest
+	d.add("👍", 1)
+	d.add("🔥", 2)
+	o.add("🎉", 2)
+	o.add("👍", 3)
+	d.merge(o)
+	want := digest{
+		Emoji:    []string{"👍", "🔥", "🎉"},
+		Counts:   map[string]int{"👍": 2, "🔥": 1, "🎉": 1},
+		Reactors: []int{1, 2, 3},
+	}
+	if !reflect.DeepEqual(d, want) {
+		t.Errorf("merged digest = %+v, want %+v", d, want)
+	}
+}
+
+func TestFlushDigest(t *testing.T) {
+	bot, f := newFakeTelegramBot(t)
+	defer f.Close()
+	const chatID, messageID, recipientID = -100, 10, 5
+	key := digestKey(recipientID, chatID, messageID)
+	flush := func(reaction string, reactorID int) digest {
+		bot.queueNotification(reaction, reactorID, chatID, messageID, recipientID, time.Hour)
+		bot.flushDigest(key, chatID, messageID, recipientID)
+		var sent digest
+		bot.storeGet(bucketDigest, key, &sent)
+		return sent
+	}
+
+	first := flush("👍", 1)
+	if first.NotificationID == 0 || len(f.callsTo("sendMessage")) != 1 {
+		t.Fatalf("first digest %+v, want it sent", first)
+	}
+	// within digestEditMaxAge, the sent digest is edited to include the new reactions
+	second := flush("🔥", 2)
+	edits := f.callsTo("editMessageText")
+	if len(edits) != 1 || len(f.callsTo("sendMessage")) != 1 {
+		t.Fatalf("second digest sent %d times and edited %d times, want it edited once", len(f.callsTo("sendMessage"))-1, len(edits))
+	}
+	if second.NotificationID != first.NotificationID || !reflect.DeepEqual(second.Emoji, []string{"👍", "🔥"}) {
+		t.Errorf("edited digest %+v, want 👍 and 🔥 in notification %d", second, first.NotificationID)
+	}
+	if text := edits[0].Params["text"]; text != second.text(bot.UserName) {
+		t.Errorf("edited text %q, want %q", text, second.text(bot.UserName))
+	}
+
+	// after it, a new digest is sent
+	second.Sent = time.Now().Add(-digestEditMaxAge)
+	bot.storePut(bucketDigest, key, second)
+	third := flush("🎉", 3)
+	if len(f.callsTo("sendMessage")) != 2 || third.NotificationID == first.NotificationID {
+		t.Errorf("third digest %+v, want it sent as a new notification", third)
+	}
+	if !reflect.DeepEqual(third.Emoji, []string{"🎉"}) {
+		t.Errorf("third digest has %v, want only 🎉", third.Emoji)
+	}
+}
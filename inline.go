@@ -0,0 +1,69 @@
+package main
+
+import (
+	"log"
+	"strings"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+	emojirx "github.com/sgreben/telegram-emoji-reactions-bot/pkg/reactions"
+)
+
+const bucketInline = "inline"
+
+// inlineState is the state of an inline message's reactions. The bot never sees inline messages,
+// only the callbacks of their buttons, so it keeps their buttons as well.
+type inlineState struct {
+	Buttons []emojirx.Single `json:"b"`
+	State   string           `json:"s"`
+}
+
+func (bot *emojiReactionBot) InlineStateRead(inlineMessageID string) (inlineState, bool) {
+	var s inlineState
+	return s, bot.storeGet(bucketInline, inlineMessageID, &s)
+}
+
+func (bot *emojiReactionBot) InlineStateWrite(inlineMessageID string, reactions *emojirx.Set) {
+	bot.storePut(bucketInline, inlineMessageID, inlineState{Buttons: reactions.Slice, State: reactions.State()})
+}
+
+// parseInlineReactions parses the reactions of an inline message.
+// Until someone first reacts, these are the buttons of the inline palette, as posted by handleQuery.
+func (bot *emojiReactionBot) parseInlineReactions(inlineMessageID string, reactions *emojirx.Set) error {
+	reactions.To = &emojirx.To{}
+	reactions.Previous = &emojirx.Previous{}
+	s, ok := bot.InlineStateRead(inlineMessageID)
+	if !ok {
+		reactions.Mode |= emojirx.ModeKeep
+		reactions.AddButtons(config.InlinePalette)
+		return nil
+	}
+	reactions.Slice = s.Buttons
+	return reactions.ParseState(s.State)
+}
+
+// handleQuery answers an inline query (`@bot some text`, which needs inline mode to be enabled
+// for the bot with @BotFather) with a message of the query's text and a keyboard of the inline palette.
+func (bot *emojiReactionBot) handleQuery(q *telegram.Query) {
+	jsonOut.Encode(q)
+	bot.UserWrite(&q.From)
+	response := &telegram.QueryResponse{}
+	if text := strings.TrimSpace(q.Text); text != "" {
+		reactions := bot.newReactionSet(bot.ChatSettings(0))
+		reactions.Mode |= emojirx.ModeKeep
+		reactions.To = &emojirx.To{}
+		reactions.AddButtons(config.InlinePalette)
+		result := &telegram.ArticleResult{
+			Title:       "Post with reactions",
+			Description: text,
+			Text:        text,
+		}
+		result.ID = "reactions"
+		result.ReplyMarkup = &telegram.InlineKeyboardMarkup{
+			InlineKeyboard: reactions.ReplyMarkup(q.ID, bot.handleCallback).InlineKeyboard,
+		}
+		response.Results = telegram.Results{result}
+	}
+	if err := bot.Answer(q, response); err != nil {
+		log.Printf("query %v: answer: %v", q.ID, err)
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+	emojirx "github.com/sgreben/telegram-emoji-reactions-bot/pkg/reactions"
+)
+
+// inlineResult is the part of an inline query result the tests look at.
+type inlineResult struct {
+	Text   string `json:"message_text"`
+	Markup struct {
+		Keyboard [][]struct {
+			Text string `json:"text"`
+		} `json:"inline_keyboard"`
+	} `json:"reply_markup"`
+}
+
+func TestHandleQuery(t *testing.T) {
+	defer func(palette []string) { config.InlinePalette = palette }(config.InlinePalette)
+	config.InlinePalette = []string{"👍", "🔥", "😂"}
+	bot, f := newFakeTelegramBot(t)
+	defer f.Close()
+	tests := []struct {
+		query      string
+		wantText   string
+		wantResult bool
+	}{
+		{"", "", false},
+		{"   ", "", false},
+		{"lunch at noon?", "lunch at noon?", true},
+		// emoji in the query are part of the text, not reactions
+		{" 🍕 or 🍣? ", "🍕 or 🍣?", true},
+	}
+	for i, tt := range tests {
+		bot.handleQuery(&telegram.Query{ID: "q", From: telegram.User{ID: 2}, Text: tt.query})
+		answers := f.callsTo("answerInlineQuery")
+		if len(answers) != i+1 {
+			t.Fatalf("%q: answered %d queries, want %d", tt.query, len(answers), i+1)
+		}
+		var results []inlineResult
+		json.Unmarshal([]byte(answers[i].Params["results"]), &results)
+		if !tt.wantResult {
+			if len(results) > 0 {
+				t.Errorf("%q: results %+v, want none", tt.query, results)
+			}
+			continue
+		}
+		if len(results) != 1 || results[0].Text != tt.wantText {
+			t.Errorf("%q: results %+v, want one with text %q", tt.query, results, tt.wantText)
+			continue
+		}
+		var labels []string
+		for _, row := range results[0].Markup.Keyboard {
+			for _, b := range row {
+				if b.Text != emojirx.WhoButtonLabel {
+					labels = append(labels, b.Text)
+				}
+			}
+		}
+		if !reflect.DeepEqual(labels, config.InlinePalette) {
+			t.Errorf("%q: buttons %v, want the inline palette %v", tt.query, labels, config.InlinePalette)
+		}
+	}
+}
+
+func TestParseInlineReactions(t *testing.T) {
+	defer func(palette []string) { config.InlinePalette = palette }(config.InlinePalette)
+	config.InlinePalette = []string{"👍", "🔥", "😂"}
+	bot := &emojiReactionBot{Store: newMemoryStore()}
+	bot.ChatConfigWrite(0, chatConfig{MaxEmojiPerUser: 1})
+	const id = "inline"
+	parse := func() *emojirx.Set {
+		reactions := bot.newReactionSet(bot.ChatSettings(0))
+		if err := bot.parseInlineReactions(id, reactions); err != nil {
+			t.Fatal(err)
+		}
+		return reactions
+	}
+
+	// before the first reaction, the message has the palette
+	reactions := parse()
+	if reactions.Mode&emojirx.ModeKeep == 0 || len(reactions.Slice) != len(config.InlinePalette) {
+		t.Fatalf("new inline message parsed to %+v in mode %v, want the palette in ModeKeep", reactions.Slice, reactions.Mode)
+	}
+	if result := reactions.React(2, []string{"🔥"}); !reflect.DeepEqual(result.Added, []string{"🔥"}) {
+		t.Fatalf("React = %+v, want 🔥 added", result)
+	}
+	bot.InlineStateWrite(id, reactions)
+
+	reactions = parse()
+	if got := reactions.Previous.Get(2, "🔥"); got != 1 || len(reactions.Slice) != len(config.InlinePalette) {
+		t.Fatalf("inline message parsed to %+v, want the palette and user 2's 🔥", reactions.Slice)
+	}
+	// the parsed state keeps counting against the limits of the inline settings
+	want := emojirx.Result{Rejected: []emojirx.Rejected{{Emoji: "😂", Reason: emojirx.RejectedUserLimit}}}
+	if result := reactions.React(2, []string{"😂"}); !reflect.DeepEqual(result, want) {
+		t.Errorf("React over the limit = %+v, want %+v", result, want)
+	}
+	if result := reactions.React(3, []string{"😂"}); !reflect.DeepEqual(result.Added, []string{"😂"}) {
+		t.Errorf("React = %+v, want 😂 added", result)
+	}
+}
@@ -1,13884 +1,34047 @@
+// Code generated by gen.go from emoji-test.txt; DO NOT EDIT.
+
 package emoji
 
+// UnicodeVersion is the version of the Unicode emoji data in Emojis.
+const UnicodeVersion = "15.1"
+
 var Emojis = map[string]Emoji{
 	"1F004": Emoji{
 		Key:        "1F004",
 		Value:      "🀄",
 		Descriptor: "Mahjong Red Dragon",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "0.6",
 	},
 	"1F0CF": Emoji{
 		Key:        "1F0CF",
 		Value:      "🃏",
 		Descriptor: "Joker",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "0.6",
 	},
 	"1F170-FE0F": Emoji{
 		Key:        "1F170-FE0F",
 		Value:      "🅰️",
 		Descriptor: "A Button (Blood Type)",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F171-FE0F": Emoji{
 		Key:        "1F171-FE0F",
 		Value:      "🅱️",
 		Descriptor: "B Button (Blood Type)",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F17E-FE0F": Emoji{
 		Key:        "1F17E-FE0F",
 		Value:      "🅾️",
 		Descriptor: "O Button (Blood Type)",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F17F-FE0F": Emoji{
 		Key:        "1F17F-FE0F",
 		Value:      "🅿️",
 		Descriptor: "P Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F18E": Emoji{
 		Key:        "1F18E",
 		Value:      "🆎",
 		Descriptor: "AB Button (Blood Type)",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F191": Emoji{
 		Key:        "1F191",
 		Value:      "🆑",
 		Descriptor: "CL Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F192": Emoji{
 		Key:        "1F192",
 		Value:      "🆒",
-		Descriptor: "Cool Button",
+		Descriptor: "COOL Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F193": Emoji{
 		Key:        "1F193",
 		Value:      "🆓",
-		Descriptor: "Free Button",
+		Descriptor: "FREE Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F194": Emoji{
 		Key:        "1F194",
 		Value:      "🆔",
 		Descriptor: "ID Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F195": Emoji{
 		Key:        "1F195",
 		Value:      "🆕",
-		Descriptor: "New Button",
+		Descriptor: "NEW Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F196": Emoji{
 		Key:        "1F196",
 		Value:      "🆖",
 		Descriptor: "NG Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F197": Emoji{
 		Key:        "1F197",
 		Value:      "🆗",
 		Descriptor: "OK Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F198": Emoji{
 		Key:        "1F198",
 		Value:      "🆘",
 		Descriptor: "SOS Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F199": Emoji{
 		Key:        "1F199",
 		Value:      "🆙",
-		Descriptor: "Up! Button",
+		Descriptor: "UP! Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F19A": Emoji{
 		Key:        "1F19A",
 		Value:      "🆚",
-		Descriptor: "Vs Button",
+		Descriptor: "VS Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F1E6-1F1E8": Emoji{
 		Key:        "1F1E6-1F1E8",
 		Value:      "🇦🇨",
 		Descriptor: "Flag: Ascension Island",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E6-1F1E9": Emoji{
 		Key:        "1F1E6-1F1E9",
 		Value:      "🇦🇩",
 		Descriptor: "Flag: Andorra",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E6-1F1EA": Emoji{
 		Key:        "1F1E6-1F1EA",
 		Value:      "🇦🇪",
 		Descriptor: "Flag: United Arab Emirates",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E6-1F1EB": Emoji{
 		Key:        "1F1E6-1F1EB",
 		Value:      "🇦🇫",
 		Descriptor: "Flag: Afghanistan",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E6-1F1EC": Emoji{
 		Key:        "1F1E6-1F1EC",
 		Value:      "🇦🇬",
-		Descriptor: "Flag: Antigua \u0026 Barbuda",
+		Descriptor: "Flag: Antigua & Barbuda",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E6-1F1EE": Emoji{
 		Key:        "1F1E6-1F1EE",
 		Value:      "🇦🇮",
 		Descriptor: "Flag: Anguilla",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E6-1F1F1": Emoji{
 		Key:        "1F1E6-1F1F1",
 		Value:      "🇦🇱",
 		Descriptor: "Flag: Albania",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E6-1F1F2": Emoji{
 		Key:        "1F1E6-1F1F2",
 		Value:      "🇦🇲",
 		Descriptor: "Flag: Armenia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E6-1F1F4": Emoji{
 		Key:        "1F1E6-1F1F4",
 		Value:      "🇦🇴",
 		Descriptor: "Flag: Angola",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E6-1F1F6": Emoji{
 		Key:        "1F1E6-1F1F6",
 		Value:      "🇦🇶",
 		Descriptor: "Flag: Antarctica",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E6-1F1F7": Emoji{
 		Key:        "1F1E6-1F1F7",
 		Value:      "🇦🇷",
 		Descriptor: "Flag: Argentina",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E6-1F1F8": Emoji{
 		Key:        "1F1E6-1F1F8",
 		Value:      "🇦🇸",
 		Descriptor: "Flag: American Samoa",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E6-1F1F9": Emoji{
 		Key:        "1F1E6-1F1F9",
 		Value:      "🇦🇹",
 		Descriptor: "Flag: Austria",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E6-1F1FA": Emoji{
 		Key:        "1F1E6-1F1FA",
 		Value:      "🇦🇺",
 		Descriptor: "Flag: Australia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E6-1F1FC": Emoji{
 		Key:        "1F1E6-1F1FC",
 		Value:      "🇦🇼",
 		Descriptor: "Flag: Aruba",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E6-1F1FD": Emoji{
 		Key:        "1F1E6-1F1FD",
 		Value:      "🇦🇽",
 		Descriptor: "Flag: Åland Islands",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E6-1F1FF": Emoji{
 		Key:        "1F1E6-1F1FF",
 		Value:      "🇦🇿",
 		Descriptor: "Flag: Azerbaijan",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E7-1F1E6": Emoji{
 		Key:        "1F1E7-1F1E6",
 		Value:      "🇧🇦",
-		Descriptor: "Flag: Bosnia \u0026 Herzegovina",
+		Descriptor: "Flag: Bosnia & Herzegovina",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E7-1F1E7": Emoji{
 		Key:        "1F1E7-1F1E7",
 		Value:      "🇧🇧",
 		Descriptor: "Flag: Barbados",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E7-1F1E9": Emoji{
 		Key:        "1F1E7-1F1E9",
 		Value:      "🇧🇩",
 		Descriptor: "Flag: Bangladesh",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E7-1F1EA": Emoji{
 		Key:        "1F1E7-1F1EA",
 		Value:      "🇧🇪",
 		Descriptor: "Flag: Belgium",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E7-1F1EB": Emoji{
 		Key:        "1F1E7-1F1EB",
 		Value:      "🇧🇫",
 		Descriptor: "Flag: Burkina Faso",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E7-1F1EC": Emoji{
 		Key:        "1F1E7-1F1EC",
 		Value:      "🇧🇬",
 		Descriptor: "Flag: Bulgaria",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E7-1F1ED": Emoji{
 		Key:        "1F1E7-1F1ED",
 		Value:      "🇧🇭",
 		Descriptor: "Flag: Bahrain",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E7-1F1EE": Emoji{
 		Key:        "1F1E7-1F1EE",
 		Value:      "🇧🇮",
 		Descriptor: "Flag: Burundi",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E7-1F1EF": Emoji{
 		Key:        "1F1E7-1F1EF",
 		Value:      "🇧🇯",
 		Descriptor: "Flag: Benin",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E7-1F1F1": Emoji{
 		Key:        "1F1E7-1F1F1",
 		Value:      "🇧🇱",
 		Descriptor: "Flag: St. Barthélemy",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E7-1F1F2": Emoji{
 		Key:        "1F1E7-1F1F2",
 		Value:      "🇧🇲",
 		Descriptor: "Flag: Bermuda",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E7-1F1F3": Emoji{
 		Key:        "1F1E7-1F1F3",
 		Value:      "🇧🇳",
 		Descriptor: "Flag: Brunei",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E7-1F1F4": Emoji{
 		Key:        "1F1E7-1F1F4",
 		Value:      "🇧🇴",
 		Descriptor: "Flag: Bolivia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E7-1F1F6": Emoji{
 		Key:        "1F1E7-1F1F6",
 		Value:      "🇧🇶",
 		Descriptor: "Flag: Caribbean Netherlands",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E7-1F1F7": Emoji{
 		Key:        "1F1E7-1F1F7",
 		Value:      "🇧🇷",
 		Descriptor: "Flag: Brazil",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E7-1F1F8": Emoji{
 		Key:        "1F1E7-1F1F8",
 		Value:      "🇧🇸",
 		Descriptor: "Flag: Bahamas",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E7-1F1F9": Emoji{
 		Key:        "1F1E7-1F1F9",
 		Value:      "🇧🇹",
 		Descriptor: "Flag: Bhutan",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E7-1F1FB": Emoji{
 		Key:        "1F1E7-1F1FB",
 		Value:      "🇧🇻",
 		Descriptor: "Flag: Bouvet Island",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E7-1F1FC": Emoji{
 		Key:        "1F1E7-1F1FC",
 		Value:      "🇧🇼",
 		Descriptor: "Flag: Botswana",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E7-1F1FE": Emoji{
 		Key:        "1F1E7-1F1FE",
 		Value:      "🇧🇾",
 		Descriptor: "Flag: Belarus",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E7-1F1FF": Emoji{
 		Key:        "1F1E7-1F1FF",
 		Value:      "🇧🇿",
 		Descriptor: "Flag: Belize",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E8-1F1E6": Emoji{
 		Key:        "1F1E8-1F1E6",
 		Value:      "🇨🇦",
 		Descriptor: "Flag: Canada",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E8-1F1E8": Emoji{
 		Key:        "1F1E8-1F1E8",
 		Value:      "🇨🇨",
 		Descriptor: "Flag: Cocos (Keeling) Islands",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E8-1F1E9": Emoji{
 		Key:        "1F1E8-1F1E9",
 		Value:      "🇨🇩",
 		Descriptor: "Flag: Congo - Kinshasa",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E8-1F1EB": Emoji{
 		Key:        "1F1E8-1F1EB",
 		Value:      "🇨🇫",
 		Descriptor: "Flag: Central African Republic",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E8-1F1EC": Emoji{
 		Key:        "1F1E8-1F1EC",
 		Value:      "🇨🇬",
 		Descriptor: "Flag: Congo - Brazzaville",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E8-1F1ED": Emoji{
 		Key:        "1F1E8-1F1ED",
 		Value:      "🇨🇭",
 		Descriptor: "Flag: Switzerland",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E8-1F1EE": Emoji{
 		Key:        "1F1E8-1F1EE",
 		Value:      "🇨🇮",
-		Descriptor: "Flag: Côte d’Ivoire",
+		Descriptor: "Flag: Côte D’Ivoire",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E8-1F1F0": Emoji{
 		Key:        "1F1E8-1F1F0",
 		Value:      "🇨🇰",
 		Descriptor: "Flag: Cook Islands",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E8-1F1F1": Emoji{
 		Key:        "1F1E8-1F1F1",
 		Value:      "🇨🇱",
 		Descriptor: "Flag: Chile",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E8-1F1F2": Emoji{
 		Key:        "1F1E8-1F1F2",
 		Value:      "🇨🇲",
 		Descriptor: "Flag: Cameroon",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E8-1F1F3": Emoji{
 		Key:        "1F1E8-1F1F3",
 		Value:      "🇨🇳",
 		Descriptor: "Flag: China",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "0.6",
 	},
 	"1F1E8-1F1F4": Emoji{
 		Key:        "1F1E8-1F1F4",
 		Value:      "🇨🇴",
 		Descriptor: "Flag: Colombia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E8-1F1F5": Emoji{
 		Key:        "1F1E8-1F1F5",
 		Value:      "🇨🇵",
 		Descriptor: "Flag: Clipperton Island",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E8-1F1F7": Emoji{
 		Key:        "1F1E8-1F1F7",
 		Value:      "🇨🇷",
 		Descriptor: "Flag: Costa Rica",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E8-1F1FA": Emoji{
 		Key:        "1F1E8-1F1FA",
 		Value:      "🇨🇺",
 		Descriptor: "Flag: Cuba",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E8-1F1FB": Emoji{
 		Key:        "1F1E8-1F1FB",
 		Value:      "🇨🇻",
 		Descriptor: "Flag: Cape Verde",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E8-1F1FC": Emoji{
 		Key:        "1F1E8-1F1FC",
 		Value:      "🇨🇼",
 		Descriptor: "Flag: Curaçao",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E8-1F1FD": Emoji{
 		Key:        "1F1E8-1F1FD",
 		Value:      "🇨🇽",
 		Descriptor: "Flag: Christmas Island",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E8-1F1FE": Emoji{
 		Key:        "1F1E8-1F1FE",
 		Value:      "🇨🇾",
 		Descriptor: "Flag: Cyprus",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E8-1F1FF": Emoji{
 		Key:        "1F1E8-1F1FF",
 		Value:      "🇨🇿",
 		Descriptor: "Flag: Czechia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E9-1F1EA": Emoji{
 		Key:        "1F1E9-1F1EA",
 		Value:      "🇩🇪",
 		Descriptor: "Flag: Germany",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "0.6",
 	},
 	"1F1E9-1F1EC": Emoji{
 		Key:        "1F1E9-1F1EC",
 		Value:      "🇩🇬",
 		Descriptor: "Flag: Diego Garcia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E9-1F1EF": Emoji{
 		Key:        "1F1E9-1F1EF",
 		Value:      "🇩🇯",
 		Descriptor: "Flag: Djibouti",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E9-1F1F0": Emoji{
 		Key:        "1F1E9-1F1F0",
 		Value:      "🇩🇰",
 		Descriptor: "Flag: Denmark",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E9-1F1F2": Emoji{
 		Key:        "1F1E9-1F1F2",
 		Value:      "🇩🇲",
 		Descriptor: "Flag: Dominica",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E9-1F1F4": Emoji{
 		Key:        "1F1E9-1F1F4",
 		Value:      "🇩🇴",
 		Descriptor: "Flag: Dominican Republic",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1E9-1F1FF": Emoji{
 		Key:        "1F1E9-1F1FF",
 		Value:      "🇩🇿",
 		Descriptor: "Flag: Algeria",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EA-1F1E6": Emoji{
 		Key:        "1F1EA-1F1E6",
 		Value:      "🇪🇦",
-		Descriptor: "Flag: Ceuta \u0026 Melilla",
+		Descriptor: "Flag: Ceuta & Melilla",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EA-1F1E8": Emoji{
 		Key:        "1F1EA-1F1E8",
 		Value:      "🇪🇨",
 		Descriptor: "Flag: Ecuador",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EA-1F1EA": Emoji{
 		Key:        "1F1EA-1F1EA",
 		Value:      "🇪🇪",
 		Descriptor: "Flag: Estonia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EA-1F1EC": Emoji{
 		Key:        "1F1EA-1F1EC",
 		Value:      "🇪🇬",
 		Descriptor: "Flag: Egypt",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EA-1F1ED": Emoji{
 		Key:        "1F1EA-1F1ED",
 		Value:      "🇪🇭",
 		Descriptor: "Flag: Western Sahara",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EA-1F1F7": Emoji{
 		Key:        "1F1EA-1F1F7",
 		Value:      "🇪🇷",
 		Descriptor: "Flag: Eritrea",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EA-1F1F8": Emoji{
 		Key:        "1F1EA-1F1F8",
 		Value:      "🇪🇸",
 		Descriptor: "Flag: Spain",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "0.6",
 	},
 	"1F1EA-1F1F9": Emoji{
 		Key:        "1F1EA-1F1F9",
 		Value:      "🇪🇹",
 		Descriptor: "Flag: Ethiopia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EA-1F1FA": Emoji{
 		Key:        "1F1EA-1F1FA",
 		Value:      "🇪🇺",
 		Descriptor: "Flag: European Union",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EB-1F1EE": Emoji{
 		Key:        "1F1EB-1F1EE",
 		Value:      "🇫🇮",
 		Descriptor: "Flag: Finland",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EB-1F1EF": Emoji{
 		Key:        "1F1EB-1F1EF",
 		Value:      "🇫🇯",
 		Descriptor: "Flag: Fiji",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EB-1F1F0": Emoji{
 		Key:        "1F1EB-1F1F0",
 		Value:      "🇫🇰",
 		Descriptor: "Flag: Falkland Islands",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EB-1F1F2": Emoji{
 		Key:        "1F1EB-1F1F2",
 		Value:      "🇫🇲",
 		Descriptor: "Flag: Micronesia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EB-1F1F4": Emoji{
 		Key:        "1F1EB-1F1F4",
 		Value:      "🇫🇴",
 		Descriptor: "Flag: Faroe Islands",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EB-1F1F7": Emoji{
 		Key:        "1F1EB-1F1F7",
 		Value:      "🇫🇷",
 		Descriptor: "Flag: France",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "0.6",
 	},
 	"1F1EC-1F1E6": Emoji{
 		Key:        "1F1EC-1F1E6",
 		Value:      "🇬🇦",
 		Descriptor: "Flag: Gabon",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EC-1F1E7": Emoji{
 		Key:        "1F1EC-1F1E7",
 		Value:      "🇬🇧",
 		Descriptor: "Flag: United Kingdom",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "0.6",
 	},
 	"1F1EC-1F1E9": Emoji{
 		Key:        "1F1EC-1F1E9",
 		Value:      "🇬🇩",
 		Descriptor: "Flag: Grenada",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EC-1F1EA": Emoji{
 		Key:        "1F1EC-1F1EA",
 		Value:      "🇬🇪",
 		Descriptor: "Flag: Georgia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EC-1F1EB": Emoji{
 		Key:        "1F1EC-1F1EB",
 		Value:      "🇬🇫",
 		Descriptor: "Flag: French Guiana",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EC-1F1EC": Emoji{
 		Key:        "1F1EC-1F1EC",
 		Value:      "🇬🇬",
 		Descriptor: "Flag: Guernsey",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EC-1F1ED": Emoji{
 		Key:        "1F1EC-1F1ED",
 		Value:      "🇬🇭",
 		Descriptor: "Flag: Ghana",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EC-1F1EE": Emoji{
 		Key:        "1F1EC-1F1EE",
 		Value:      "🇬🇮",
 		Descriptor: "Flag: Gibraltar",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EC-1F1F1": Emoji{
 		Key:        "1F1EC-1F1F1",
 		Value:      "🇬🇱",
 		Descriptor: "Flag: Greenland",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EC-1F1F2": Emoji{
 		Key:        "1F1EC-1F1F2",
 		Value:      "🇬🇲",
 		Descriptor: "Flag: Gambia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EC-1F1F3": Emoji{
 		Key:        "1F1EC-1F1F3",
 		Value:      "🇬🇳",
 		Descriptor: "Flag: Guinea",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EC-1F1F5": Emoji{
 		Key:        "1F1EC-1F1F5",
 		Value:      "🇬🇵",
 		Descriptor: "Flag: Guadeloupe",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EC-1F1F6": Emoji{
 		Key:        "1F1EC-1F1F6",
 		Value:      "🇬🇶",
 		Descriptor: "Flag: Equatorial Guinea",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EC-1F1F7": Emoji{
 		Key:        "1F1EC-1F1F7",
 		Value:      "🇬🇷",
 		Descriptor: "Flag: Greece",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EC-1F1F8": Emoji{
 		Key:        "1F1EC-1F1F8",
 		Value:      "🇬🇸",
-		Descriptor: "Flag: South Georgia \u0026 South Sandwich Islands",
+		Descriptor: "Flag: South Georgia & South Sandwich Islands",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EC-1F1F9": Emoji{
 		Key:        "1F1EC-1F1F9",
 		Value:      "🇬🇹",
 		Descriptor: "Flag: Guatemala",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EC-1F1FA": Emoji{
 		Key:        "1F1EC-1F1FA",
 		Value:      "🇬🇺",
 		Descriptor: "Flag: Guam",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EC-1F1FC": Emoji{
 		Key:        "1F1EC-1F1FC",
 		Value:      "🇬🇼",
 		Descriptor: "Flag: Guinea-Bissau",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EC-1F1FE": Emoji{
 		Key:        "1F1EC-1F1FE",
 		Value:      "🇬🇾",
 		Descriptor: "Flag: Guyana",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1ED-1F1F0": Emoji{
 		Key:        "1F1ED-1F1F0",
 		Value:      "🇭🇰",
 		Descriptor: "Flag: Hong Kong SAR China",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1ED-1F1F2": Emoji{
 		Key:        "1F1ED-1F1F2",
 		Value:      "🇭🇲",
-		Descriptor: "Flag: Heard \u0026 McDonald Islands",
+		Descriptor: "Flag: Heard & McDonald Islands",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1ED-1F1F3": Emoji{
 		Key:        "1F1ED-1F1F3",
 		Value:      "🇭🇳",
 		Descriptor: "Flag: Honduras",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1ED-1F1F7": Emoji{
 		Key:        "1F1ED-1F1F7",
 		Value:      "🇭🇷",
 		Descriptor: "Flag: Croatia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1ED-1F1F9": Emoji{
 		Key:        "1F1ED-1F1F9",
 		Value:      "🇭🇹",
 		Descriptor: "Flag: Haiti",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1ED-1F1FA": Emoji{
 		Key:        "1F1ED-1F1FA",
 		Value:      "🇭🇺",
 		Descriptor: "Flag: Hungary",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EE-1F1E8": Emoji{
 		Key:        "1F1EE-1F1E8",
 		Value:      "🇮🇨",
 		Descriptor: "Flag: Canary Islands",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EE-1F1E9": Emoji{
 		Key:        "1F1EE-1F1E9",
 		Value:      "🇮🇩",
 		Descriptor: "Flag: Indonesia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EE-1F1EA": Emoji{
 		Key:        "1F1EE-1F1EA",
 		Value:      "🇮🇪",
 		Descriptor: "Flag: Ireland",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EE-1F1F1": Emoji{
 		Key:        "1F1EE-1F1F1",
 		Value:      "🇮🇱",
 		Descriptor: "Flag: Israel",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EE-1F1F2": Emoji{
 		Key:        "1F1EE-1F1F2",
 		Value:      "🇮🇲",
 		Descriptor: "Flag: Isle of Man",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EE-1F1F3": Emoji{
 		Key:        "1F1EE-1F1F3",
 		Value:      "🇮🇳",
 		Descriptor: "Flag: India",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EE-1F1F4": Emoji{
 		Key:        "1F1EE-1F1F4",
 		Value:      "🇮🇴",
 		Descriptor: "Flag: British Indian Ocean Territory",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EE-1F1F6": Emoji{
 		Key:        "1F1EE-1F1F6",
 		Value:      "🇮🇶",
 		Descriptor: "Flag: Iraq",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EE-1F1F7": Emoji{
 		Key:        "1F1EE-1F1F7",
 		Value:      "🇮🇷",
 		Descriptor: "Flag: Iran",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EE-1F1F8": Emoji{
 		Key:        "1F1EE-1F1F8",
 		Value:      "🇮🇸",
 		Descriptor: "Flag: Iceland",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EE-1F1F9": Emoji{
 		Key:        "1F1EE-1F1F9",
 		Value:      "🇮🇹",
 		Descriptor: "Flag: Italy",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "0.6",
 	},
 	"1F1EF-1F1EA": Emoji{
 		Key:        "1F1EF-1F1EA",
 		Value:      "🇯🇪",
 		Descriptor: "Flag: Jersey",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EF-1F1F2": Emoji{
 		Key:        "1F1EF-1F1F2",
 		Value:      "🇯🇲",
 		Descriptor: "Flag: Jamaica",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EF-1F1F4": Emoji{
 		Key:        "1F1EF-1F1F4",
 		Value:      "🇯🇴",
 		Descriptor: "Flag: Jordan",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1EF-1F1F5": Emoji{
 		Key:        "1F1EF-1F1F5",
 		Value:      "🇯🇵",
 		Descriptor: "Flag: Japan",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "0.6",
 	},
 	"1F1F0-1F1EA": Emoji{
 		Key:        "1F1F0-1F1EA",
 		Value:      "🇰🇪",
 		Descriptor: "Flag: Kenya",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F0-1F1EC": Emoji{
 		Key:        "1F1F0-1F1EC",
 		Value:      "🇰🇬",
 		Descriptor: "Flag: Kyrgyzstan",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F0-1F1ED": Emoji{
 		Key:        "1F1F0-1F1ED",
 		Value:      "🇰🇭",
 		Descriptor: "Flag: Cambodia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F0-1F1EE": Emoji{
 		Key:        "1F1F0-1F1EE",
 		Value:      "🇰🇮",
 		Descriptor: "Flag: Kiribati",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F0-1F1F2": Emoji{
 		Key:        "1F1F0-1F1F2",
 		Value:      "🇰🇲",
 		Descriptor: "Flag: Comoros",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F0-1F1F3": Emoji{
 		Key:        "1F1F0-1F1F3",
 		Value:      "🇰🇳",
-		Descriptor: "Flag: St. Kitts \u0026 Nevis",
+		Descriptor: "Flag: St. Kitts & Nevis",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F0-1F1F5": Emoji{
 		Key:        "1F1F0-1F1F5",
 		Value:      "🇰🇵",
 		Descriptor: "Flag: North Korea",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F0-1F1F7": Emoji{
 		Key:        "1F1F0-1F1F7",
 		Value:      "🇰🇷",
 		Descriptor: "Flag: South Korea",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "0.6",
 	},
 	"1F1F0-1F1FC": Emoji{
 		Key:        "1F1F0-1F1FC",
 		Value:      "🇰🇼",
 		Descriptor: "Flag: Kuwait",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F0-1F1FE": Emoji{
 		Key:        "1F1F0-1F1FE",
 		Value:      "🇰🇾",
 		Descriptor: "Flag: Cayman Islands",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F0-1F1FF": Emoji{
 		Key:        "1F1F0-1F1FF",
 		Value:      "🇰🇿",
 		Descriptor: "Flag: Kazakhstan",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F1-1F1E6": Emoji{
 		Key:        "1F1F1-1F1E6",
 		Value:      "🇱🇦",
 		Descriptor: "Flag: Laos",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F1-1F1E7": Emoji{
 		Key:        "1F1F1-1F1E7",
 		Value:      "🇱🇧",
 		Descriptor: "Flag: Lebanon",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F1-1F1E8": Emoji{
 		Key:        "1F1F1-1F1E8",
 		Value:      "🇱🇨",
 		Descriptor: "Flag: St. Lucia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F1-1F1EE": Emoji{
 		Key:        "1F1F1-1F1EE",
 		Value:      "🇱🇮",
 		Descriptor: "Flag: Liechtenstein",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F1-1F1F0": Emoji{
 		Key:        "1F1F1-1F1F0",
 		Value:      "🇱🇰",
 		Descriptor: "Flag: Sri Lanka",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F1-1F1F7": Emoji{
 		Key:        "1F1F1-1F1F7",
 		Value:      "🇱🇷",
 		Descriptor: "Flag: Liberia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F1-1F1F8": Emoji{
 		Key:        "1F1F1-1F1F8",
 		Value:      "🇱🇸",
 		Descriptor: "Flag: Lesotho",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F1-1F1F9": Emoji{
 		Key:        "1F1F1-1F1F9",
 		Value:      "🇱🇹",
 		Descriptor: "Flag: Lithuania",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F1-1F1FA": Emoji{
 		Key:        "1F1F1-1F1FA",
 		Value:      "🇱🇺",
 		Descriptor: "Flag: Luxembourg",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F1-1F1FB": Emoji{
 		Key:        "1F1F1-1F1FB",
 		Value:      "🇱🇻",
 		Descriptor: "Flag: Latvia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F1-1F1FE": Emoji{
 		Key:        "1F1F1-1F1FE",
 		Value:      "🇱🇾",
 		Descriptor: "Flag: Libya",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F2-1F1E6": Emoji{
 		Key:        "1F1F2-1F1E6",
 		Value:      "🇲🇦",
 		Descriptor: "Flag: Morocco",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F2-1F1E8": Emoji{
 		Key:        "1F1F2-1F1E8",
 		Value:      "🇲🇨",
 		Descriptor: "Flag: Monaco",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F2-1F1E9": Emoji{
 		Key:        "1F1F2-1F1E9",
 		Value:      "🇲🇩",
 		Descriptor: "Flag: Moldova",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F2-1F1EA": Emoji{
 		Key:        "1F1F2-1F1EA",
 		Value:      "🇲🇪",
 		Descriptor: "Flag: Montenegro",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F2-1F1EB": Emoji{
 		Key:        "1F1F2-1F1EB",
 		Value:      "🇲🇫",
 		Descriptor: "Flag: St. Martin",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F2-1F1EC": Emoji{
 		Key:        "1F1F2-1F1EC",
 		Value:      "🇲🇬",
 		Descriptor: "Flag: Madagascar",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F2-1F1ED": Emoji{
 		Key:        "1F1F2-1F1ED",
 		Value:      "🇲🇭",
 		Descriptor: "Flag: Marshall Islands",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F2-1F1F0": Emoji{
 		Key:        "1F1F2-1F1F0",
 		Value:      "🇲🇰",
 		Descriptor: "Flag: North Macedonia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F2-1F1F1": Emoji{
 		Key:        "1F1F2-1F1F1",
 		Value:      "🇲🇱",
 		Descriptor: "Flag: Mali",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F2-1F1F2": Emoji{
 		Key:        "1F1F2-1F1F2",
 		Value:      "🇲🇲",
 		Descriptor: "Flag: Myanmar (Burma)",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F2-1F1F3": Emoji{
 		Key:        "1F1F2-1F1F3",
 		Value:      "🇲🇳",
 		Descriptor: "Flag: Mongolia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F2-1F1F4": Emoji{
 		Key:        "1F1F2-1F1F4",
 		Value:      "🇲🇴",
-		Descriptor: "Flag: Macau Sar China",
+		Descriptor: "Flag: Macao SAR China",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F2-1F1F5": Emoji{
 		Key:        "1F1F2-1F1F5",
 		Value:      "🇲🇵",
 		Descriptor: "Flag: Northern Mariana Islands",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F2-1F1F6": Emoji{
 		Key:        "1F1F2-1F1F6",
 		Value:      "🇲🇶",
 		Descriptor: "Flag: Martinique",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F2-1F1F7": Emoji{
 		Key:        "1F1F2-1F1F7",
 		Value:      "🇲🇷",
 		Descriptor: "Flag: Mauritania",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F2-1F1F8": Emoji{
 		Key:        "1F1F2-1F1F8",
 		Value:      "🇲🇸",
 		Descriptor: "Flag: Montserrat",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F2-1F1F9": Emoji{
 		Key:        "1F1F2-1F1F9",
 		Value:      "🇲🇹",
 		Descriptor: "Flag: Malta",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F2-1F1FA": Emoji{
 		Key:        "1F1F2-1F1FA",
 		Value:      "🇲🇺",
 		Descriptor: "Flag: Mauritius",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F2-1F1FB": Emoji{
 		Key:        "1F1F2-1F1FB",
 		Value:      "🇲🇻",
 		Descriptor: "Flag: Maldives",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F2-1F1FC": Emoji{
 		Key:        "1F1F2-1F1FC",
 		Value:      "🇲🇼",
 		Descriptor: "Flag: Malawi",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F2-1F1FD": Emoji{
 		Key:        "1F1F2-1F1FD",
 		Value:      "🇲🇽",
 		Descriptor: "Flag: Mexico",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F2-1F1FE": Emoji{
 		Key:        "1F1F2-1F1FE",
 		Value:      "🇲🇾",
 		Descriptor: "Flag: Malaysia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F2-1F1FF": Emoji{
 		Key:        "1F1F2-1F1FF",
 		Value:      "🇲🇿",
 		Descriptor: "Flag: Mozambique",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F3-1F1E6": Emoji{
 		Key:        "1F1F3-1F1E6",
 		Value:      "🇳🇦",
 		Descriptor: "Flag: Namibia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F3-1F1E8": Emoji{
 		Key:        "1F1F3-1F1E8",
 		Value:      "🇳🇨",
 		Descriptor: "Flag: New Caledonia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F3-1F1EA": Emoji{
 		Key:        "1F1F3-1F1EA",
 		Value:      "🇳🇪",
 		Descriptor: "Flag: Niger",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F3-1F1EB": Emoji{
 		Key:        "1F1F3-1F1EB",
 		Value:      "🇳🇫",
 		Descriptor: "Flag: Norfolk Island",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F3-1F1EC": Emoji{
 		Key:        "1F1F3-1F1EC",
 		Value:      "🇳🇬",
 		Descriptor: "Flag: Nigeria",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F3-1F1EE": Emoji{
 		Key:        "1F1F3-1F1EE",
 		Value:      "🇳🇮",
 		Descriptor: "Flag: Nicaragua",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F3-1F1F1": Emoji{
 		Key:        "1F1F3-1F1F1",
 		Value:      "🇳🇱",
 		Descriptor: "Flag: Netherlands",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F3-1F1F4": Emoji{
 		Key:        "1F1F3-1F1F4",
 		Value:      "🇳🇴",
 		Descriptor: "Flag: Norway",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F3-1F1F5": Emoji{
 		Key:        "1F1F3-1F1F5",
 		Value:      "🇳🇵",
 		Descriptor: "Flag: Nepal",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F3-1F1F7": Emoji{
 		Key:        "1F1F3-1F1F7",
 		Value:      "🇳🇷",
 		Descriptor: "Flag: Nauru",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F3-1F1FA": Emoji{
 		Key:        "1F1F3-1F1FA",
 		Value:      "🇳🇺",
 		Descriptor: "Flag: Niue",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F3-1F1FF": Emoji{
 		Key:        "1F1F3-1F1FF",
 		Value:      "🇳🇿",
 		Descriptor: "Flag: New Zealand",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F4-1F1F2": Emoji{
 		Key:        "1F1F4-1F1F2",
 		Value:      "🇴🇲",
 		Descriptor: "Flag: Oman",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F5-1F1E6": Emoji{
 		Key:        "1F1F5-1F1E6",
 		Value:      "🇵🇦",
 		Descriptor: "Flag: Panama",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F5-1F1EA": Emoji{
 		Key:        "1F1F5-1F1EA",
 		Value:      "🇵🇪",
 		Descriptor: "Flag: Peru",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F5-1F1EB": Emoji{
 		Key:        "1F1F5-1F1EB",
 		Value:      "🇵🇫",
 		Descriptor: "Flag: French Polynesia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F5-1F1EC": Emoji{
 		Key:        "1F1F5-1F1EC",
 		Value:      "🇵🇬",
 		Descriptor: "Flag: Papua New Guinea",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F5-1F1ED": Emoji{
 		Key:        "1F1F5-1F1ED",
 		Value:      "🇵🇭",
 		Descriptor: "Flag: Philippines",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F5-1F1F0": Emoji{
 		Key:        "1F1F5-1F1F0",
 		Value:      "🇵🇰",
 		Descriptor: "Flag: Pakistan",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F5-1F1F1": Emoji{
 		Key:        "1F1F5-1F1F1",
 		Value:      "🇵🇱",
 		Descriptor: "Flag: Poland",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F5-1F1F2": Emoji{
 		Key:        "1F1F5-1F1F2",
 		Value:      "🇵🇲",
-		Descriptor: "Flag: St. Pierre \u0026 Miquelon",
+		Descriptor: "Flag: St. Pierre & Miquelon",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F5-1F1F3": Emoji{
 		Key:        "1F1F5-1F1F3",
 		Value:      "🇵🇳",
 		Descriptor: "Flag: Pitcairn Islands",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F5-1F1F7": Emoji{
 		Key:        "1F1F5-1F1F7",
 		Value:      "🇵🇷",
 		Descriptor: "Flag: Puerto Rico",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F5-1F1F8": Emoji{
 		Key:        "1F1F5-1F1F8",
 		Value:      "🇵🇸",
 		Descriptor: "Flag: Palestinian Territories",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F5-1F1F9": Emoji{
 		Key:        "1F1F5-1F1F9",
 		Value:      "🇵🇹",
 		Descriptor: "Flag: Portugal",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F5-1F1FC": Emoji{
 		Key:        "1F1F5-1F1FC",
 		Value:      "🇵🇼",
 		Descriptor: "Flag: Palau",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F5-1F1FE": Emoji{
 		Key:        "1F1F5-1F1FE",
 		Value:      "🇵🇾",
 		Descriptor: "Flag: Paraguay",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F6-1F1E6": Emoji{
 		Key:        "1F1F6-1F1E6",
 		Value:      "🇶🇦",
 		Descriptor: "Flag: Qatar",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F7-1F1EA": Emoji{
 		Key:        "1F1F7-1F1EA",
 		Value:      "🇷🇪",
 		Descriptor: "Flag: Réunion",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F7-1F1F4": Emoji{
 		Key:        "1F1F7-1F1F4",
 		Value:      "🇷🇴",
 		Descriptor: "Flag: Romania",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F7-1F1F8": Emoji{
 		Key:        "1F1F7-1F1F8",
 		Value:      "🇷🇸",
 		Descriptor: "Flag: Serbia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F7-1F1FA": Emoji{
 		Key:        "1F1F7-1F1FA",
 		Value:      "🇷🇺",
 		Descriptor: "Flag: Russia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "0.6",
 	},
 	"1F1F7-1F1FC": Emoji{
 		Key:        "1F1F7-1F1FC",
 		Value:      "🇷🇼",
 		Descriptor: "Flag: Rwanda",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F8-1F1E6": Emoji{
 		Key:        "1F1F8-1F1E6",
 		Value:      "🇸🇦",
 		Descriptor: "Flag: Saudi Arabia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F8-1F1E7": Emoji{
 		Key:        "1F1F8-1F1E7",
 		Value:      "🇸🇧",
 		Descriptor: "Flag: Solomon Islands",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F8-1F1E8": Emoji{
 		Key:        "1F1F8-1F1E8",
 		Value:      "🇸🇨",
 		Descriptor: "Flag: Seychelles",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F8-1F1E9": Emoji{
 		Key:        "1F1F8-1F1E9",
 		Value:      "🇸🇩",
 		Descriptor: "Flag: Sudan",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F8-1F1EA": Emoji{
 		Key:        "1F1F8-1F1EA",
 		Value:      "🇸🇪",
 		Descriptor: "Flag: Sweden",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F8-1F1EC": Emoji{
 		Key:        "1F1F8-1F1EC",
 		Value:      "🇸🇬",
 		Descriptor: "Flag: Singapore",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F8-1F1ED": Emoji{
 		Key:        "1F1F8-1F1ED",
 		Value:      "🇸🇭",
 		Descriptor: "Flag: St. Helena",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F8-1F1EE": Emoji{
 		Key:        "1F1F8-1F1EE",
 		Value:      "🇸🇮",
 		Descriptor: "Flag: Slovenia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F8-1F1EF": Emoji{
 		Key:        "1F1F8-1F1EF",
 		Value:      "🇸🇯",
-		Descriptor: "Flag: Svalbard \u0026 Jan Mayen",
+		Descriptor: "Flag: Svalbard & Jan Mayen",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F8-1F1F0": Emoji{
 		Key:        "1F1F8-1F1F0",
 		Value:      "🇸🇰",
 		Descriptor: "Flag: Slovakia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F8-1F1F1": Emoji{
 		Key:        "1F1F8-1F1F1",
 		Value:      "🇸🇱",
 		Descriptor: "Flag: Sierra Leone",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F8-1F1F2": Emoji{
 		Key:        "1F1F8-1F1F2",
 		Value:      "🇸🇲",
 		Descriptor: "Flag: San Marino",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F8-1F1F3": Emoji{
 		Key:        "1F1F8-1F1F3",
 		Value:      "🇸🇳",
 		Descriptor: "Flag: Senegal",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F8-1F1F4": Emoji{
 		Key:        "1F1F8-1F1F4",
 		Value:      "🇸🇴",
 		Descriptor: "Flag: Somalia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F8-1F1F7": Emoji{
 		Key:        "1F1F8-1F1F7",
 		Value:      "🇸🇷",
 		Descriptor: "Flag: Suriname",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F8-1F1F8": Emoji{
 		Key:        "1F1F8-1F1F8",
 		Value:      "🇸🇸",
 		Descriptor: "Flag: South Sudan",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F8-1F1F9": Emoji{
 		Key:        "1F1F8-1F1F9",
 		Value:      "🇸🇹",
-		Descriptor: "Flag: São Tomé \u0026 Príncipe",
+		Descriptor: "Flag: São Tomé & Príncipe",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F8-1F1FB": Emoji{
 		Key:        "1F1F8-1F1FB",
 		Value:      "🇸🇻",
 		Descriptor: "Flag: El Salvador",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F8-1F1FD": Emoji{
 		Key:        "1F1F8-1F1FD",
 		Value:      "🇸🇽",
 		Descriptor: "Flag: Sint Maarten",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F8-1F1FE": Emoji{
 		Key:        "1F1F8-1F1FE",
 		Value:      "🇸🇾",
 		Descriptor: "Flag: Syria",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F8-1F1FF": Emoji{
 		Key:        "1F1F8-1F1FF",
 		Value:      "🇸🇿",
-		Descriptor: "Flag: Swaziland",
+		Descriptor: "Flag: Eswatini",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F9-1F1E6": Emoji{
 		Key:        "1F1F9-1F1E6",
 		Value:      "🇹🇦",
 		Descriptor: "Flag: Tristan Da Cunha",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F9-1F1E8": Emoji{
 		Key:        "1F1F9-1F1E8",
 		Value:      "🇹🇨",
-		Descriptor: "Flag: Turks \u0026 Caicos Islands",
+		Descriptor: "Flag: Turks & Caicos Islands",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F9-1F1E9": Emoji{
 		Key:        "1F1F9-1F1E9",
 		Value:      "🇹🇩",
 		Descriptor: "Flag: Chad",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F9-1F1EB": Emoji{
 		Key:        "1F1F9-1F1EB",
 		Value:      "🇹🇫",
 		Descriptor: "Flag: French Southern Territories",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F9-1F1EC": Emoji{
 		Key:        "1F1F9-1F1EC",
 		Value:      "🇹🇬",
 		Descriptor: "Flag: Togo",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F9-1F1ED": Emoji{
 		Key:        "1F1F9-1F1ED",
 		Value:      "🇹🇭",
 		Descriptor: "Flag: Thailand",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F9-1F1EF": Emoji{
 		Key:        "1F1F9-1F1EF",
 		Value:      "🇹🇯",
 		Descriptor: "Flag: Tajikistan",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F9-1F1F0": Emoji{
 		Key:        "1F1F9-1F1F0",
 		Value:      "🇹🇰",
 		Descriptor: "Flag: Tokelau",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F9-1F1F1": Emoji{
 		Key:        "1F1F9-1F1F1",
 		Value:      "🇹🇱",
 		Descriptor: "Flag: Timor-Leste",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F9-1F1F2": Emoji{
 		Key:        "1F1F9-1F1F2",
 		Value:      "🇹🇲",
 		Descriptor: "Flag: Turkmenistan",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F9-1F1F3": Emoji{
 		Key:        "1F1F9-1F1F3",
 		Value:      "🇹🇳",
 		Descriptor: "Flag: Tunisia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F9-1F1F4": Emoji{
 		Key:        "1F1F9-1F1F4",
 		Value:      "🇹🇴",
 		Descriptor: "Flag: Tonga",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F9-1F1F7": Emoji{
 		Key:        "1F1F9-1F1F7",
 		Value:      "🇹🇷",
-		Descriptor: "Flag: Turkey",
+		Descriptor: "Flag: Türkiye",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F9-1F1F9": Emoji{
 		Key:        "1F1F9-1F1F9",
 		Value:      "🇹🇹",
-		Descriptor: "Flag: Trinidad \u0026 Tobago",
+		Descriptor: "Flag: Trinidad & Tobago",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F9-1F1FB": Emoji{
 		Key:        "1F1F9-1F1FB",
 		Value:      "🇹🇻",
 		Descriptor: "Flag: Tuvalu",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F9-1F1FC": Emoji{
 		Key:        "1F1F9-1F1FC",
 		Value:      "🇹🇼",
 		Descriptor: "Flag: Taiwan",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1F9-1F1FF": Emoji{
 		Key:        "1F1F9-1F1FF",
 		Value:      "🇹🇿",
 		Descriptor: "Flag: Tanzania",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1FA-1F1E6": Emoji{
 		Key:        "1F1FA-1F1E6",
 		Value:      "🇺🇦",
 		Descriptor: "Flag: Ukraine",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1FA-1F1EC": Emoji{
 		Key:        "1F1FA-1F1EC",
 		Value:      "🇺🇬",
 		Descriptor: "Flag: Uganda",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1FA-1F1F2": Emoji{
 		Key:        "1F1FA-1F1F2",
 		Value:      "🇺🇲",
 		Descriptor: "Flag: U.S. Outlying Islands",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1FA-1F1F3": Emoji{
 		Key:        "1F1FA-1F1F3",
 		Value:      "🇺🇳",
 		Descriptor: "Flag: United Nations",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "4.0",
 	},
 	"1F1FA-1F1F8": Emoji{
 		Key:        "1F1FA-1F1F8",
 		Value:      "🇺🇸",
 		Descriptor: "Flag: United States",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "0.6",
 	},
 	"1F1FA-1F1FE": Emoji{
 		Key:        "1F1FA-1F1FE",
 		Value:      "🇺🇾",
 		Descriptor: "Flag: Uruguay",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1FA-1F1FF": Emoji{
 		Key:        "1F1FA-1F1FF",
 		Value:      "🇺🇿",
 		Descriptor: "Flag: Uzbekistan",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1FB-1F1E6": Emoji{
 		Key:        "1F1FB-1F1E6",
 		Value:      "🇻🇦",
 		Descriptor: "Flag: Vatican City",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1FB-1F1E8": Emoji{
 		Key:        "1F1FB-1F1E8",
 		Value:      "🇻🇨",
-		Descriptor: "Flag: St. Vincent \u0026 Grenadines",
+		Descriptor: "Flag: St. Vincent & Grenadines",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1FB-1F1EA": Emoji{
 		Key:        "1F1FB-1F1EA",
 		Value:      "🇻🇪",
 		Descriptor: "Flag: Venezuela",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1FB-1F1EC": Emoji{
 		Key:        "1F1FB-1F1EC",
 		Value:      "🇻🇬",
 		Descriptor: "Flag: British Virgin Islands",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1FB-1F1EE": Emoji{
 		Key:        "1F1FB-1F1EE",
 		Value:      "🇻🇮",
 		Descriptor: "Flag: U.S. Virgin Islands",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1FB-1F1F3": Emoji{
 		Key:        "1F1FB-1F1F3",
 		Value:      "🇻🇳",
 		Descriptor: "Flag: Vietnam",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1FB-1F1FA": Emoji{
 		Key:        "1F1FB-1F1FA",
 		Value:      "🇻🇺",
 		Descriptor: "Flag: Vanuatu",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1FC-1F1EB": Emoji{
 		Key:        "1F1FC-1F1EB",
 		Value:      "🇼🇫",
-		Descriptor: "Flag: Wallis \u0026 Futuna",
+		Descriptor: "Flag: Wallis & Futuna",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1FC-1F1F8": Emoji{
 		Key:        "1F1FC-1F1F8",
 		Value:      "🇼🇸",
 		Descriptor: "Flag: Samoa",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1FD-1F1F0": Emoji{
 		Key:        "1F1FD-1F1F0",
 		Value:      "🇽🇰",
 		Descriptor: "Flag: Kosovo",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1FE-1F1EA": Emoji{
 		Key:        "1F1FE-1F1EA",
 		Value:      "🇾🇪",
 		Descriptor: "Flag: Yemen",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1FE-1F1F9": Emoji{
 		Key:        "1F1FE-1F1F9",
 		Value:      "🇾🇹",
 		Descriptor: "Flag: Mayotte",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1FF-1F1E6": Emoji{
 		Key:        "1F1FF-1F1E6",
 		Value:      "🇿🇦",
 		Descriptor: "Flag: South Africa",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1FF-1F1F2": Emoji{
 		Key:        "1F1FF-1F1F2",
 		Value:      "🇿🇲",
 		Descriptor: "Flag: Zambia",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F1FF-1F1FC": Emoji{
 		Key:        "1F1FF-1F1FC",
 		Value:      "🇿🇼",
 		Descriptor: "Flag: Zimbabwe",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "country-flag",
+		Version:    "2.0",
 	},
 	"1F201": Emoji{
 		Key:        "1F201",
 		Value:      "🈁",
 		Descriptor: "Japanese “Here” Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F202-FE0F": Emoji{
 		Key:        "1F202-FE0F",
 		Value:      "🈂️",
 		Descriptor: "Japanese “Service Charge” Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F21A": Emoji{
 		Key:        "1F21A",
 		Value:      "🈚",
 		Descriptor: "Japanese “Free of Charge” Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F22F": Emoji{
 		Key:        "1F22F",
 		Value:      "🈯",
 		Descriptor: "Japanese “Reserved” Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F232": Emoji{
 		Key:        "1F232",
 		Value:      "🈲",
 		Descriptor: "Japanese “Prohibited” Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F233": Emoji{
 		Key:        "1F233",
 		Value:      "🈳",
 		Descriptor: "Japanese “Vacancy” Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F234": Emoji{
 		Key:        "1F234",
 		Value:      "🈴",
 		Descriptor: "Japanese “Passing Grade” Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F235": Emoji{
 		Key:        "1F235",
 		Value:      "🈵",
 		Descriptor: "Japanese “No Vacancy” Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F236": Emoji{
 		Key:        "1F236",
 		Value:      "🈶",
 		Descriptor: "Japanese “Not Free of Charge” Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F237-FE0F": Emoji{
 		Key:        "1F237-FE0F",
 		Value:      "🈷️",
 		Descriptor: "Japanese “Monthly Amount” Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F238": Emoji{
 		Key:        "1F238",
 		Value:      "🈸",
 		Descriptor: "Japanese “Application” Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F239": Emoji{
 		Key:        "1F239",
 		Value:      "🈹",
 		Descriptor: "Japanese “Discount” Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F23A": Emoji{
 		Key:        "1F23A",
 		Value:      "🈺",
 		Descriptor: "Japanese “Open for Business” Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F250": Emoji{
 		Key:        "1F250",
 		Value:      "🉐",
 		Descriptor: "Japanese “Bargain” Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F251": Emoji{
 		Key:        "1F251",
 		Value:      "🉑",
 		Descriptor: "Japanese “Acceptable” Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F300": Emoji{
 		Key:        "1F300",
 		Value:      "🌀",
 		Descriptor: "Cyclone",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.6",
 	},
 	"1F301": Emoji{
 		Key:        "1F301",
 		Value:      "🌁",
 		Descriptor: "Foggy",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-other",
+		Version:    "0.6",
 	},
 	"1F302": Emoji{
 		Key:        "1F302",
 		Value:      "🌂",
 		Descriptor: "Closed Umbrella",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.6",
 	},
 	"1F303": Emoji{
 		Key:        "1F303",
 		Value:      "🌃",
 		Descriptor: "Night With Stars",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-other",
+		Version:    "0.6",
 	},
 	"1F304": Emoji{
 		Key:        "1F304",
 		Value:      "🌄",
 		Descriptor: "Sunrise Over Mountains",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-other",
+		Version:    "0.6",
 	},
 	"1F305": Emoji{
 		Key:        "1F305",
 		Value:      "🌅",
 		Descriptor: "Sunrise",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-other",
+		Version:    "0.6",
 	},
 	"1F306": Emoji{
 		Key:        "1F306",
 		Value:      "🌆",
 		Descriptor: "Cityscape at Dusk",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-other",
+		Version:    "0.6",
 	},
 	"1F307": Emoji{
 		Key:        "1F307",
 		Value:      "🌇",
 		Descriptor: "Sunset",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-other",
+		Version:    "0.6",
 	},
 	"1F308": Emoji{
 		Key:        "1F308",
 		Value:      "🌈",
 		Descriptor: "Rainbow",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.6",
 	},
 	"1F309": Emoji{
 		Key:        "1F309",
 		Value:      "🌉",
 		Descriptor: "Bridge at Night",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-other",
+		Version:    "0.6",
 	},
 	"1F30A": Emoji{
 		Key:        "1F30A",
 		Value:      "🌊",
 		Descriptor: "Water Wave",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.6",
 	},
 	"1F30B": Emoji{
 		Key:        "1F30B",
 		Value:      "🌋",
 		Descriptor: "Volcano",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-geographic",
+		Version:    "0.6",
 	},
 	"1F30C": Emoji{
 		Key:        "1F30C",
 		Value:      "🌌",
 		Descriptor: "Milky Way",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.6",
 	},
 	"1F30D": Emoji{
 		Key:        "1F30D",
 		Value:      "🌍",
 		Descriptor: "Globe Showing Europe-Africa",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-map",
+		Version:    "0.7",
 	},
 	"1F30E": Emoji{
 		Key:        "1F30E",
 		Value:      "🌎",
 		Descriptor: "Globe Showing Americas",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-map",
+		Version:    "0.7",
 	},
 	"1F30F": Emoji{
 		Key:        "1F30F",
 		Value:      "🌏",
 		Descriptor: "Globe Showing Asia-Australia",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-map",
+		Version:    "0.6",
 	},
 	"1F310": Emoji{
 		Key:        "1F310",
 		Value:      "🌐",
 		Descriptor: "Globe With Meridians",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-map",
+		Version:    "1.0",
 	},
 	"1F311": Emoji{
 		Key:        "1F311",
 		Value:      "🌑",
 		Descriptor: "New Moon",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.6",
 	},
 	"1F312": Emoji{
 		Key:        "1F312",
 		Value:      "🌒",
 		Descriptor: "Waxing Crescent Moon",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "1.0",
 	},
 	"1F313": Emoji{
 		Key:        "1F313",
 		Value:      "🌓",
 		Descriptor: "First Quarter Moon",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.6",
 	},
 	"1F314": Emoji{
 		Key:        "1F314",
 		Value:      "🌔",
 		Descriptor: "Waxing Gibbous Moon",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.6",
 	},
 	"1F315": Emoji{
 		Key:        "1F315",
 		Value:      "🌕",
 		Descriptor: "Full Moon",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.6",
 	},
 	"1F316": Emoji{
 		Key:        "1F316",
 		Value:      "🌖",
 		Descriptor: "Waning Gibbous Moon",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "1.0",
 	},
 	"1F317": Emoji{
 		Key:        "1F317",
 		Value:      "🌗",
 		Descriptor: "Last Quarter Moon",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "1.0",
 	},
 	"1F318": Emoji{
 		Key:        "1F318",
 		Value:      "🌘",
 		Descriptor: "Waning Crescent Moon",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "1.0",
 	},
 	"1F319": Emoji{
 		Key:        "1F319",
 		Value:      "🌙",
 		Descriptor: "Crescent Moon",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.6",
 	},
 	"1F31A": Emoji{
 		Key:        "1F31A",
 		Value:      "🌚",
 		Descriptor: "New Moon Face",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "1.0",
 	},
 	"1F31B": Emoji{
 		Key:        "1F31B",
 		Value:      "🌛",
 		Descriptor: "First Quarter Moon Face",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.6",
 	},
 	"1F31C": Emoji{
 		Key:        "1F31C",
 		Value:      "🌜",
 		Descriptor: "Last Quarter Moon Face",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.7",
 	},
 	"1F31D": Emoji{
 		Key:        "1F31D",
 		Value:      "🌝",
 		Descriptor: "Full Moon Face",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "1.0",
 	},
 	"1F31E": Emoji{
 		Key:        "1F31E",
 		Value:      "🌞",
 		Descriptor: "Sun With Face",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "1.0",
 	},
 	"1F31F": Emoji{
 		Key:        "1F31F",
 		Value:      "🌟",
 		Descriptor: "Glowing Star",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.6",
 	},
 	"1F320": Emoji{
 		Key:        "1F320",
 		Value:      "🌠",
 		Descriptor: "Shooting Star",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.6",
 	},
 	"1F321-FE0F": Emoji{
 		Key:        "1F321-FE0F",
 		Value:      "🌡️",
 		Descriptor: "Thermometer",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.7",
 	},
 	"1F324-FE0F": Emoji{
 		Key:        "1F324-FE0F",
 		Value:      "🌤️",
 		Descriptor: "Sun Behind Small Cloud",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.7",
 	},
 	"1F325-FE0F": Emoji{
 		Key:        "1F325-FE0F",
 		Value:      "🌥️",
 		Descriptor: "Sun Behind Large Cloud",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.7",
 	},
 	"1F326-FE0F": Emoji{
 		Key:        "1F326-FE0F",
 		Value:      "🌦️",
 		Descriptor: "Sun Behind Rain Cloud",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.7",
 	},
 	"1F327-FE0F": Emoji{
 		Key:        "1F327-FE0F",
 		Value:      "🌧️",
 		Descriptor: "Cloud With Rain",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.7",
 	},
 	"1F328-FE0F": Emoji{
 		Key:        "1F328-FE0F",
 		Value:      "🌨️",
 		Descriptor: "Cloud With Snow",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.7",
 	},
 	"1F329-FE0F": Emoji{
 		Key:        "1F329-FE0F",
 		Value:      "🌩️",
 		Descriptor: "Cloud With Lightning",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.7",
 	},
 	"1F32A-FE0F": Emoji{
 		Key:        "1F32A-FE0F",
 		Value:      "🌪️",
 		Descriptor: "Tornado",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.7",
 	},
 	"1F32B-FE0F": Emoji{
 		Key:        "1F32B-FE0F",
 		Value:      "🌫️",
 		Descriptor: "Fog",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.7",
 	},
 	"1F32C-FE0F": Emoji{
 		Key:        "1F32C-FE0F",
 		Value:      "🌬️",
 		Descriptor: "Wind Face",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.7",
 	},
 	"1F32D": Emoji{
 		Key:        "1F32D",
 		Value:      "🌭",
 		Descriptor: "Hot Dog",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "1.0",
 	},
 	"1F32E": Emoji{
 		Key:        "1F32E",
 		Value:      "🌮",
 		Descriptor: "Taco",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "1.0",
 	},
 	"1F32F": Emoji{
 		Key:        "1F32F",
 		Value:      "🌯",
 		Descriptor: "Burrito",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "1.0",
 	},
 	"1F330": Emoji{
 		Key:        "1F330",
 		Value:      "🌰",
 		Descriptor: "Chestnut",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-vegetable",
+		Version:    "0.6",
 	},
 	"1F331": Emoji{
 		Key:        "1F331",
 		Value:      "🌱",
 		Descriptor: "Seedling",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-other",
+		Version:    "0.6",
 	},
 	"1F332": Emoji{
 		Key:        "1F332",
 		Value:      "🌲",
 		Descriptor: "Evergreen Tree",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-other",
+		Version:    "1.0",
 	},
 	"1F333": Emoji{
 		Key:        "1F333",
 		Value:      "🌳",
 		Descriptor: "Deciduous Tree",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-other",
+		Version:    "1.0",
 	},
 	"1F334": Emoji{
 		Key:        "1F334",
 		Value:      "🌴",
 		Descriptor: "Palm Tree",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-other",
+		Version:    "0.6",
 	},
 	"1F335": Emoji{
 		Key:        "1F335",
 		Value:      "🌵",
 		Descriptor: "Cactus",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-other",
+		Version:    "0.6",
 	},
 	"1F336-FE0F": Emoji{
 		Key:        "1F336-FE0F",
 		Value:      "🌶️",
 		Descriptor: "Hot Pepper",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-vegetable",
+		Version:    "0.7",
 	},
 	"1F337": Emoji{
 		Key:        "1F337",
 		Value:      "🌷",
 		Descriptor: "Tulip",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-flower",
+		Version:    "0.6",
 	},
 	"1F338": Emoji{
 		Key:        "1F338",
 		Value:      "🌸",
 		Descriptor: "Cherry Blossom",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-flower",
+		Version:    "0.6",
 	},
 	"1F339": Emoji{
 		Key:        "1F339",
 		Value:      "🌹",
 		Descriptor: "Rose",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-flower",
+		Version:    "0.6",
 	},
 	"1F33A": Emoji{
 		Key:        "1F33A",
 		Value:      "🌺",
 		Descriptor: "Hibiscus",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-flower",
+		Version:    "0.6",
 	},
 	"1F33B": Emoji{
 		Key:        "1F33B",
 		Value:      "🌻",
 		Descriptor: "Sunflower",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-flower",
+		Version:    "0.6",
 	},
 	"1F33C": Emoji{
 		Key:        "1F33C",
 		Value:      "🌼",
 		Descriptor: "Blossom",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-flower",
+		Version:    "0.6",
 	},
 	"1F33D": Emoji{
 		Key:        "1F33D",
 		Value:      "🌽",
 		Descriptor: "Ear of Corn",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-vegetable",
+		Version:    "0.6",
 	},
 	"1F33E": Emoji{
 		Key:        "1F33E",
 		Value:      "🌾",
 		Descriptor: "Sheaf of Rice",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-other",
+		Version:    "0.6",
 	},
 	"1F33F": Emoji{
 		Key:        "1F33F",
 		Value:      "🌿",
 		Descriptor: "Herb",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-other",
+		Version:    "0.6",
 	},
 	"1F340": Emoji{
 		Key:        "1F340",
 		Value:      "🍀",
 		Descriptor: "Four Leaf Clover",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-other",
+		Version:    "0.6",
 	},
 	"1F341": Emoji{
 		Key:        "1F341",
 		Value:      "🍁",
 		Descriptor: "Maple Leaf",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-other",
+		Version:    "0.6",
 	},
 	"1F342": Emoji{
 		Key:        "1F342",
 		Value:      "🍂",
 		Descriptor: "Fallen Leaf",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-other",
+		Version:    "0.6",
 	},
 	"1F343": Emoji{
 		Key:        "1F343",
 		Value:      "🍃",
 		Descriptor: "Leaf Fluttering in Wind",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-other",
+		Version:    "0.6",
 	},
 	"1F344": Emoji{
 		Key:        "1F344",
 		Value:      "🍄",
 		Descriptor: "Mushroom",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-other",
+		Version:    "0.6",
+	},
+	"1F344-200D-1F7EB": Emoji{
+		Key:        "1F344-200D-1F7EB",
+		Value:      "🍄‍🟫",
+		Descriptor: "Brown Mushroom",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-vegetable",
+		Version:    "15.1",
 	},
 	"1F345": Emoji{
 		Key:        "1F345",
 		Value:      "🍅",
 		Descriptor: "Tomato",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-fruit",
+		Version:    "0.6",
 	},
 	"1F346": Emoji{
 		Key:        "1F346",
 		Value:      "🍆",
 		Descriptor: "Eggplant",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-vegetable",
+		Version:    "0.6",
 	},
 	"1F347": Emoji{
 		Key:        "1F347",
 		Value:      "🍇",
 		Descriptor: "Grapes",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-fruit",
+		Version:    "0.6",
 	},
 	"1F348": Emoji{
 		Key:        "1F348",
 		Value:      "🍈",
 		Descriptor: "Melon",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-fruit",
+		Version:    "0.6",
 	},
 	"1F349": Emoji{
 		Key:        "1F349",
 		Value:      "🍉",
 		Descriptor: "Watermelon",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-fruit",
+		Version:    "0.6",
 	},
 	"1F34A": Emoji{
 		Key:        "1F34A",
 		Value:      "🍊",
 		Descriptor: "Tangerine",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-fruit",
+		Version:    "0.6",
 	},
 	"1F34B": Emoji{
 		Key:        "1F34B",
 		Value:      "🍋",
 		Descriptor: "Lemon",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-fruit",
+		Version:    "1.0",
+	},
+	"1F34B-200D-1F7E9": Emoji{
+		Key:        "1F34B-200D-1F7E9",
+		Value:      "🍋‍🟩",
+		Descriptor: "Lime",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-fruit",
+		Version:    "15.1",
 	},
 	"1F34C": Emoji{
 		Key:        "1F34C",
 		Value:      "🍌",
 		Descriptor: "Banana",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-fruit",
+		Version:    "0.6",
 	},
 	"1F34D": Emoji{
 		Key:        "1F34D",
 		Value:      "🍍",
 		Descriptor: "Pineapple",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-fruit",
+		Version:    "0.6",
 	},
 	"1F34E": Emoji{
 		Key:        "1F34E",
 		Value:      "🍎",
 		Descriptor: "Red Apple",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-fruit",
+		Version:    "0.6",
 	},
 	"1F34F": Emoji{
 		Key:        "1F34F",
 		Value:      "🍏",
 		Descriptor: "Green Apple",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-fruit",
+		Version:    "0.6",
 	},
 	"1F350": Emoji{
 		Key:        "1F350",
 		Value:      "🍐",
 		Descriptor: "Pear",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-fruit",
+		Version:    "1.0",
 	},
 	"1F351": Emoji{
 		Key:        "1F351",
 		Value:      "🍑",
 		Descriptor: "Peach",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-fruit",
+		Version:    "0.6",
 	},
 	"1F352": Emoji{
 		Key:        "1F352",
 		Value:      "🍒",
 		Descriptor: "Cherries",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-fruit",
+		Version:    "0.6",
 	},
 	"1F353": Emoji{
 		Key:        "1F353",
 		Value:      "🍓",
 		Descriptor: "Strawberry",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-fruit",
+		Version:    "0.6",
 	},
 	"1F354": Emoji{
 		Key:        "1F354",
 		Value:      "🍔",
 		Descriptor: "Hamburger",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "0.6",
 	},
 	"1F355": Emoji{
 		Key:        "1F355",
 		Value:      "🍕",
 		Descriptor: "Pizza",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "0.6",
 	},
 	"1F356": Emoji{
 		Key:        "1F356",
 		Value:      "🍖",
 		Descriptor: "Meat on Bone",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "0.6",
 	},
 	"1F357": Emoji{
 		Key:        "1F357",
 		Value:      "🍗",
 		Descriptor: "Poultry Leg",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "0.6",
 	},
 	"1F358": Emoji{
 		Key:        "1F358",
 		Value:      "🍘",
 		Descriptor: "Rice Cracker",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-asian",
+		Version:    "0.6",
 	},
 	"1F359": Emoji{
 		Key:        "1F359",
 		Value:      "🍙",
 		Descriptor: "Rice Ball",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-asian",
+		Version:    "0.6",
 	},
 	"1F35A": Emoji{
 		Key:        "1F35A",
 		Value:      "🍚",
 		Descriptor: "Cooked Rice",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-asian",
+		Version:    "0.6",
 	},
 	"1F35B": Emoji{
 		Key:        "1F35B",
 		Value:      "🍛",
 		Descriptor: "Curry Rice",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-asian",
+		Version:    "0.6",
 	},
 	"1F35C": Emoji{
 		Key:        "1F35C",
 		Value:      "🍜",
 		Descriptor: "Steaming Bowl",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-asian",
+		Version:    "0.6",
 	},
 	"1F35D": Emoji{
 		Key:        "1F35D",
 		Value:      "🍝",
 		Descriptor: "Spaghetti",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-asian",
+		Version:    "0.6",
 	},
 	"1F35E": Emoji{
 		Key:        "1F35E",
 		Value:      "🍞",
 		Descriptor: "Bread",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "0.6",
 	},
 	"1F35F": Emoji{
 		Key:        "1F35F",
 		Value:      "🍟",
 		Descriptor: "French Fries",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "0.6",
 	},
 	"1F360": Emoji{
 		Key:        "1F360",
 		Value:      "🍠",
 		Descriptor: "Roasted Sweet Potato",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-asian",
+		Version:    "0.6",
 	},
 	"1F361": Emoji{
 		Key:        "1F361",
 		Value:      "🍡",
 		Descriptor: "Dango",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-asian",
+		Version:    "0.6",
 	},
 	"1F362": Emoji{
 		Key:        "1F362",
 		Value:      "🍢",
 		Descriptor: "Oden",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-asian",
+		Version:    "0.6",
 	},
 	"1F363": Emoji{
 		Key:        "1F363",
 		Value:      "🍣",
 		Descriptor: "Sushi",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-asian",
+		Version:    "0.6",
 	},
 	"1F364": Emoji{
 		Key:        "1F364",
 		Value:      "🍤",
 		Descriptor: "Fried Shrimp",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-asian",
+		Version:    "0.6",
 	},
 	"1F365": Emoji{
 		Key:        "1F365",
 		Value:      "🍥",
 		Descriptor: "Fish Cake With Swirl",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-asian",
+		Version:    "0.6",
 	},
 	"1F366": Emoji{
 		Key:        "1F366",
 		Value:      "🍦",
 		Descriptor: "Soft Ice Cream",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-sweet",
+		Version:    "0.6",
 	},
 	"1F367": Emoji{
 		Key:        "1F367",
 		Value:      "🍧",
 		Descriptor: "Shaved Ice",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-sweet",
+		Version:    "0.6",
 	},
 	"1F368": Emoji{
 		Key:        "1F368",
 		Value:      "🍨",
 		Descriptor: "Ice Cream",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-sweet",
+		Version:    "0.6",
 	},
 	"1F369": Emoji{
 		Key:        "1F369",
 		Value:      "🍩",
 		Descriptor: "Doughnut",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-sweet",
+		Version:    "0.6",
 	},
 	"1F36A": Emoji{
 		Key:        "1F36A",
 		Value:      "🍪",
 		Descriptor: "Cookie",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-sweet",
+		Version:    "0.6",
 	},
 	"1F36B": Emoji{
 		Key:        "1F36B",
 		Value:      "🍫",
 		Descriptor: "Chocolate Bar",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-sweet",
+		Version:    "0.6",
 	},
 	"1F36C": Emoji{
 		Key:        "1F36C",
 		Value:      "🍬",
 		Descriptor: "Candy",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-sweet",
+		Version:    "0.6",
 	},
 	"1F36D": Emoji{
 		Key:        "1F36D",
 		Value:      "🍭",
 		Descriptor: "Lollipop",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-sweet",
+		Version:    "0.6",
 	},
 	"1F36E": Emoji{
 		Key:        "1F36E",
 		Value:      "🍮",
 		Descriptor: "Custard",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-sweet",
+		Version:    "0.6",
 	},
 	"1F36F": Emoji{
 		Key:        "1F36F",
 		Value:      "🍯",
 		Descriptor: "Honey Pot",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-sweet",
+		Version:    "0.6",
 	},
 	"1F370": Emoji{
 		Key:        "1F370",
 		Value:      "🍰",
 		Descriptor: "Shortcake",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-sweet",
+		Version:    "0.6",
 	},
 	"1F371": Emoji{
 		Key:        "1F371",
 		Value:      "🍱",
 		Descriptor: "Bento Box",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-asian",
+		Version:    "0.6",
 	},
 	"1F372": Emoji{
 		Key:        "1F372",
 		Value:      "🍲",
 		Descriptor: "Pot of Food",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "0.6",
 	},
 	"1F373": Emoji{
 		Key:        "1F373",
 		Value:      "🍳",
 		Descriptor: "Cooking",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "0.6",
 	},
 	"1F374": Emoji{
 		Key:        "1F374",
 		Value:      "🍴",
 		Descriptor: "Fork and Knife",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "dishware",
+		Version:    "0.6",
 	},
 	"1F375": Emoji{
 		Key:        "1F375",
 		Value:      "🍵",
 		Descriptor: "Teacup Without Handle",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "drink",
+		Version:    "0.6",
 	},
 	"1F376": Emoji{
 		Key:        "1F376",
 		Value:      "🍶",
 		Descriptor: "Sake",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "drink",
+		Version:    "0.6",
 	},
 	"1F377": Emoji{
 		Key:        "1F377",
 		Value:      "🍷",
 		Descriptor: "Wine Glass",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "drink",
+		Version:    "0.6",
 	},
 	"1F378": Emoji{
 		Key:        "1F378",
 		Value:      "🍸",
 		Descriptor: "Cocktail Glass",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "drink",
+		Version:    "0.6",
 	},
 	"1F379": Emoji{
 		Key:        "1F379",
 		Value:      "🍹",
 		Descriptor: "Tropical Drink",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "drink",
+		Version:    "0.6",
 	},
 	"1F37A": Emoji{
 		Key:        "1F37A",
 		Value:      "🍺",
 		Descriptor: "Beer Mug",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "drink",
+		Version:    "0.6",
 	},
 	"1F37B": Emoji{
 		Key:        "1F37B",
 		Value:      "🍻",
 		Descriptor: "Clinking Beer Mugs",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "drink",
+		Version:    "0.6",
 	},
 	"1F37C": Emoji{
 		Key:        "1F37C",
 		Value:      "🍼",
 		Descriptor: "Baby Bottle",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "drink",
+		Version:    "1.0",
 	},
 	"1F37D-FE0F": Emoji{
 		Key:        "1F37D-FE0F",
 		Value:      "🍽️",
 		Descriptor: "Fork and Knife With Plate",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "dishware",
+		Version:    "0.7",
 	},
 	"1F37E": Emoji{
 		Key:        "1F37E",
 		Value:      "🍾",
 		Descriptor: "Bottle With Popping Cork",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "drink",
+		Version:    "1.0",
 	},
 	"1F37F": Emoji{
 		Key:        "1F37F",
 		Value:      "🍿",
 		Descriptor: "Popcorn",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "1.0",
 	},
 	"1F380": Emoji{
 		Key:        "1F380",
 		Value:      "🎀",
 		Descriptor: "Ribbon",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "event",
+		Version:    "0.6",
 	},
 	"1F381": Emoji{
 		Key:        "1F381",
 		Value:      "🎁",
 		Descriptor: "Wrapped Gift",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "event",
+		Version:    "0.6",
 	},
 	"1F382": Emoji{
 		Key:        "1F382",
 		Value:      "🎂",
 		Descriptor: "Birthday Cake",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-sweet",
+		Version:    "0.6",
 	},
 	"1F383": Emoji{
 		Key:        "1F383",
 		Value:      "🎃",
 		Descriptor: "Jack-O-Lantern",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "event",
+		Version:    "0.6",
 	},
 	"1F384": Emoji{
 		Key:        "1F384",
 		Value:      "🎄",
 		Descriptor: "Christmas Tree",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "event",
+		Version:    "0.6",
 	},
 	"1F385": Emoji{
 		Key:        "1F385",
 		Value:      "🎅",
 		Descriptor: "Santa Claus",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "0.6",
 	},
 	"1F385-1F3FB": Emoji{
 		Key:        "1F385-1F3FB",
 		Value:      "🎅🏻",
 		Descriptor: "Santa Claus: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "1.0",
 	},
 	"1F385-1F3FC": Emoji{
 		Key:        "1F385-1F3FC",
 		Value:      "🎅🏼",
 		Descriptor: "Santa Claus: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "1.0",
 	},
 	"1F385-1F3FD": Emoji{
 		Key:        "1F385-1F3FD",
 		Value:      "🎅🏽",
 		Descriptor: "Santa Claus: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "1.0",
 	},
 	"1F385-1F3FE": Emoji{
 		Key:        "1F385-1F3FE",
 		Value:      "🎅🏾",
 		Descriptor: "Santa Claus: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "1.0",
 	},
 	"1F385-1F3FF": Emoji{
 		Key:        "1F385-1F3FF",
 		Value:      "🎅🏿",
 		Descriptor: "Santa Claus: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "1.0",
 	},
 	"1F386": Emoji{
 		Key:        "1F386",
 		Value:      "🎆",
 		Descriptor: "Fireworks",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "event",
+		Version:    "0.6",
 	},
 	"1F387": Emoji{
 		Key:        "1F387",
 		Value:      "🎇",
 		Descriptor: "Sparkler",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "event",
+		Version:    "0.6",
 	},
 	"1F388": Emoji{
 		Key:        "1F388",
 		Value:      "🎈",
 		Descriptor: "Balloon",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "event",
+		Version:    "0.6",
 	},
 	"1F389": Emoji{
 		Key:        "1F389",
 		Value:      "🎉",
 		Descriptor: "Party Popper",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "event",
+		Version:    "0.6",
 	},
 	"1F38A": Emoji{
 		Key:        "1F38A",
 		Value:      "🎊",
 		Descriptor: "Confetti Ball",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "event",
+		Version:    "0.6",
 	},
 	"1F38B": Emoji{
 		Key:        "1F38B",
 		Value:      "🎋",
 		Descriptor: "Tanabata Tree",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "event",
+		Version:    "0.6",
 	},
 	"1F38C": Emoji{
 		Key:        "1F38C",
 		Value:      "🎌",
 		Descriptor: "Crossed Flags",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "flag",
+		Version:    "0.6",
 	},
 	"1F38D": Emoji{
 		Key:        "1F38D",
 		Value:      "🎍",
 		Descriptor: "Pine Decoration",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "event",
+		Version:    "0.6",
 	},
 	"1F38E": Emoji{
 		Key:        "1F38E",
 		Value:      "🎎",
 		Descriptor: "Japanese Dolls",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "event",
+		Version:    "0.6",
 	},
 	"1F38F": Emoji{
 		Key:        "1F38F",
 		Value:      "🎏",
 		Descriptor: "Carp Streamer",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "event",
+		Version:    "0.6",
 	},
 	"1F390": Emoji{
 		Key:        "1F390",
 		Value:      "🎐",
 		Descriptor: "Wind Chime",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "event",
+		Version:    "0.6",
 	},
 	"1F391": Emoji{
 		Key:        "1F391",
 		Value:      "🎑",
 		Descriptor: "Moon Viewing Ceremony",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "event",
+		Version:    "0.6",
 	},
 	"1F392": Emoji{
 		Key:        "1F392",
 		Value:      "🎒",
 		Descriptor: "Backpack",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F393": Emoji{
 		Key:        "1F393",
 		Value:      "🎓",
 		Descriptor: "Graduation Cap",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F396-FE0F": Emoji{
 		Key:        "1F396-FE0F",
 		Value:      "🎖️",
 		Descriptor: "Military Medal",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "award-medal",
+		Version:    "0.7",
 	},
 	"1F397-FE0F": Emoji{
 		Key:        "1F397-FE0F",
 		Value:      "🎗️",
 		Descriptor: "Reminder Ribbon",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "event",
+		Version:    "0.7",
 	},
 	"1F399-FE0F": Emoji{
 		Key:        "1F399-FE0F",
 		Value:      "🎙️",
 		Descriptor: "Studio Microphone",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "music",
+		Version:    "0.7",
 	},
 	"1F39A-FE0F": Emoji{
 		Key:        "1F39A-FE0F",
 		Value:      "🎚️",
 		Descriptor: "Level Slider",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "music",
+		Version:    "0.7",
 	},
 	"1F39B-FE0F": Emoji{
 		Key:        "1F39B-FE0F",
 		Value:      "🎛️",
 		Descriptor: "Control Knobs",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "music",
+		Version:    "0.7",
 	},
 	"1F39E-FE0F": Emoji{
 		Key:        "1F39E-FE0F",
 		Value:      "🎞️",
 		Descriptor: "Film Frames",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "light & video",
+		Version:    "0.7",
 	},
 	"1F39F-FE0F": Emoji{
 		Key:        "1F39F-FE0F",
 		Value:      "🎟️",
 		Descriptor: "Admission Tickets",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "event",
+		Version:    "0.7",
 	},
 	"1F3A0": Emoji{
 		Key:        "1F3A0",
 		Value:      "🎠",
 		Descriptor: "Carousel Horse",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-other",
+		Version:    "0.6",
 	},
 	"1F3A1": Emoji{
 		Key:        "1F3A1",
 		Value:      "🎡",
 		Descriptor: "Ferris Wheel",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-other",
+		Version:    "0.6",
 	},
 	"1F3A2": Emoji{
 		Key:        "1F3A2",
 		Value:      "🎢",
 		Descriptor: "Roller Coaster",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-other",
+		Version:    "0.6",
 	},
 	"1F3A3": Emoji{
 		Key:        "1F3A3",
 		Value:      "🎣",
 		Descriptor: "Fishing Pole",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "0.6",
 	},
 	"1F3A4": Emoji{
 		Key:        "1F3A4",
 		Value:      "🎤",
 		Descriptor: "Microphone",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "music",
+		Version:    "0.6",
 	},
 	"1F3A5": Emoji{
 		Key:        "1F3A5",
 		Value:      "🎥",
 		Descriptor: "Movie Camera",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "light & video",
+		Version:    "0.6",
 	},
 	"1F3A6": Emoji{
 		Key:        "1F3A6",
 		Value:      "🎦",
 		Descriptor: "Cinema",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "0.6",
 	},
 	"1F3A7": Emoji{
 		Key:        "1F3A7",
 		Value:      "🎧",
 		Descriptor: "Headphone",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "music",
+		Version:    "0.6",
 	},
 	"1F3A8": Emoji{
 		Key:        "1F3A8",
 		Value:      "🎨",
 		Descriptor: "Artist Palette",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "arts & crafts",
+		Version:    "0.6",
 	},
 	"1F3A9": Emoji{
 		Key:        "1F3A9",
 		Value:      "🎩",
 		Descriptor: "Top Hat",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F3AA": Emoji{
 		Key:        "1F3AA",
 		Value:      "🎪",
 		Descriptor: "Circus Tent",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-other",
+		Version:    "0.6",
 	},
 	"1F3AB": Emoji{
 		Key:        "1F3AB",
 		Value:      "🎫",
 		Descriptor: "Ticket",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "event",
+		Version:    "0.6",
 	},
 	"1F3AC": Emoji{
 		Key:        "1F3AC",
 		Value:      "🎬",
 		Descriptor: "Clapper Board",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "light & video",
+		Version:    "0.6",
 	},
 	"1F3AD": Emoji{
 		Key:        "1F3AD",
 		Value:      "🎭",
 		Descriptor: "Performing Arts",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "arts & crafts",
+		Version:    "0.6",
 	},
 	"1F3AE": Emoji{
 		Key:        "1F3AE",
 		Value:      "🎮",
 		Descriptor: "Video Game",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "0.6",
 	},
 	"1F3AF": Emoji{
 		Key:        "1F3AF",
 		Value:      "🎯",
-		Descriptor: "Direct Hit",
+		Descriptor: "Bullseye",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "0.6",
 	},
 	"1F3B0": Emoji{
 		Key:        "1F3B0",
 		Value:      "🎰",
 		Descriptor: "Slot Machine",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "0.6",
 	},
 	"1F3B1": Emoji{
 		Key:        "1F3B1",
 		Value:      "🎱",
 		Descriptor: "Pool 8 Ball",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "0.6",
 	},
 	"1F3B2": Emoji{
 		Key:        "1F3B2",
 		Value:      "🎲",
 		Descriptor: "Game Die",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "0.6",
 	},
 	"1F3B3": Emoji{
 		Key:        "1F3B3",
 		Value:      "🎳",
 		Descriptor: "Bowling",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "0.6",
 	},
 	"1F3B4": Emoji{
 		Key:        "1F3B4",
 		Value:      "🎴",
 		Descriptor: "Flower Playing Cards",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "0.6",
 	},
 	"1F3B5": Emoji{
 		Key:        "1F3B5",
 		Value:      "🎵",
 		Descriptor: "Musical Note",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "music",
+		Version:    "0.6",
 	},
 	"1F3B6": Emoji{
 		Key:        "1F3B6",
 		Value:      "🎶",
 		Descriptor: "Musical Notes",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "music",
+		Version:    "0.6",
 	},
 	"1F3B7": Emoji{
 		Key:        "1F3B7",
 		Value:      "🎷",
 		Descriptor: "Saxophone",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "musical-instrument",
+		Version:    "0.6",
 	},
 	"1F3B8": Emoji{
 		Key:        "1F3B8",
 		Value:      "🎸",
 		Descriptor: "Guitar",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "musical-instrument",
+		Version:    "0.6",
 	},
 	"1F3B9": Emoji{
 		Key:        "1F3B9",
 		Value:      "🎹",
 		Descriptor: "Musical Keyboard",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "musical-instrument",
+		Version:    "0.6",
 	},
 	"1F3BA": Emoji{
 		Key:        "1F3BA",
 		Value:      "🎺",
 		Descriptor: "Trumpet",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "musical-instrument",
+		Version:    "0.6",
 	},
 	"1F3BB": Emoji{
 		Key:        "1F3BB",
 		Value:      "🎻",
 		Descriptor: "Violin",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "musical-instrument",
+		Version:    "0.6",
 	},
 	"1F3BC": Emoji{
 		Key:        "1F3BC",
 		Value:      "🎼",
 		Descriptor: "Musical Score",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "music",
+		Version:    "0.6",
 	},
 	"1F3BD": Emoji{
 		Key:        "1F3BD",
 		Value:      "🎽",
 		Descriptor: "Running Shirt",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "0.6",
 	},
 	"1F3BE": Emoji{
 		Key:        "1F3BE",
 		Value:      "🎾",
 		Descriptor: "Tennis",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "0.6",
 	},
 	"1F3BF": Emoji{
 		Key:        "1F3BF",
 		Value:      "🎿",
 		Descriptor: "Skis",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "0.6",
 	},
 	"1F3C0": Emoji{
 		Key:        "1F3C0",
 		Value:      "🏀",
 		Descriptor: "Basketball",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "0.6",
 	},
 	"1F3C1": Emoji{
 		Key:        "1F3C1",
 		Value:      "🏁",
 		Descriptor: "Chequered Flag",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "flag",
+		Version:    "0.6",
 	},
 	"1F3C2": Emoji{
 		Key:        "1F3C2",
 		Value:      "🏂",
 		Descriptor: "Snowboarder",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "0.6",
+	},
+	"1F3C2-1F3FB": Emoji{
+		Key:        "1F3C2-1F3FB",
+		Value:      "🏂🏻",
+		Descriptor: "Snowboarder: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
+	},
+	"1F3C2-1F3FC": Emoji{
+		Key:        "1F3C2-1F3FC",
+		Value:      "🏂🏼",
+		Descriptor: "Snowboarder: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
+	},
+	"1F3C2-1F3FD": Emoji{
+		Key:        "1F3C2-1F3FD",
+		Value:      "🏂🏽",
+		Descriptor: "Snowboarder: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
+	},
+	"1F3C2-1F3FE": Emoji{
+		Key:        "1F3C2-1F3FE",
+		Value:      "🏂🏾",
+		Descriptor: "Snowboarder: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
+	},
+	"1F3C2-1F3FF": Emoji{
+		Key:        "1F3C2-1F3FF",
+		Value:      "🏂🏿",
+		Descriptor: "Snowboarder: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F3C3": Emoji{
 		Key:        "1F3C3",
 		Value:      "🏃",
 		Descriptor: "Person Running",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "0.6",
 	},
 	"1F3C3-1F3FB": Emoji{
 		Key:        "1F3C3-1F3FB",
 		Value:      "🏃🏻",
 		Descriptor: "Person Running: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F3C3-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F3C3-1F3FB-200D-2640-FE0F",
 		Value:      "🏃🏻‍♀️",
 		Descriptor: "Woman Running: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F3C3-1F3FB-200D-2640-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F3C3-1F3FB-200D-2640-FE0F-200D-27A1-FE0F",
+		Value:      "🏃🏻‍♀️‍➡️",
+		Descriptor: "Woman Running Facing Right: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F3C3-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F3C3-1F3FB-200D-2642-FE0F",
 		Value:      "🏃🏻‍♂️",
 		Descriptor: "Man Running: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F3C3-1F3FB-200D-2642-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F3C3-1F3FB-200D-2642-FE0F-200D-27A1-FE0F",
+		Value:      "🏃🏻‍♂️‍➡️",
+		Descriptor: "Man Running Facing Right: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F3C3-1F3FB-200D-27A1-FE0F": Emoji{
+		Key:        "1F3C3-1F3FB-200D-27A1-FE0F",
+		Value:      "🏃🏻‍➡️",
+		Descriptor: "Person Running Facing Right: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F3C3-1F3FC": Emoji{
 		Key:        "1F3C3-1F3FC",
 		Value:      "🏃🏼",
 		Descriptor: "Person Running: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F3C3-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F3C3-1F3FC-200D-2640-FE0F",
 		Value:      "🏃🏼‍♀️",
 		Descriptor: "Woman Running: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F3C3-1F3FC-200D-2640-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F3C3-1F3FC-200D-2640-FE0F-200D-27A1-FE0F",
+		Value:      "🏃🏼‍♀️‍➡️",
+		Descriptor: "Woman Running Facing Right: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F3C3-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F3C3-1F3FC-200D-2642-FE0F",
 		Value:      "🏃🏼‍♂️",
 		Descriptor: "Man Running: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F3C3-1F3FC-200D-2642-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F3C3-1F3FC-200D-2642-FE0F-200D-27A1-FE0F",
+		Value:      "🏃🏼‍♂️‍➡️",
+		Descriptor: "Man Running Facing Right: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F3C3-1F3FC-200D-27A1-FE0F": Emoji{
+		Key:        "1F3C3-1F3FC-200D-27A1-FE0F",
+		Value:      "🏃🏼‍➡️",
+		Descriptor: "Person Running Facing Right: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F3C3-1F3FD": Emoji{
 		Key:        "1F3C3-1F3FD",
 		Value:      "🏃🏽",
 		Descriptor: "Person Running: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F3C3-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F3C3-1F3FD-200D-2640-FE0F",
 		Value:      "🏃🏽‍♀️",
 		Descriptor: "Woman Running: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F3C3-1F3FD-200D-2640-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F3C3-1F3FD-200D-2640-FE0F-200D-27A1-FE0F",
+		Value:      "🏃🏽‍♀️‍➡️",
+		Descriptor: "Woman Running Facing Right: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F3C3-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F3C3-1F3FD-200D-2642-FE0F",
 		Value:      "🏃🏽‍♂️",
 		Descriptor: "Man Running: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F3C3-1F3FD-200D-2642-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F3C3-1F3FD-200D-2642-FE0F-200D-27A1-FE0F",
+		Value:      "🏃🏽‍♂️‍➡️",
+		Descriptor: "Man Running Facing Right: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F3C3-1F3FD-200D-27A1-FE0F": Emoji{
+		Key:        "1F3C3-1F3FD-200D-27A1-FE0F",
+		Value:      "🏃🏽‍➡️",
+		Descriptor: "Person Running Facing Right: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F3C3-1F3FE": Emoji{
 		Key:        "1F3C3-1F3FE",
 		Value:      "🏃🏾",
 		Descriptor: "Person Running: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F3C3-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F3C3-1F3FE-200D-2640-FE0F",
 		Value:      "🏃🏾‍♀️",
 		Descriptor: "Woman Running: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F3C3-1F3FE-200D-2640-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F3C3-1F3FE-200D-2640-FE0F-200D-27A1-FE0F",
+		Value:      "🏃🏾‍♀️‍➡️",
+		Descriptor: "Woman Running Facing Right: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F3C3-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F3C3-1F3FE-200D-2642-FE0F",
 		Value:      "🏃🏾‍♂️",
 		Descriptor: "Man Running: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F3C3-1F3FE-200D-2642-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F3C3-1F3FE-200D-2642-FE0F-200D-27A1-FE0F",
+		Value:      "🏃🏾‍♂️‍➡️",
+		Descriptor: "Man Running Facing Right: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F3C3-1F3FE-200D-27A1-FE0F": Emoji{
+		Key:        "1F3C3-1F3FE-200D-27A1-FE0F",
+		Value:      "🏃🏾‍➡️",
+		Descriptor: "Person Running Facing Right: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F3C3-1F3FF": Emoji{
 		Key:        "1F3C3-1F3FF",
 		Value:      "🏃🏿",
 		Descriptor: "Person Running: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F3C3-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F3C3-1F3FF-200D-2640-FE0F",
 		Value:      "🏃🏿‍♀️",
 		Descriptor: "Woman Running: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F3C3-1F3FF-200D-2640-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F3C3-1F3FF-200D-2640-FE0F-200D-27A1-FE0F",
+		Value:      "🏃🏿‍♀️‍➡️",
+		Descriptor: "Woman Running Facing Right: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F3C3-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F3C3-1F3FF-200D-2642-FE0F",
 		Value:      "🏃🏿‍♂️",
 		Descriptor: "Man Running: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F3C3-1F3FF-200D-2642-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F3C3-1F3FF-200D-2642-FE0F-200D-27A1-FE0F",
+		Value:      "🏃🏿‍♂️‍➡️",
+		Descriptor: "Man Running Facing Right: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F3C3-1F3FF-200D-27A1-FE0F": Emoji{
+		Key:        "1F3C3-1F3FF-200D-27A1-FE0F",
+		Value:      "🏃🏿‍➡️",
+		Descriptor: "Person Running Facing Right: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F3C3-200D-2640-FE0F": Emoji{
 		Key:        "1F3C3-200D-2640-FE0F",
 		Value:      "🏃‍♀️",
 		Descriptor: "Woman Running",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F3C3-200D-2640-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F3C3-200D-2640-FE0F-200D-27A1-FE0F",
+		Value:      "🏃‍♀️‍➡️",
+		Descriptor: "Woman Running Facing Right",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F3C3-200D-2642-FE0F": Emoji{
 		Key:        "1F3C3-200D-2642-FE0F",
 		Value:      "🏃‍♂️",
 		Descriptor: "Man Running",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F3C3-200D-2642-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F3C3-200D-2642-FE0F-200D-27A1-FE0F",
+		Value:      "🏃‍♂️‍➡️",
+		Descriptor: "Man Running Facing Right",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F3C3-200D-27A1-FE0F": Emoji{
+		Key:        "1F3C3-200D-27A1-FE0F",
+		Value:      "🏃‍➡️",
+		Descriptor: "Person Running Facing Right",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F3C4": Emoji{
 		Key:        "1F3C4",
 		Value:      "🏄",
 		Descriptor: "Person Surfing",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "0.6",
 	},
 	"1F3C4-1F3FB": Emoji{
 		Key:        "1F3C4-1F3FB",
 		Value:      "🏄🏻",
 		Descriptor: "Person Surfing: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F3C4-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F3C4-1F3FB-200D-2640-FE0F",
 		Value:      "🏄🏻‍♀️",
 		Descriptor: "Woman Surfing: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3C4-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F3C4-1F3FB-200D-2642-FE0F",
 		Value:      "🏄🏻‍♂️",
 		Descriptor: "Man Surfing: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3C4-1F3FC": Emoji{
 		Key:        "1F3C4-1F3FC",
 		Value:      "🏄🏼",
 		Descriptor: "Person Surfing: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F3C4-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F3C4-1F3FC-200D-2640-FE0F",
 		Value:      "🏄🏼‍♀️",
 		Descriptor: "Woman Surfing: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3C4-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F3C4-1F3FC-200D-2642-FE0F",
 		Value:      "🏄🏼‍♂️",
 		Descriptor: "Man Surfing: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3C4-1F3FD": Emoji{
 		Key:        "1F3C4-1F3FD",
 		Value:      "🏄🏽",
 		Descriptor: "Person Surfing: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F3C4-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F3C4-1F3FD-200D-2640-FE0F",
 		Value:      "🏄🏽‍♀️",
 		Descriptor: "Woman Surfing: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3C4-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F3C4-1F3FD-200D-2642-FE0F",
 		Value:      "🏄🏽‍♂️",
 		Descriptor: "Man Surfing: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3C4-1F3FE": Emoji{
 		Key:        "1F3C4-1F3FE",
 		Value:      "🏄🏾",
 		Descriptor: "Person Surfing: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F3C4-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F3C4-1F3FE-200D-2640-FE0F",
 		Value:      "🏄🏾‍♀️",
 		Descriptor: "Woman Surfing: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3C4-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F3C4-1F3FE-200D-2642-FE0F",
 		Value:      "🏄🏾‍♂️",
 		Descriptor: "Man Surfing: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3C4-1F3FF": Emoji{
 		Key:        "1F3C4-1F3FF",
 		Value:      "🏄🏿",
 		Descriptor: "Person Surfing: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F3C4-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F3C4-1F3FF-200D-2640-FE0F",
 		Value:      "🏄🏿‍♀️",
 		Descriptor: "Woman Surfing: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3C4-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F3C4-1F3FF-200D-2642-FE0F",
 		Value:      "🏄🏿‍♂️",
 		Descriptor: "Man Surfing: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3C4-200D-2640-FE0F": Emoji{
 		Key:        "1F3C4-200D-2640-FE0F",
 		Value:      "🏄‍♀️",
 		Descriptor: "Woman Surfing",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3C4-200D-2642-FE0F": Emoji{
 		Key:        "1F3C4-200D-2642-FE0F",
 		Value:      "🏄‍♂️",
 		Descriptor: "Man Surfing",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3C5": Emoji{
 		Key:        "1F3C5",
 		Value:      "🏅",
 		Descriptor: "Sports Medal",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "award-medal",
+		Version:    "1.0",
 	},
 	"1F3C6": Emoji{
 		Key:        "1F3C6",
 		Value:      "🏆",
 		Descriptor: "Trophy",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "award-medal",
+		Version:    "0.6",
 	},
 	"1F3C7": Emoji{
 		Key:        "1F3C7",
 		Value:      "🏇",
 		Descriptor: "Horse Racing",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F3C7-1F3FB": Emoji{
 		Key:        "1F3C7-1F3FB",
 		Value:      "🏇🏻",
 		Descriptor: "Horse Racing: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F3C7-1F3FC": Emoji{
 		Key:        "1F3C7-1F3FC",
 		Value:      "🏇🏼",
 		Descriptor: "Horse Racing: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F3C7-1F3FD": Emoji{
 		Key:        "1F3C7-1F3FD",
 		Value:      "🏇🏽",
 		Descriptor: "Horse Racing: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F3C7-1F3FE": Emoji{
 		Key:        "1F3C7-1F3FE",
 		Value:      "🏇🏾",
 		Descriptor: "Horse Racing: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F3C7-1F3FF": Emoji{
 		Key:        "1F3C7-1F3FF",
 		Value:      "🏇🏿",
 		Descriptor: "Horse Racing: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F3C8": Emoji{
 		Key:        "1F3C8",
 		Value:      "🏈",
 		Descriptor: "American Football",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "0.6",
 	},
 	"1F3C9": Emoji{
 		Key:        "1F3C9",
 		Value:      "🏉",
 		Descriptor: "Rugby Football",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "1.0",
 	},
 	"1F3CA": Emoji{
 		Key:        "1F3CA",
 		Value:      "🏊",
 		Descriptor: "Person Swimming",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "0.6",
 	},
 	"1F3CA-1F3FB": Emoji{
 		Key:        "1F3CA-1F3FB",
 		Value:      "🏊🏻",
 		Descriptor: "Person Swimming: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F3CA-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F3CA-1F3FB-200D-2640-FE0F",
 		Value:      "🏊🏻‍♀️",
 		Descriptor: "Woman Swimming: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CA-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F3CA-1F3FB-200D-2642-FE0F",
 		Value:      "🏊🏻‍♂️",
 		Descriptor: "Man Swimming: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CA-1F3FC": Emoji{
 		Key:        "1F3CA-1F3FC",
 		Value:      "🏊🏼",
 		Descriptor: "Person Swimming: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F3CA-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F3CA-1F3FC-200D-2640-FE0F",
 		Value:      "🏊🏼‍♀️",
 		Descriptor: "Woman Swimming: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CA-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F3CA-1F3FC-200D-2642-FE0F",
 		Value:      "🏊🏼‍♂️",
 		Descriptor: "Man Swimming: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CA-1F3FD": Emoji{
 		Key:        "1F3CA-1F3FD",
 		Value:      "🏊🏽",
 		Descriptor: "Person Swimming: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F3CA-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F3CA-1F3FD-200D-2640-FE0F",
 		Value:      "🏊🏽‍♀️",
 		Descriptor: "Woman Swimming: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CA-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F3CA-1F3FD-200D-2642-FE0F",
 		Value:      "🏊🏽‍♂️",
 		Descriptor: "Man Swimming: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CA-1F3FE": Emoji{
 		Key:        "1F3CA-1F3FE",
 		Value:      "🏊🏾",
 		Descriptor: "Person Swimming: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F3CA-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F3CA-1F3FE-200D-2640-FE0F",
 		Value:      "🏊🏾‍♀️",
 		Descriptor: "Woman Swimming: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CA-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F3CA-1F3FE-200D-2642-FE0F",
 		Value:      "🏊🏾‍♂️",
 		Descriptor: "Man Swimming: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CA-1F3FF": Emoji{
 		Key:        "1F3CA-1F3FF",
 		Value:      "🏊🏿",
 		Descriptor: "Person Swimming: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F3CA-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F3CA-1F3FF-200D-2640-FE0F",
 		Value:      "🏊🏿‍♀️",
 		Descriptor: "Woman Swimming: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CA-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F3CA-1F3FF-200D-2642-FE0F",
 		Value:      "🏊🏿‍♂️",
 		Descriptor: "Man Swimming: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CA-200D-2640-FE0F": Emoji{
 		Key:        "1F3CA-200D-2640-FE0F",
 		Value:      "🏊‍♀️",
 		Descriptor: "Woman Swimming",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CA-200D-2642-FE0F": Emoji{
 		Key:        "1F3CA-200D-2642-FE0F",
 		Value:      "🏊‍♂️",
 		Descriptor: "Man Swimming",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CB-1F3FB": Emoji{
 		Key:        "1F3CB-1F3FB",
 		Value:      "🏋🏻",
 		Descriptor: "Person Lifting Weights: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "2.0",
 	},
 	"1F3CB-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F3CB-1F3FB-200D-2640-FE0F",
 		Value:      "🏋🏻‍♀️",
 		Descriptor: "Woman Lifting Weights: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CB-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F3CB-1F3FB-200D-2642-FE0F",
 		Value:      "🏋🏻‍♂️",
 		Descriptor: "Man Lifting Weights: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CB-1F3FC": Emoji{
 		Key:        "1F3CB-1F3FC",
 		Value:      "🏋🏼",
 		Descriptor: "Person Lifting Weights: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "2.0",
 	},
 	"1F3CB-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F3CB-1F3FC-200D-2640-FE0F",
 		Value:      "🏋🏼‍♀️",
 		Descriptor: "Woman Lifting Weights: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CB-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F3CB-1F3FC-200D-2642-FE0F",
 		Value:      "🏋🏼‍♂️",
 		Descriptor: "Man Lifting Weights: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CB-1F3FD": Emoji{
 		Key:        "1F3CB-1F3FD",
 		Value:      "🏋🏽",
 		Descriptor: "Person Lifting Weights: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "2.0",
 	},
 	"1F3CB-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F3CB-1F3FD-200D-2640-FE0F",
 		Value:      "🏋🏽‍♀️",
 		Descriptor: "Woman Lifting Weights: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CB-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F3CB-1F3FD-200D-2642-FE0F",
 		Value:      "🏋🏽‍♂️",
 		Descriptor: "Man Lifting Weights: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CB-1F3FE": Emoji{
 		Key:        "1F3CB-1F3FE",
 		Value:      "🏋🏾",
 		Descriptor: "Person Lifting Weights: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "2.0",
 	},
 	"1F3CB-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F3CB-1F3FE-200D-2640-FE0F",
 		Value:      "🏋🏾‍♀️",
 		Descriptor: "Woman Lifting Weights: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CB-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F3CB-1F3FE-200D-2642-FE0F",
 		Value:      "🏋🏾‍♂️",
 		Descriptor: "Man Lifting Weights: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CB-1F3FF": Emoji{
 		Key:        "1F3CB-1F3FF",
 		Value:      "🏋🏿",
 		Descriptor: "Person Lifting Weights: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "2.0",
 	},
 	"1F3CB-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F3CB-1F3FF-200D-2640-FE0F",
 		Value:      "🏋🏿‍♀️",
 		Descriptor: "Woman Lifting Weights: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CB-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F3CB-1F3FF-200D-2642-FE0F",
 		Value:      "🏋🏿‍♂️",
 		Descriptor: "Man Lifting Weights: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CB-FE0F": Emoji{
 		Key:        "1F3CB-FE0F",
 		Value:      "🏋️",
 		Descriptor: "Person Lifting Weights",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "0.7",
 	},
 	"1F3CB-FE0F-200D-2640-FE0F": Emoji{
 		Key:        "1F3CB-FE0F-200D-2640-FE0F",
 		Value:      "🏋️‍♀️",
 		Descriptor: "Woman Lifting Weights",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CB-FE0F-200D-2642-FE0F": Emoji{
 		Key:        "1F3CB-FE0F-200D-2642-FE0F",
 		Value:      "🏋️‍♂️",
 		Descriptor: "Man Lifting Weights",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CC-1F3FB": Emoji{
 		Key:        "1F3CC-1F3FB",
 		Value:      "🏌🏻",
 		Descriptor: "Person Golfing: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CC-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F3CC-1F3FB-200D-2640-FE0F",
 		Value:      "🏌🏻‍♀️",
 		Descriptor: "Woman Golfing: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CC-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F3CC-1F3FB-200D-2642-FE0F",
 		Value:      "🏌🏻‍♂️",
 		Descriptor: "Man Golfing: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CC-1F3FC": Emoji{
 		Key:        "1F3CC-1F3FC",
 		Value:      "🏌🏼",
 		Descriptor: "Person Golfing: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CC-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F3CC-1F3FC-200D-2640-FE0F",
 		Value:      "🏌🏼‍♀️",
 		Descriptor: "Woman Golfing: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CC-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F3CC-1F3FC-200D-2642-FE0F",
 		Value:      "🏌🏼‍♂️",
 		Descriptor: "Man Golfing: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CC-1F3FD": Emoji{
 		Key:        "1F3CC-1F3FD",
 		Value:      "🏌🏽",
 		Descriptor: "Person Golfing: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CC-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F3CC-1F3FD-200D-2640-FE0F",
 		Value:      "🏌🏽‍♀️",
 		Descriptor: "Woman Golfing: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CC-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F3CC-1F3FD-200D-2642-FE0F",
 		Value:      "🏌🏽‍♂️",
 		Descriptor: "Man Golfing: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CC-1F3FE": Emoji{
 		Key:        "1F3CC-1F3FE",
 		Value:      "🏌🏾",
 		Descriptor: "Person Golfing: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CC-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F3CC-1F3FE-200D-2640-FE0F",
 		Value:      "🏌🏾‍♀️",
 		Descriptor: "Woman Golfing: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CC-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F3CC-1F3FE-200D-2642-FE0F",
 		Value:      "🏌🏾‍♂️",
 		Descriptor: "Man Golfing: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CC-1F3FF": Emoji{
 		Key:        "1F3CC-1F3FF",
 		Value:      "🏌🏿",
 		Descriptor: "Person Golfing: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CC-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F3CC-1F3FF-200D-2640-FE0F",
 		Value:      "🏌🏿‍♀️",
 		Descriptor: "Woman Golfing: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CC-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F3CC-1F3FF-200D-2642-FE0F",
 		Value:      "🏌🏿‍♂️",
 		Descriptor: "Man Golfing: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CC-FE0F": Emoji{
 		Key:        "1F3CC-FE0F",
 		Value:      "🏌️",
 		Descriptor: "Person Golfing",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "0.7",
 	},
 	"1F3CC-FE0F-200D-2640-FE0F": Emoji{
 		Key:        "1F3CC-FE0F-200D-2640-FE0F",
 		Value:      "🏌️‍♀️",
 		Descriptor: "Woman Golfing",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CC-FE0F-200D-2642-FE0F": Emoji{
 		Key:        "1F3CC-FE0F-200D-2642-FE0F",
 		Value:      "🏌️‍♂️",
 		Descriptor: "Man Golfing",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F3CD-FE0F": Emoji{
 		Key:        "1F3CD-FE0F",
 		Value:      "🏍️",
 		Descriptor: "Motorcycle",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.7",
 	},
 	"1F3CE-FE0F": Emoji{
 		Key:        "1F3CE-FE0F",
 		Value:      "🏎️",
 		Descriptor: "Racing Car",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.7",
 	},
 	"1F3CF": Emoji{
 		Key:        "1F3CF",
 		Value:      "🏏",
 		Descriptor: "Cricket Game",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "1.0",
 	},
 	"1F3D0": Emoji{
 		Key:        "1F3D0",
 		Value:      "🏐",
 		Descriptor: "Volleyball",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "1.0",
 	},
 	"1F3D1": Emoji{
 		Key:        "1F3D1",
 		Value:      "🏑",
 		Descriptor: "Field Hockey",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "1.0",
 	},
 	"1F3D2": Emoji{
 		Key:        "1F3D2",
 		Value:      "🏒",
 		Descriptor: "Ice Hockey",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "1.0",
 	},
 	"1F3D3": Emoji{
 		Key:        "1F3D3",
 		Value:      "🏓",
 		Descriptor: "Ping Pong",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "1.0",
 	},
 	"1F3D4-FE0F": Emoji{
 		Key:        "1F3D4-FE0F",
 		Value:      "🏔️",
 		Descriptor: "Snow-Capped Mountain",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-geographic",
+		Version:    "0.7",
 	},
 	"1F3D5-FE0F": Emoji{
 		Key:        "1F3D5-FE0F",
 		Value:      "🏕️",
 		Descriptor: "Camping",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-geographic",
+		Version:    "0.7",
 	},
 	"1F3D6-FE0F": Emoji{
 		Key:        "1F3D6-FE0F",
 		Value:      "🏖️",
 		Descriptor: "Beach With Umbrella",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-geographic",
+		Version:    "0.7",
 	},
 	"1F3D7-FE0F": Emoji{
 		Key:        "1F3D7-FE0F",
 		Value:      "🏗️",
 		Descriptor: "Building Construction",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "0.7",
 	},
 	"1F3D8-FE0F": Emoji{
 		Key:        "1F3D8-FE0F",
 		Value:      "🏘️",
 		Descriptor: "Houses",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "0.7",
 	},
 	"1F3D9-FE0F": Emoji{
 		Key:        "1F3D9-FE0F",
 		Value:      "🏙️",
 		Descriptor: "Cityscape",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-other",
+		Version:    "0.7",
 	},
 	"1F3DA-FE0F": Emoji{
 		Key:        "1F3DA-FE0F",
 		Value:      "🏚️",
 		Descriptor: "Derelict House",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "0.7",
 	},
 	"1F3DB-FE0F": Emoji{
 		Key:        "1F3DB-FE0F",
 		Value:      "🏛️",
 		Descriptor: "Classical Building",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "0.7",
 	},
 	"1F3DC-FE0F": Emoji{
 		Key:        "1F3DC-FE0F",
 		Value:      "🏜️",
 		Descriptor: "Desert",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-geographic",
+		Version:    "0.7",
 	},
 	"1F3DD-FE0F": Emoji{
 		Key:        "1F3DD-FE0F",
 		Value:      "🏝️",
 		Descriptor: "Desert Island",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-geographic",
+		Version:    "0.7",
 	},
 	"1F3DE-FE0F": Emoji{
 		Key:        "1F3DE-FE0F",
 		Value:      "🏞️",
 		Descriptor: "National Park",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-geographic",
+		Version:    "0.7",
 	},
 	"1F3DF-FE0F": Emoji{
 		Key:        "1F3DF-FE0F",
 		Value:      "🏟️",
 		Descriptor: "Stadium",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "0.7",
 	},
 	"1F3E0": Emoji{
 		Key:        "1F3E0",
 		Value:      "🏠",
 		Descriptor: "House",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "0.6",
 	},
 	"1F3E1": Emoji{
 		Key:        "1F3E1",
 		Value:      "🏡",
 		Descriptor: "House With Garden",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "0.6",
 	},
 	"1F3E2": Emoji{
 		Key:        "1F3E2",
 		Value:      "🏢",
 		Descriptor: "Office Building",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "0.6",
 	},
 	"1F3E3": Emoji{
 		Key:        "1F3E3",
 		Value:      "🏣",
 		Descriptor: "Japanese Post Office",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "0.6",
 	},
 	"1F3E4": Emoji{
 		Key:        "1F3E4",
 		Value:      "🏤",
 		Descriptor: "Post Office",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "1.0",
 	},
 	"1F3E5": Emoji{
 		Key:        "1F3E5",
 		Value:      "🏥",
 		Descriptor: "Hospital",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "0.6",
 	},
 	"1F3E6": Emoji{
 		Key:        "1F3E6",
 		Value:      "🏦",
 		Descriptor: "Bank",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "0.6",
 	},
 	"1F3E7": Emoji{
 		Key:        "1F3E7",
 		Value:      "🏧",
 		Descriptor: "ATM Sign",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "transport-sign",
+		Version:    "0.6",
 	},
 	"1F3E8": Emoji{
 		Key:        "1F3E8",
 		Value:      "🏨",
 		Descriptor: "Hotel",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "0.6",
 	},
 	"1F3E9": Emoji{
 		Key:        "1F3E9",
 		Value:      "🏩",
 		Descriptor: "Love Hotel",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "0.6",
 	},
 	"1F3EA": Emoji{
 		Key:        "1F3EA",
 		Value:      "🏪",
 		Descriptor: "Convenience Store",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "0.6",
 	},
 	"1F3EB": Emoji{
 		Key:        "1F3EB",
 		Value:      "🏫",
 		Descriptor: "School",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "0.6",
 	},
 	"1F3EC": Emoji{
 		Key:        "1F3EC",
 		Value:      "🏬",
 		Descriptor: "Department Store",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "0.6",
 	},
 	"1F3ED": Emoji{
 		Key:        "1F3ED",
 		Value:      "🏭",
 		Descriptor: "Factory",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "0.6",
 	},
 	"1F3EE": Emoji{
 		Key:        "1F3EE",
 		Value:      "🏮",
 		Descriptor: "Red Paper Lantern",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "light & video",
+		Version:    "0.6",
 	},
 	"1F3EF": Emoji{
 		Key:        "1F3EF",
 		Value:      "🏯",
 		Descriptor: "Japanese Castle",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "0.6",
 	},
 	"1F3F0": Emoji{
 		Key:        "1F3F0",
 		Value:      "🏰",
 		Descriptor: "Castle",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "0.6",
 	},
 	"1F3F3-FE0F": Emoji{
 		Key:        "1F3F3-FE0F",
 		Value:      "🏳️",
 		Descriptor: "White Flag",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "flag",
+		Version:    "0.7",
 	},
 	"1F3F3-FE0F-200D-1F308": Emoji{
 		Key:        "1F3F3-FE0F-200D-1F308",
 		Value:      "🏳️‍🌈",
 		Descriptor: "Rainbow Flag",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "flag",
+		Version:    "4.0",
+	},
+	"1F3F3-FE0F-200D-26A7-FE0F": Emoji{
+		Key:        "1F3F3-FE0F-200D-26A7-FE0F",
+		Value:      "🏳️‍⚧️",
+		Descriptor: "Transgender Flag",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "flag",
+		Version:    "13.0",
 	},
 	"1F3F4": Emoji{
 		Key:        "1F3F4",
 		Value:      "🏴",
 		Descriptor: "Black Flag",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "flag",
+		Version:    "1.0",
 	},
 	"1F3F4-200D-2620-FE0F": Emoji{
 		Key:        "1F3F4-200D-2620-FE0F",
 		Value:      "🏴‍☠️",
 		Descriptor: "Pirate Flag",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "flag",
+		Version:    "11.0",
 	},
 	"1F3F4-E0067-E0062-E0065-E006E-E0067-E007F": Emoji{
 		Key:        "1F3F4-E0067-E0062-E0065-E006E-E0067-E007F",
 		Value:      "🏴󠁧󠁢󠁥󠁮󠁧󠁿",
 		Descriptor: "Flag: England",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "subdivision-flag",
+		Version:    "5.0",
 	},
 	"1F3F4-E0067-E0062-E0073-E0063-E0074-E007F": Emoji{
 		Key:        "1F3F4-E0067-E0062-E0073-E0063-E0074-E007F",
 		Value:      "🏴󠁧󠁢󠁳󠁣󠁴󠁿",
 		Descriptor: "Flag: Scotland",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "subdivision-flag",
+		Version:    "5.0",
 	},
 	"1F3F4-E0067-E0062-E0077-E006C-E0073-E007F": Emoji{
 		Key:        "1F3F4-E0067-E0062-E0077-E006C-E0073-E007F",
 		Value:      "🏴󠁧󠁢󠁷󠁬󠁳󠁿",
 		Descriptor: "Flag: Wales",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "subdivision-flag",
+		Version:    "5.0",
 	},
 	"1F3F5-FE0F": Emoji{
 		Key:        "1F3F5-FE0F",
 		Value:      "🏵️",
 		Descriptor: "Rosette",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-flower",
+		Version:    "0.7",
 	},
 	"1F3F7-FE0F": Emoji{
 		Key:        "1F3F7-FE0F",
 		Value:      "🏷️",
 		Descriptor: "Label",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "book-paper",
+		Version:    "0.7",
 	},
 	"1F3F8": Emoji{
 		Key:        "1F3F8",
 		Value:      "🏸",
 		Descriptor: "Badminton",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "1.0",
 	},
 	"1F3F9": Emoji{
 		Key:        "1F3F9",
 		Value:      "🏹",
 		Descriptor: "Bow and Arrow",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "1.0",
 	},
 	"1F3FA": Emoji{
 		Key:        "1F3FA",
 		Value:      "🏺",
 		Descriptor: "Amphora",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "dishware",
+		Version:    "1.0",
 	},
 	"1F3FB": Emoji{
 		Key:        "1F3FB",
 		Value:      "🏻",
 		Descriptor: "Light Skin Tone",
+		Status:     "component",
+		Group:      "Component",
+		Subgroup:   "skin-tone",
+		Version:    "1.0",
 	},
 	"1F3FC": Emoji{
 		Key:        "1F3FC",
 		Value:      "🏼",
 		Descriptor: "Medium-Light Skin Tone",
+		Status:     "component",
+		Group:      "Component",
+		Subgroup:   "skin-tone",
+		Version:    "1.0",
 	},
 	"1F3FD": Emoji{
 		Key:        "1F3FD",
 		Value:      "🏽",
 		Descriptor: "Medium Skin Tone",
+		Status:     "component",
+		Group:      "Component",
+		Subgroup:   "skin-tone",
+		Version:    "1.0",
 	},
 	"1F3FE": Emoji{
 		Key:        "1F3FE",
 		Value:      "🏾",
 		Descriptor: "Medium-Dark Skin Tone",
+		Status:     "component",
+		Group:      "Component",
+		Subgroup:   "skin-tone",
+		Version:    "1.0",
 	},
 	"1F3FF": Emoji{
 		Key:        "1F3FF",
 		Value:      "🏿",
 		Descriptor: "Dark Skin Tone",
+		Status:     "component",
+		Group:      "Component",
+		Subgroup:   "skin-tone",
+		Version:    "1.0",
 	},
 	"1F400": Emoji{
 		Key:        "1F400",
 		Value:      "🐀",
 		Descriptor: "Rat",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "1.0",
 	},
 	"1F401": Emoji{
 		Key:        "1F401",
 		Value:      "🐁",
 		Descriptor: "Mouse",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "1.0",
 	},
 	"1F402": Emoji{
 		Key:        "1F402",
 		Value:      "🐂",
 		Descriptor: "Ox",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "1.0",
 	},
 	"1F403": Emoji{
 		Key:        "1F403",
 		Value:      "🐃",
 		Descriptor: "Water Buffalo",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "1.0",
 	},
 	"1F404": Emoji{
 		Key:        "1F404",
 		Value:      "🐄",
 		Descriptor: "Cow",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "1.0",
 	},
 	"1F405": Emoji{
 		Key:        "1F405",
 		Value:      "🐅",
 		Descriptor: "Tiger",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "1.0",
 	},
 	"1F406": Emoji{
 		Key:        "1F406",
 		Value:      "🐆",
 		Descriptor: "Leopard",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "1.0",
 	},
 	"1F407": Emoji{
 		Key:        "1F407",
 		Value:      "🐇",
 		Descriptor: "Rabbit",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "1.0",
 	},
 	"1F408": Emoji{
 		Key:        "1F408",
 		Value:      "🐈",
 		Descriptor: "Cat",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.7",
+	},
+	"1F408-200D-2B1B": Emoji{
+		Key:        "1F408-200D-2B1B",
+		Value:      "🐈‍⬛",
+		Descriptor: "Black Cat",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "13.0",
 	},
 	"1F409": Emoji{
 		Key:        "1F409",
 		Value:      "🐉",
 		Descriptor: "Dragon",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-reptile",
+		Version:    "1.0",
 	},
 	"1F40A": Emoji{
 		Key:        "1F40A",
 		Value:      "🐊",
 		Descriptor: "Crocodile",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-reptile",
+		Version:    "1.0",
 	},
 	"1F40B": Emoji{
 		Key:        "1F40B",
 		Value:      "🐋",
 		Descriptor: "Whale",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-marine",
+		Version:    "1.0",
 	},
 	"1F40C": Emoji{
 		Key:        "1F40C",
 		Value:      "🐌",
 		Descriptor: "Snail",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bug",
+		Version:    "0.6",
 	},
 	"1F40D": Emoji{
 		Key:        "1F40D",
 		Value:      "🐍",
 		Descriptor: "Snake",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-reptile",
+		Version:    "0.6",
 	},
 	"1F40E": Emoji{
 		Key:        "1F40E",
 		Value:      "🐎",
 		Descriptor: "Horse",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.6",
 	},
 	"1F40F": Emoji{
 		Key:        "1F40F",
 		Value:      "🐏",
 		Descriptor: "Ram",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "1.0",
 	},
 	"1F410": Emoji{
 		Key:        "1F410",
 		Value:      "🐐",
 		Descriptor: "Goat",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "1.0",
 	},
 	"1F411": Emoji{
 		Key:        "1F411",
 		Value:      "🐑",
 		Descriptor: "Ewe",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.6",
 	},
 	"1F412": Emoji{
 		Key:        "1F412",
 		Value:      "🐒",
 		Descriptor: "Monkey",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.6",
 	},
 	"1F413": Emoji{
 		Key:        "1F413",
 		Value:      "🐓",
 		Descriptor: "Rooster",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bird",
+		Version:    "1.0",
 	},
 	"1F414": Emoji{
 		Key:        "1F414",
 		Value:      "🐔",
 		Descriptor: "Chicken",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bird",
+		Version:    "0.6",
 	},
 	"1F415": Emoji{
 		Key:        "1F415",
 		Value:      "🐕",
 		Descriptor: "Dog",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.7",
+	},
+	"1F415-200D-1F9BA": Emoji{
+		Key:        "1F415-200D-1F9BA",
+		Value:      "🐕‍🦺",
+		Descriptor: "Service Dog",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "12.0",
 	},
 	"1F416": Emoji{
 		Key:        "1F416",
 		Value:      "🐖",
 		Descriptor: "Pig",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "1.0",
 	},
 	"1F417": Emoji{
 		Key:        "1F417",
 		Value:      "🐗",
 		Descriptor: "Boar",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.6",
 	},
 	"1F418": Emoji{
 		Key:        "1F418",
 		Value:      "🐘",
 		Descriptor: "Elephant",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.6",
 	},
 	"1F419": Emoji{
 		Key:        "1F419",
 		Value:      "🐙",
 		Descriptor: "Octopus",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-marine",
+		Version:    "0.6",
 	},
 	"1F41A": Emoji{
 		Key:        "1F41A",
 		Value:      "🐚",
 		Descriptor: "Spiral Shell",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-marine",
+		Version:    "0.6",
 	},
 	"1F41B": Emoji{
 		Key:        "1F41B",
 		Value:      "🐛",
 		Descriptor: "Bug",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bug",
+		Version:    "0.6",
 	},
 	"1F41C": Emoji{
 		Key:        "1F41C",
 		Value:      "🐜",
 		Descriptor: "Ant",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bug",
+		Version:    "0.6",
 	},
 	"1F41D": Emoji{
 		Key:        "1F41D",
 		Value:      "🐝",
 		Descriptor: "Honeybee",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bug",
+		Version:    "0.6",
 	},
 	"1F41E": Emoji{
 		Key:        "1F41E",
 		Value:      "🐞",
 		Descriptor: "Lady Beetle",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bug",
+		Version:    "0.6",
 	},
 	"1F41F": Emoji{
 		Key:        "1F41F",
 		Value:      "🐟",
 		Descriptor: "Fish",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-marine",
+		Version:    "0.6",
 	},
 	"1F420": Emoji{
 		Key:        "1F420",
 		Value:      "🐠",
 		Descriptor: "Tropical Fish",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-marine",
+		Version:    "0.6",
 	},
 	"1F421": Emoji{
 		Key:        "1F421",
 		Value:      "🐡",
 		Descriptor: "Blowfish",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-marine",
+		Version:    "0.6",
 	},
 	"1F422": Emoji{
 		Key:        "1F422",
 		Value:      "🐢",
 		Descriptor: "Turtle",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-reptile",
+		Version:    "0.6",
 	},
 	"1F423": Emoji{
 		Key:        "1F423",
 		Value:      "🐣",
 		Descriptor: "Hatching Chick",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bird",
+		Version:    "0.6",
 	},
 	"1F424": Emoji{
 		Key:        "1F424",
 		Value:      "🐤",
 		Descriptor: "Baby Chick",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bird",
+		Version:    "0.6",
 	},
 	"1F425": Emoji{
 		Key:        "1F425",
 		Value:      "🐥",
 		Descriptor: "Front-Facing Baby Chick",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bird",
+		Version:    "0.6",
 	},
 	"1F426": Emoji{
 		Key:        "1F426",
 		Value:      "🐦",
 		Descriptor: "Bird",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bird",
+		Version:    "0.6",
+	},
+	"1F426-200D-1F525": Emoji{
+		Key:        "1F426-200D-1F525",
+		Value:      "🐦‍🔥",
+		Descriptor: "Phoenix",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bird",
+		Version:    "15.1",
+	},
+	"1F426-200D-2B1B": Emoji{
+		Key:        "1F426-200D-2B1B",
+		Value:      "🐦‍⬛",
+		Descriptor: "Black Bird",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bird",
+		Version:    "15.0",
 	},
 	"1F427": Emoji{
 		Key:        "1F427",
 		Value:      "🐧",
 		Descriptor: "Penguin",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bird",
+		Version:    "0.6",
 	},
 	"1F428": Emoji{
 		Key:        "1F428",
 		Value:      "🐨",
 		Descriptor: "Koala",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.6",
 	},
 	"1F429": Emoji{
 		Key:        "1F429",
 		Value:      "🐩",
 		Descriptor: "Poodle",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.6",
 	},
 	"1F42A": Emoji{
 		Key:        "1F42A",
 		Value:      "🐪",
 		Descriptor: "Camel",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "1.0",
 	},
 	"1F42B": Emoji{
 		Key:        "1F42B",
 		Value:      "🐫",
 		Descriptor: "Two-Hump Camel",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.6",
 	},
 	"1F42C": Emoji{
 		Key:        "1F42C",
 		Value:      "🐬",
 		Descriptor: "Dolphin",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-marine",
+		Version:    "0.6",
 	},
 	"1F42D": Emoji{
 		Key:        "1F42D",
 		Value:      "🐭",
 		Descriptor: "Mouse Face",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.6",
 	},
 	"1F42E": Emoji{
 		Key:        "1F42E",
 		Value:      "🐮",
 		Descriptor: "Cow Face",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.6",
 	},
 	"1F42F": Emoji{
 		Key:        "1F42F",
 		Value:      "🐯",
 		Descriptor: "Tiger Face",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.6",
 	},
 	"1F430": Emoji{
 		Key:        "1F430",
 		Value:      "🐰",
 		Descriptor: "Rabbit Face",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.6",
 	},
 	"1F431": Emoji{
 		Key:        "1F431",
 		Value:      "🐱",
 		Descriptor: "Cat Face",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.6",
 	},
 	"1F432": Emoji{
 		Key:        "1F432",
 		Value:      "🐲",
 		Descriptor: "Dragon Face",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-reptile",
+		Version:    "0.6",
 	},
 	"1F433": Emoji{
 		Key:        "1F433",
 		Value:      "🐳",
 		Descriptor: "Spouting Whale",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-marine",
+		Version:    "0.6",
 	},
 	"1F434": Emoji{
 		Key:        "1F434",
 		Value:      "🐴",
 		Descriptor: "Horse Face",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.6",
 	},
 	"1F435": Emoji{
 		Key:        "1F435",
 		Value:      "🐵",
 		Descriptor: "Monkey Face",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.6",
 	},
 	"1F436": Emoji{
 		Key:        "1F436",
 		Value:      "🐶",
 		Descriptor: "Dog Face",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.6",
 	},
 	"1F437": Emoji{
 		Key:        "1F437",
 		Value:      "🐷",
 		Descriptor: "Pig Face",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.6",
 	},
 	"1F438": Emoji{
 		Key:        "1F438",
 		Value:      "🐸",
-		Descriptor: "Frog Face",
+		Descriptor: "Frog",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-amphibian",
+		Version:    "0.6",
 	},
 	"1F439": Emoji{
 		Key:        "1F439",
 		Value:      "🐹",
-		Descriptor: "Hamster Face",
+		Descriptor: "Hamster",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.6",
 	},
 	"1F43A": Emoji{
 		Key:        "1F43A",
 		Value:      "🐺",
-		Descriptor: "Wolf Face",
+		Descriptor: "Wolf",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.6",
 	},
 	"1F43B": Emoji{
 		Key:        "1F43B",
 		Value:      "🐻",
-		Descriptor: "Bear Face",
+		Descriptor: "Bear",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.6",
+	},
+	"1F43B-200D-2744-FE0F": Emoji{
+		Key:        "1F43B-200D-2744-FE0F",
+		Value:      "🐻‍❄️",
+		Descriptor: "Polar Bear",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "13.0",
 	},
 	"1F43C": Emoji{
 		Key:        "1F43C",
 		Value:      "🐼",
-		Descriptor: "Panda Face",
+		Descriptor: "Panda",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.6",
 	},
 	"1F43D": Emoji{
 		Key:        "1F43D",
 		Value:      "🐽",
 		Descriptor: "Pig Nose",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.6",
 	},
 	"1F43E": Emoji{
 		Key:        "1F43E",
 		Value:      "🐾",
 		Descriptor: "Paw Prints",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.6",
 	},
 	"1F43F-FE0F": Emoji{
 		Key:        "1F43F-FE0F",
 		Value:      "🐿️",
 		Descriptor: "Chipmunk",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "0.7",
 	},
 	"1F440": Emoji{
 		Key:        "1F440",
 		Value:      "👀",
 		Descriptor: "Eyes",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "0.6",
 	},
 	"1F441-FE0F": Emoji{
 		Key:        "1F441-FE0F",
 		Value:      "👁️",
 		Descriptor: "Eye",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "0.7",
 	},
 	"1F441-FE0F-200D-1F5E8-FE0F": Emoji{
 		Key:        "1F441-FE0F-200D-1F5E8-FE0F",
 		Value:      "👁️‍🗨️",
 		Descriptor: "Eye in Speech Bubble",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "emotion",
+		Version:    "2.0",
 	},
 	"1F442": Emoji{
 		Key:        "1F442",
 		Value:      "👂",
 		Descriptor: "Ear",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "0.6",
 	},
 	"1F442-1F3FB": Emoji{
 		Key:        "1F442-1F3FB",
 		Value:      "👂🏻",
 		Descriptor: "Ear: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "1.0",
 	},
 	"1F442-1F3FC": Emoji{
 		Key:        "1F442-1F3FC",
 		Value:      "👂🏼",
 		Descriptor: "Ear: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "1.0",
 	},
 	"1F442-1F3FD": Emoji{
 		Key:        "1F442-1F3FD",
 		Value:      "👂🏽",
 		Descriptor: "Ear: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "1.0",
 	},
 	"1F442-1F3FE": Emoji{
 		Key:        "1F442-1F3FE",
 		Value:      "👂🏾",
 		Descriptor: "Ear: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "1.0",
 	},
 	"1F442-1F3FF": Emoji{
 		Key:        "1F442-1F3FF",
 		Value:      "👂🏿",
 		Descriptor: "Ear: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "1.0",
 	},
 	"1F443": Emoji{
 		Key:        "1F443",
 		Value:      "👃",
 		Descriptor: "Nose",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "0.6",
 	},
 	"1F443-1F3FB": Emoji{
 		Key:        "1F443-1F3FB",
 		Value:      "👃🏻",
 		Descriptor: "Nose: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "1.0",
 	},
 	"1F443-1F3FC": Emoji{
 		Key:        "1F443-1F3FC",
 		Value:      "👃🏼",
 		Descriptor: "Nose: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "1.0",
 	},
 	"1F443-1F3FD": Emoji{
 		Key:        "1F443-1F3FD",
 		Value:      "👃🏽",
 		Descriptor: "Nose: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "1.0",
 	},
 	"1F443-1F3FE": Emoji{
 		Key:        "1F443-1F3FE",
 		Value:      "👃🏾",
 		Descriptor: "Nose: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "1.0",
 	},
 	"1F443-1F3FF": Emoji{
 		Key:        "1F443-1F3FF",
 		Value:      "👃🏿",
 		Descriptor: "Nose: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "1.0",
 	},
 	"1F444": Emoji{
 		Key:        "1F444",
 		Value:      "👄",
 		Descriptor: "Mouth",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "0.6",
 	},
 	"1F445": Emoji{
 		Key:        "1F445",
 		Value:      "👅",
 		Descriptor: "Tongue",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "0.6",
 	},
 	"1F446": Emoji{
 		Key:        "1F446",
 		Value:      "👆",
 		Descriptor: "Backhand Index Pointing Up",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "0.6",
 	},
 	"1F446-1F3FB": Emoji{
 		Key:        "1F446-1F3FB",
 		Value:      "👆🏻",
 		Descriptor: "Backhand Index Pointing Up: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F446-1F3FC": Emoji{
 		Key:        "1F446-1F3FC",
 		Value:      "👆🏼",
 		Descriptor: "Backhand Index Pointing Up: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F446-1F3FD": Emoji{
 		Key:        "1F446-1F3FD",
 		Value:      "👆🏽",
 		Descriptor: "Backhand Index Pointing Up: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F446-1F3FE": Emoji{
 		Key:        "1F446-1F3FE",
 		Value:      "👆🏾",
 		Descriptor: "Backhand Index Pointing Up: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F446-1F3FF": Emoji{
 		Key:        "1F446-1F3FF",
 		Value:      "👆🏿",
 		Descriptor: "Backhand Index Pointing Up: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F447": Emoji{
 		Key:        "1F447",
 		Value:      "👇",
 		Descriptor: "Backhand Index Pointing Down",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "0.6",
 	},
 	"1F447-1F3FB": Emoji{
 		Key:        "1F447-1F3FB",
 		Value:      "👇🏻",
 		Descriptor: "Backhand Index Pointing Down: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F447-1F3FC": Emoji{
 		Key:        "1F447-1F3FC",
 		Value:      "👇🏼",
 		Descriptor: "Backhand Index Pointing Down: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F447-1F3FD": Emoji{
 		Key:        "1F447-1F3FD",
 		Value:      "👇🏽",
 		Descriptor: "Backhand Index Pointing Down: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F447-1F3FE": Emoji{
 		Key:        "1F447-1F3FE",
 		Value:      "👇🏾",
 		Descriptor: "Backhand Index Pointing Down: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F447-1F3FF": Emoji{
 		Key:        "1F447-1F3FF",
 		Value:      "👇🏿",
 		Descriptor: "Backhand Index Pointing Down: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F448": Emoji{
 		Key:        "1F448",
 		Value:      "👈",
 		Descriptor: "Backhand Index Pointing Left",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "0.6",
 	},
 	"1F448-1F3FB": Emoji{
 		Key:        "1F448-1F3FB",
 		Value:      "👈🏻",
 		Descriptor: "Backhand Index Pointing Left: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F448-1F3FC": Emoji{
 		Key:        "1F448-1F3FC",
 		Value:      "👈🏼",
 		Descriptor: "Backhand Index Pointing Left: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F448-1F3FD": Emoji{
 		Key:        "1F448-1F3FD",
 		Value:      "👈🏽",
 		Descriptor: "Backhand Index Pointing Left: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F448-1F3FE": Emoji{
 		Key:        "1F448-1F3FE",
 		Value:      "👈🏾",
 		Descriptor: "Backhand Index Pointing Left: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F448-1F3FF": Emoji{
 		Key:        "1F448-1F3FF",
 		Value:      "👈🏿",
 		Descriptor: "Backhand Index Pointing Left: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F449": Emoji{
 		Key:        "1F449",
 		Value:      "👉",
 		Descriptor: "Backhand Index Pointing Right",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "0.6",
 	},
 	"1F449-1F3FB": Emoji{
 		Key:        "1F449-1F3FB",
 		Value:      "👉🏻",
 		Descriptor: "Backhand Index Pointing Right: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F449-1F3FC": Emoji{
 		Key:        "1F449-1F3FC",
 		Value:      "👉🏼",
 		Descriptor: "Backhand Index Pointing Right: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F449-1F3FD": Emoji{
 		Key:        "1F449-1F3FD",
 		Value:      "👉🏽",
 		Descriptor: "Backhand Index Pointing Right: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F449-1F3FE": Emoji{
 		Key:        "1F449-1F3FE",
 		Value:      "👉🏾",
 		Descriptor: "Backhand Index Pointing Right: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F449-1F3FF": Emoji{
 		Key:        "1F449-1F3FF",
 		Value:      "👉🏿",
 		Descriptor: "Backhand Index Pointing Right: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F44A": Emoji{
 		Key:        "1F44A",
 		Value:      "👊",
 		Descriptor: "Oncoming Fist",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "0.6",
 	},
 	"1F44A-1F3FB": Emoji{
 		Key:        "1F44A-1F3FB",
 		Value:      "👊🏻",
 		Descriptor: "Oncoming Fist: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "1.0",
 	},
 	"1F44A-1F3FC": Emoji{
 		Key:        "1F44A-1F3FC",
 		Value:      "👊🏼",
 		Descriptor: "Oncoming Fist: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "1.0",
 	},
 	"1F44A-1F3FD": Emoji{
 		Key:        "1F44A-1F3FD",
 		Value:      "👊🏽",
 		Descriptor: "Oncoming Fist: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "1.0",
 	},
 	"1F44A-1F3FE": Emoji{
 		Key:        "1F44A-1F3FE",
 		Value:      "👊🏾",
 		Descriptor: "Oncoming Fist: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "1.0",
 	},
 	"1F44A-1F3FF": Emoji{
 		Key:        "1F44A-1F3FF",
 		Value:      "👊🏿",
 		Descriptor: "Oncoming Fist: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "1.0",
 	},
 	"1F44B": Emoji{
 		Key:        "1F44B",
 		Value:      "👋",
 		Descriptor: "Waving Hand",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "0.6",
 	},
 	"1F44B-1F3FB": Emoji{
 		Key:        "1F44B-1F3FB",
 		Value:      "👋🏻",
 		Descriptor: "Waving Hand: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "1.0",
 	},
 	"1F44B-1F3FC": Emoji{
 		Key:        "1F44B-1F3FC",
 		Value:      "👋🏼",
 		Descriptor: "Waving Hand: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "1.0",
 	},
 	"1F44B-1F3FD": Emoji{
 		Key:        "1F44B-1F3FD",
 		Value:      "👋🏽",
 		Descriptor: "Waving Hand: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "1.0",
 	},
 	"1F44B-1F3FE": Emoji{
 		Key:        "1F44B-1F3FE",
 		Value:      "👋🏾",
 		Descriptor: "Waving Hand: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "1.0",
 	},
 	"1F44B-1F3FF": Emoji{
 		Key:        "1F44B-1F3FF",
 		Value:      "👋🏿",
 		Descriptor: "Waving Hand: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "1.0",
 	},
 	"1F44C": Emoji{
 		Key:        "1F44C",
 		Value:      "👌",
 		Descriptor: "OK Hand",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "0.6",
 	},
 	"1F44C-1F3FB": Emoji{
 		Key:        "1F44C-1F3FB",
 		Value:      "👌🏻",
 		Descriptor: "OK Hand: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "1.0",
 	},
 	"1F44C-1F3FC": Emoji{
 		Key:        "1F44C-1F3FC",
 		Value:      "👌🏼",
 		Descriptor: "OK Hand: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "1.0",
 	},
 	"1F44C-1F3FD": Emoji{
 		Key:        "1F44C-1F3FD",
 		Value:      "👌🏽",
 		Descriptor: "OK Hand: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "1.0",
 	},
 	"1F44C-1F3FE": Emoji{
 		Key:        "1F44C-1F3FE",
 		Value:      "👌🏾",
 		Descriptor: "OK Hand: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "1.0",
 	},
 	"1F44C-1F3FF": Emoji{
 		Key:        "1F44C-1F3FF",
 		Value:      "👌🏿",
 		Descriptor: "OK Hand: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "1.0",
 	},
 	"1F44D": Emoji{
 		Key:        "1F44D",
 		Value:      "👍",
 		Descriptor: "Thumbs Up",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "0.6",
 	},
 	"1F44D-1F3FB": Emoji{
 		Key:        "1F44D-1F3FB",
 		Value:      "👍🏻",
 		Descriptor: "Thumbs Up: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "1.0",
 	},
 	"1F44D-1F3FC": Emoji{
 		Key:        "1F44D-1F3FC",
 		Value:      "👍🏼",
 		Descriptor: "Thumbs Up: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "1.0",
 	},
 	"1F44D-1F3FD": Emoji{
 		Key:        "1F44D-1F3FD",
 		Value:      "👍🏽",
 		Descriptor: "Thumbs Up: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "1.0",
 	},
 	"1F44D-1F3FE": Emoji{
 		Key:        "1F44D-1F3FE",
 		Value:      "👍🏾",
 		Descriptor: "Thumbs Up: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "1.0",
 	},
 	"1F44D-1F3FF": Emoji{
 		Key:        "1F44D-1F3FF",
 		Value:      "👍🏿",
 		Descriptor: "Thumbs Up: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "1.0",
 	},
 	"1F44E": Emoji{
 		Key:        "1F44E",
 		Value:      "👎",
 		Descriptor: "Thumbs Down",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "0.6",
 	},
 	"1F44E-1F3FB": Emoji{
 		Key:        "1F44E-1F3FB",
 		Value:      "👎🏻",
 		Descriptor: "Thumbs Down: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "1.0",
 	},
 	"1F44E-1F3FC": Emoji{
 		Key:        "1F44E-1F3FC",
 		Value:      "👎🏼",
 		Descriptor: "Thumbs Down: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "1.0",
 	},
 	"1F44E-1F3FD": Emoji{
 		Key:        "1F44E-1F3FD",
 		Value:      "👎🏽",
 		Descriptor: "Thumbs Down: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "1.0",
 	},
 	"1F44E-1F3FE": Emoji{
 		Key:        "1F44E-1F3FE",
 		Value:      "👎🏾",
 		Descriptor: "Thumbs Down: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "1.0",
 	},
 	"1F44E-1F3FF": Emoji{
 		Key:        "1F44E-1F3FF",
 		Value:      "👎🏿",
 		Descriptor: "Thumbs Down: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "1.0",
 	},
 	"1F44F": Emoji{
 		Key:        "1F44F",
 		Value:      "👏",
 		Descriptor: "Clapping Hands",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "0.6",
 	},
 	"1F44F-1F3FB": Emoji{
 		Key:        "1F44F-1F3FB",
 		Value:      "👏🏻",
 		Descriptor: "Clapping Hands: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "1.0",
 	},
 	"1F44F-1F3FC": Emoji{
 		Key:        "1F44F-1F3FC",
 		Value:      "👏🏼",
 		Descriptor: "Clapping Hands: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "1.0",
 	},
 	"1F44F-1F3FD": Emoji{
 		Key:        "1F44F-1F3FD",
 		Value:      "👏🏽",
 		Descriptor: "Clapping Hands: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "1.0",
 	},
 	"1F44F-1F3FE": Emoji{
 		Key:        "1F44F-1F3FE",
 		Value:      "👏🏾",
 		Descriptor: "Clapping Hands: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "1.0",
 	},
 	"1F44F-1F3FF": Emoji{
 		Key:        "1F44F-1F3FF",
 		Value:      "👏🏿",
 		Descriptor: "Clapping Hands: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "1.0",
 	},
 	"1F450": Emoji{
 		Key:        "1F450",
 		Value:      "👐",
 		Descriptor: "Open Hands",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "0.6",
 	},
 	"1F450-1F3FB": Emoji{
 		Key:        "1F450-1F3FB",
 		Value:      "👐🏻",
 		Descriptor: "Open Hands: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "1.0",
 	},
 	"1F450-1F3FC": Emoji{
 		Key:        "1F450-1F3FC",
 		Value:      "👐🏼",
 		Descriptor: "Open Hands: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "1.0",
 	},
 	"1F450-1F3FD": Emoji{
 		Key:        "1F450-1F3FD",
 		Value:      "👐🏽",
 		Descriptor: "Open Hands: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "1.0",
 	},
 	"1F450-1F3FE": Emoji{
 		Key:        "1F450-1F3FE",
 		Value:      "👐🏾",
 		Descriptor: "Open Hands: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "1.0",
 	},
 	"1F450-1F3FF": Emoji{
 		Key:        "1F450-1F3FF",
 		Value:      "👐🏿",
 		Descriptor: "Open Hands: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "1.0",
 	},
 	"1F451": Emoji{
 		Key:        "1F451",
 		Value:      "👑",
 		Descriptor: "Crown",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F452": Emoji{
 		Key:        "1F452",
 		Value:      "👒",
 		Descriptor: "Woman’s Hat",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F453": Emoji{
 		Key:        "1F453",
 		Value:      "👓",
 		Descriptor: "Glasses",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F454": Emoji{
 		Key:        "1F454",
 		Value:      "👔",
 		Descriptor: "Necktie",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F455": Emoji{
 		Key:        "1F455",
 		Value:      "👕",
 		Descriptor: "T-Shirt",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F456": Emoji{
 		Key:        "1F456",
 		Value:      "👖",
 		Descriptor: "Jeans",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F457": Emoji{
 		Key:        "1F457",
 		Value:      "👗",
 		Descriptor: "Dress",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F458": Emoji{
 		Key:        "1F458",
 		Value:      "👘",
 		Descriptor: "Kimono",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F459": Emoji{
 		Key:        "1F459",
 		Value:      "👙",
 		Descriptor: "Bikini",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F45A": Emoji{
 		Key:        "1F45A",
 		Value:      "👚",
 		Descriptor: "Woman’s Clothes",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F45B": Emoji{
 		Key:        "1F45B",
 		Value:      "👛",
 		Descriptor: "Purse",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F45C": Emoji{
 		Key:        "1F45C",
 		Value:      "👜",
 		Descriptor: "Handbag",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F45D": Emoji{
 		Key:        "1F45D",
 		Value:      "👝",
 		Descriptor: "Clutch Bag",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F45E": Emoji{
 		Key:        "1F45E",
 		Value:      "👞",
 		Descriptor: "Man’s Shoe",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F45F": Emoji{
 		Key:        "1F45F",
 		Value:      "👟",
 		Descriptor: "Running Shoe",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F460": Emoji{
 		Key:        "1F460",
 		Value:      "👠",
 		Descriptor: "High-Heeled Shoe",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F461": Emoji{
 		Key:        "1F461",
 		Value:      "👡",
 		Descriptor: "Woman’s Sandal",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F462": Emoji{
 		Key:        "1F462",
 		Value:      "👢",
 		Descriptor: "Woman’s Boot",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F463": Emoji{
 		Key:        "1F463",
 		Value:      "👣",
 		Descriptor: "Footprints",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-symbol",
+		Version:    "0.6",
 	},
 	"1F464": Emoji{
 		Key:        "1F464",
 		Value:      "👤",
 		Descriptor: "Bust in Silhouette",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-symbol",
+		Version:    "0.6",
 	},
 	"1F465": Emoji{
 		Key:        "1F465",
 		Value:      "👥",
 		Descriptor: "Busts in Silhouette",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-symbol",
+		Version:    "1.0",
 	},
 	"1F466": Emoji{
 		Key:        "1F466",
 		Value:      "👦",
 		Descriptor: "Boy",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "0.6",
 	},
 	"1F466-1F3FB": Emoji{
 		Key:        "1F466-1F3FB",
 		Value:      "👦🏻",
 		Descriptor: "Boy: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F466-1F3FC": Emoji{
 		Key:        "1F466-1F3FC",
 		Value:      "👦🏼",
 		Descriptor: "Boy: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F466-1F3FD": Emoji{
 		Key:        "1F466-1F3FD",
 		Value:      "👦🏽",
 		Descriptor: "Boy: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F466-1F3FE": Emoji{
 		Key:        "1F466-1F3FE",
 		Value:      "👦🏾",
 		Descriptor: "Boy: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F466-1F3FF": Emoji{
 		Key:        "1F466-1F3FF",
 		Value:      "👦🏿",
 		Descriptor: "Boy: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F467": Emoji{
 		Key:        "1F467",
 		Value:      "👧",
 		Descriptor: "Girl",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "0.6",
 	},
 	"1F467-1F3FB": Emoji{
 		Key:        "1F467-1F3FB",
 		Value:      "👧🏻",
 		Descriptor: "Girl: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F467-1F3FC": Emoji{
 		Key:        "1F467-1F3FC",
 		Value:      "👧🏼",
 		Descriptor: "Girl: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F467-1F3FD": Emoji{
 		Key:        "1F467-1F3FD",
 		Value:      "👧🏽",
 		Descriptor: "Girl: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F467-1F3FE": Emoji{
 		Key:        "1F467-1F3FE",
 		Value:      "👧🏾",
 		Descriptor: "Girl: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F467-1F3FF": Emoji{
 		Key:        "1F467-1F3FF",
 		Value:      "👧🏿",
 		Descriptor: "Girl: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F468": Emoji{
 		Key:        "1F468",
 		Value:      "👨",
 		Descriptor: "Man",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "0.6",
 	},
 	"1F468-1F3FB": Emoji{
 		Key:        "1F468-1F3FB",
 		Value:      "👨🏻",
 		Descriptor: "Man: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F468-1F3FB-200D-1F33E": Emoji{
 		Key:        "1F468-1F3FB-200D-1F33E",
 		Value:      "👨🏻‍🌾",
 		Descriptor: "Man Farmer: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FB-200D-1F373": Emoji{
 		Key:        "1F468-1F3FB-200D-1F373",
 		Value:      "👨🏻‍🍳",
 		Descriptor: "Man Cook: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F468-1F3FB-200D-1F37C": Emoji{
+		Key:        "1F468-1F3FB-200D-1F37C",
+		Value:      "👨🏻‍🍼",
+		Descriptor: "Man Feeding Baby: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
 	},
 	"1F468-1F3FB-200D-1F393": Emoji{
 		Key:        "1F468-1F3FB-200D-1F393",
 		Value:      "👨🏻‍🎓",
 		Descriptor: "Man Student: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FB-200D-1F3A4": Emoji{
 		Key:        "1F468-1F3FB-200D-1F3A4",
 		Value:      "👨🏻‍🎤",
 		Descriptor: "Man Singer: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FB-200D-1F3A8": Emoji{
 		Key:        "1F468-1F3FB-200D-1F3A8",
 		Value:      "👨🏻‍🎨",
 		Descriptor: "Man Artist: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FB-200D-1F3EB": Emoji{
 		Key:        "1F468-1F3FB-200D-1F3EB",
 		Value:      "👨🏻‍🏫",
 		Descriptor: "Man Teacher: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FB-200D-1F3ED": Emoji{
 		Key:        "1F468-1F3FB-200D-1F3ED",
 		Value:      "👨🏻‍🏭",
 		Descriptor: "Man Factory Worker: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FB-200D-1F4BB": Emoji{
 		Key:        "1F468-1F3FB-200D-1F4BB",
 		Value:      "👨🏻‍💻",
 		Descriptor: "Man Technologist: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FB-200D-1F4BC": Emoji{
 		Key:        "1F468-1F3FB-200D-1F4BC",
 		Value:      "👨🏻‍💼",
 		Descriptor: "Man Office Worker: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FB-200D-1F527": Emoji{
 		Key:        "1F468-1F3FB-200D-1F527",
 		Value:      "👨🏻‍🔧",
 		Descriptor: "Man Mechanic: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FB-200D-1F52C": Emoji{
 		Key:        "1F468-1F3FB-200D-1F52C",
 		Value:      "👨🏻‍🔬",
 		Descriptor: "Man Scientist: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FB-200D-1F680": Emoji{
 		Key:        "1F468-1F3FB-200D-1F680",
 		Value:      "👨🏻‍🚀",
 		Descriptor: "Man Astronaut: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FB-200D-1F692": Emoji{
 		Key:        "1F468-1F3FB-200D-1F692",
 		Value:      "👨🏻‍🚒",
 		Descriptor: "Man Firefighter: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F468-1F3FB-200D-1F91D-200D-1F468-1F3FC": Emoji{
+		Key:        "1F468-1F3FB-200D-1F91D-200D-1F468-1F3FC",
+		Value:      "👨🏻‍🤝‍👨🏼",
+		Descriptor: "Men Holding Hands: Light Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F468-1F3FB-200D-1F91D-200D-1F468-1F3FD": Emoji{
+		Key:        "1F468-1F3FB-200D-1F91D-200D-1F468-1F3FD",
+		Value:      "👨🏻‍🤝‍👨🏽",
+		Descriptor: "Men Holding Hands: Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F468-1F3FB-200D-1F91D-200D-1F468-1F3FE": Emoji{
+		Key:        "1F468-1F3FB-200D-1F91D-200D-1F468-1F3FE",
+		Value:      "👨🏻‍🤝‍👨🏾",
+		Descriptor: "Men Holding Hands: Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F468-1F3FB-200D-1F91D-200D-1F468-1F3FF": Emoji{
+		Key:        "1F468-1F3FB-200D-1F91D-200D-1F468-1F3FF",
+		Value:      "👨🏻‍🤝‍👨🏿",
+		Descriptor: "Men Holding Hands: Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F468-1F3FB-200D-1F9AF": Emoji{
+		Key:        "1F468-1F3FB-200D-1F9AF",
+		Value:      "👨🏻‍🦯",
+		Descriptor: "Man With White Cane: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F468-1F3FB-200D-1F9AF-200D-27A1-FE0F": Emoji{
+		Key:        "1F468-1F3FB-200D-1F9AF-200D-27A1-FE0F",
+		Value:      "👨🏻‍🦯‍➡️",
+		Descriptor: "Man With White Cane Facing Right: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F468-1F3FB-200D-1F9B0": Emoji{
 		Key:        "1F468-1F3FB-200D-1F9B0",
 		Value:      "👨🏻‍🦰",
 		Descriptor: "Man: Light Skin Tone, Red Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F468-1F3FB-200D-1F9B1": Emoji{
 		Key:        "1F468-1F3FB-200D-1F9B1",
 		Value:      "👨🏻‍🦱",
 		Descriptor: "Man: Light Skin Tone, Curly Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F468-1F3FB-200D-1F9B2": Emoji{
 		Key:        "1F468-1F3FB-200D-1F9B2",
 		Value:      "👨🏻‍🦲",
 		Descriptor: "Man: Light Skin Tone, Bald",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F468-1F3FB-200D-1F9B3": Emoji{
 		Key:        "1F468-1F3FB-200D-1F9B3",
 		Value:      "👨🏻‍🦳",
 		Descriptor: "Man: Light Skin Tone, White Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
+	},
+	"1F468-1F3FB-200D-1F9BC": Emoji{
+		Key:        "1F468-1F3FB-200D-1F9BC",
+		Value:      "👨🏻‍🦼",
+		Descriptor: "Man in Motorized Wheelchair: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F468-1F3FB-200D-1F9BC-200D-27A1-FE0F": Emoji{
+		Key:        "1F468-1F3FB-200D-1F9BC-200D-27A1-FE0F",
+		Value:      "👨🏻‍🦼‍➡️",
+		Descriptor: "Man in Motorized Wheelchair Facing Right: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F468-1F3FB-200D-1F9BD": Emoji{
+		Key:        "1F468-1F3FB-200D-1F9BD",
+		Value:      "👨🏻‍🦽",
+		Descriptor: "Man in Manual Wheelchair: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F468-1F3FB-200D-1F9BD-200D-27A1-FE0F": Emoji{
+		Key:        "1F468-1F3FB-200D-1F9BD-200D-27A1-FE0F",
+		Value:      "👨🏻‍🦽‍➡️",
+		Descriptor: "Man in Manual Wheelchair Facing Right: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F468-1F3FB-200D-2695-FE0F": Emoji{
 		Key:        "1F468-1F3FB-200D-2695-FE0F",
 		Value:      "👨🏻‍⚕️",
 		Descriptor: "Man Health Worker: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FB-200D-2696-FE0F": Emoji{
 		Key:        "1F468-1F3FB-200D-2696-FE0F",
 		Value:      "👨🏻‍⚖️",
 		Descriptor: "Man Judge: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FB-200D-2708-FE0F": Emoji{
 		Key:        "1F468-1F3FB-200D-2708-FE0F",
 		Value:      "👨🏻‍✈️",
 		Descriptor: "Man Pilot: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F468-1F3FB-200D-2764-FE0F-200D-1F468-1F3FB": Emoji{
+		Key:        "1F468-1F3FB-200D-2764-FE0F-200D-1F468-1F3FB",
+		Value:      "👨🏻‍❤️‍👨🏻",
+		Descriptor: "Couple With Heart: Man, Man, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FB-200D-2764-FE0F-200D-1F468-1F3FC": Emoji{
+		Key:        "1F468-1F3FB-200D-2764-FE0F-200D-1F468-1F3FC",
+		Value:      "👨🏻‍❤️‍👨🏼",
+		Descriptor: "Couple With Heart: Man, Man, Light Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FB-200D-2764-FE0F-200D-1F468-1F3FD": Emoji{
+		Key:        "1F468-1F3FB-200D-2764-FE0F-200D-1F468-1F3FD",
+		Value:      "👨🏻‍❤️‍👨🏽",
+		Descriptor: "Couple With Heart: Man, Man, Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FB-200D-2764-FE0F-200D-1F468-1F3FE": Emoji{
+		Key:        "1F468-1F3FB-200D-2764-FE0F-200D-1F468-1F3FE",
+		Value:      "👨🏻‍❤️‍👨🏾",
+		Descriptor: "Couple With Heart: Man, Man, Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FB-200D-2764-FE0F-200D-1F468-1F3FF": Emoji{
+		Key:        "1F468-1F3FB-200D-2764-FE0F-200D-1F468-1F3FF",
+		Value:      "👨🏻‍❤️‍👨🏿",
+		Descriptor: "Couple With Heart: Man, Man, Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FB": Emoji{
+		Key:        "1F468-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FB",
+		Value:      "👨🏻‍❤️‍💋‍👨🏻",
+		Descriptor: "Kiss: Man, Man, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FC": Emoji{
+		Key:        "1F468-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FC",
+		Value:      "👨🏻‍❤️‍💋‍👨🏼",
+		Descriptor: "Kiss: Man, Man, Light Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FD": Emoji{
+		Key:        "1F468-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FD",
+		Value:      "👨🏻‍❤️‍💋‍👨🏽",
+		Descriptor: "Kiss: Man, Man, Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FE": Emoji{
+		Key:        "1F468-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FE",
+		Value:      "👨🏻‍❤️‍💋‍👨🏾",
+		Descriptor: "Kiss: Man, Man, Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FF": Emoji{
+		Key:        "1F468-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FF",
+		Value:      "👨🏻‍❤️‍💋‍👨🏿",
+		Descriptor: "Kiss: Man, Man, Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
 	},
 	"1F468-1F3FC": Emoji{
 		Key:        "1F468-1F3FC",
 		Value:      "👨🏼",
 		Descriptor: "Man: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F468-1F3FC-200D-1F33E": Emoji{
 		Key:        "1F468-1F3FC-200D-1F33E",
 		Value:      "👨🏼‍🌾",
 		Descriptor: "Man Farmer: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FC-200D-1F373": Emoji{
 		Key:        "1F468-1F3FC-200D-1F373",
 		Value:      "👨🏼‍🍳",
 		Descriptor: "Man Cook: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F468-1F3FC-200D-1F37C": Emoji{
+		Key:        "1F468-1F3FC-200D-1F37C",
+		Value:      "👨🏼‍🍼",
+		Descriptor: "Man Feeding Baby: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
 	},
 	"1F468-1F3FC-200D-1F393": Emoji{
 		Key:        "1F468-1F3FC-200D-1F393",
 		Value:      "👨🏼‍🎓",
 		Descriptor: "Man Student: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FC-200D-1F3A4": Emoji{
 		Key:        "1F468-1F3FC-200D-1F3A4",
 		Value:      "👨🏼‍🎤",
 		Descriptor: "Man Singer: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FC-200D-1F3A8": Emoji{
 		Key:        "1F468-1F3FC-200D-1F3A8",
 		Value:      "👨🏼‍🎨",
 		Descriptor: "Man Artist: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FC-200D-1F3EB": Emoji{
 		Key:        "1F468-1F3FC-200D-1F3EB",
 		Value:      "👨🏼‍🏫",
 		Descriptor: "Man Teacher: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FC-200D-1F3ED": Emoji{
 		Key:        "1F468-1F3FC-200D-1F3ED",
 		Value:      "👨🏼‍🏭",
 		Descriptor: "Man Factory Worker: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FC-200D-1F4BB": Emoji{
 		Key:        "1F468-1F3FC-200D-1F4BB",
 		Value:      "👨🏼‍💻",
 		Descriptor: "Man Technologist: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FC-200D-1F4BC": Emoji{
 		Key:        "1F468-1F3FC-200D-1F4BC",
 		Value:      "👨🏼‍💼",
 		Descriptor: "Man Office Worker: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FC-200D-1F527": Emoji{
 		Key:        "1F468-1F3FC-200D-1F527",
 		Value:      "👨🏼‍🔧",
 		Descriptor: "Man Mechanic: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FC-200D-1F52C": Emoji{
 		Key:        "1F468-1F3FC-200D-1F52C",
 		Value:      "👨🏼‍🔬",
 		Descriptor: "Man Scientist: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FC-200D-1F680": Emoji{
 		Key:        "1F468-1F3FC-200D-1F680",
 		Value:      "👨🏼‍🚀",
 		Descriptor: "Man Astronaut: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FC-200D-1F692": Emoji{
 		Key:        "1F468-1F3FC-200D-1F692",
 		Value:      "👨🏼‍🚒",
 		Descriptor: "Man Firefighter: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F468-1F3FC-200D-1F91D-200D-1F468-1F3FB": Emoji{
+		Key:        "1F468-1F3FC-200D-1F91D-200D-1F468-1F3FB",
+		Value:      "👨🏼‍🤝‍👨🏻",
+		Descriptor: "Men Holding Hands: Medium-Light Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F468-1F3FC-200D-1F91D-200D-1F468-1F3FD": Emoji{
+		Key:        "1F468-1F3FC-200D-1F91D-200D-1F468-1F3FD",
+		Value:      "👨🏼‍🤝‍👨🏽",
+		Descriptor: "Men Holding Hands: Medium-Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F468-1F3FC-200D-1F91D-200D-1F468-1F3FE": Emoji{
+		Key:        "1F468-1F3FC-200D-1F91D-200D-1F468-1F3FE",
+		Value:      "👨🏼‍🤝‍👨🏾",
+		Descriptor: "Men Holding Hands: Medium-Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F468-1F3FC-200D-1F91D-200D-1F468-1F3FF": Emoji{
+		Key:        "1F468-1F3FC-200D-1F91D-200D-1F468-1F3FF",
+		Value:      "👨🏼‍🤝‍👨🏿",
+		Descriptor: "Men Holding Hands: Medium-Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F468-1F3FC-200D-1F9AF": Emoji{
+		Key:        "1F468-1F3FC-200D-1F9AF",
+		Value:      "👨🏼‍🦯",
+		Descriptor: "Man With White Cane: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F468-1F3FC-200D-1F9AF-200D-27A1-FE0F": Emoji{
+		Key:        "1F468-1F3FC-200D-1F9AF-200D-27A1-FE0F",
+		Value:      "👨🏼‍🦯‍➡️",
+		Descriptor: "Man With White Cane Facing Right: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F468-1F3FC-200D-1F9B0": Emoji{
 		Key:        "1F468-1F3FC-200D-1F9B0",
 		Value:      "👨🏼‍🦰",
 		Descriptor: "Man: Medium-Light Skin Tone, Red Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F468-1F3FC-200D-1F9B1": Emoji{
 		Key:        "1F468-1F3FC-200D-1F9B1",
 		Value:      "👨🏼‍🦱",
 		Descriptor: "Man: Medium-Light Skin Tone, Curly Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F468-1F3FC-200D-1F9B2": Emoji{
 		Key:        "1F468-1F3FC-200D-1F9B2",
 		Value:      "👨🏼‍🦲",
 		Descriptor: "Man: Medium-Light Skin Tone, Bald",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F468-1F3FC-200D-1F9B3": Emoji{
 		Key:        "1F468-1F3FC-200D-1F9B3",
 		Value:      "👨🏼‍🦳",
 		Descriptor: "Man: Medium-Light Skin Tone, White Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
+	},
+	"1F468-1F3FC-200D-1F9BC": Emoji{
+		Key:        "1F468-1F3FC-200D-1F9BC",
+		Value:      "👨🏼‍🦼",
+		Descriptor: "Man in Motorized Wheelchair: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F468-1F3FC-200D-1F9BC-200D-27A1-FE0F": Emoji{
+		Key:        "1F468-1F3FC-200D-1F9BC-200D-27A1-FE0F",
+		Value:      "👨🏼‍🦼‍➡️",
+		Descriptor: "Man in Motorized Wheelchair Facing Right: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F468-1F3FC-200D-1F9BD": Emoji{
+		Key:        "1F468-1F3FC-200D-1F9BD",
+		Value:      "👨🏼‍🦽",
+		Descriptor: "Man in Manual Wheelchair: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F468-1F3FC-200D-1F9BD-200D-27A1-FE0F": Emoji{
+		Key:        "1F468-1F3FC-200D-1F9BD-200D-27A1-FE0F",
+		Value:      "👨🏼‍🦽‍➡️",
+		Descriptor: "Man in Manual Wheelchair Facing Right: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F468-1F3FC-200D-2695-FE0F": Emoji{
 		Key:        "1F468-1F3FC-200D-2695-FE0F",
 		Value:      "👨🏼‍⚕️",
 		Descriptor: "Man Health Worker: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FC-200D-2696-FE0F": Emoji{
 		Key:        "1F468-1F3FC-200D-2696-FE0F",
 		Value:      "👨🏼‍⚖️",
 		Descriptor: "Man Judge: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FC-200D-2708-FE0F": Emoji{
 		Key:        "1F468-1F3FC-200D-2708-FE0F",
 		Value:      "👨🏼‍✈️",
 		Descriptor: "Man Pilot: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F468-1F3FC-200D-2764-FE0F-200D-1F468-1F3FB": Emoji{
+		Key:        "1F468-1F3FC-200D-2764-FE0F-200D-1F468-1F3FB",
+		Value:      "👨🏼‍❤️‍👨🏻",
+		Descriptor: "Couple With Heart: Man, Man, Medium-Light Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FC-200D-2764-FE0F-200D-1F468-1F3FC": Emoji{
+		Key:        "1F468-1F3FC-200D-2764-FE0F-200D-1F468-1F3FC",
+		Value:      "👨🏼‍❤️‍👨🏼",
+		Descriptor: "Couple With Heart: Man, Man, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FC-200D-2764-FE0F-200D-1F468-1F3FD": Emoji{
+		Key:        "1F468-1F3FC-200D-2764-FE0F-200D-1F468-1F3FD",
+		Value:      "👨🏼‍❤️‍👨🏽",
+		Descriptor: "Couple With Heart: Man, Man, Medium-Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FC-200D-2764-FE0F-200D-1F468-1F3FE": Emoji{
+		Key:        "1F468-1F3FC-200D-2764-FE0F-200D-1F468-1F3FE",
+		Value:      "👨🏼‍❤️‍👨🏾",
+		Descriptor: "Couple With Heart: Man, Man, Medium-Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FC-200D-2764-FE0F-200D-1F468-1F3FF": Emoji{
+		Key:        "1F468-1F3FC-200D-2764-FE0F-200D-1F468-1F3FF",
+		Value:      "👨🏼‍❤️‍👨🏿",
+		Descriptor: "Couple With Heart: Man, Man, Medium-Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FB": Emoji{
+		Key:        "1F468-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FB",
+		Value:      "👨🏼‍❤️‍💋‍👨🏻",
+		Descriptor: "Kiss: Man, Man, Medium-Light Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FC": Emoji{
+		Key:        "1F468-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FC",
+		Value:      "👨🏼‍❤️‍💋‍👨🏼",
+		Descriptor: "Kiss: Man, Man, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FD": Emoji{
+		Key:        "1F468-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FD",
+		Value:      "👨🏼‍❤️‍💋‍👨🏽",
+		Descriptor: "Kiss: Man, Man, Medium-Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FE": Emoji{
+		Key:        "1F468-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FE",
+		Value:      "👨🏼‍❤️‍💋‍👨🏾",
+		Descriptor: "Kiss: Man, Man, Medium-Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FF": Emoji{
+		Key:        "1F468-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FF",
+		Value:      "👨🏼‍❤️‍💋‍👨🏿",
+		Descriptor: "Kiss: Man, Man, Medium-Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
 	},
 	"1F468-1F3FD": Emoji{
 		Key:        "1F468-1F3FD",
 		Value:      "👨🏽",
 		Descriptor: "Man: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F468-1F3FD-200D-1F33E": Emoji{
 		Key:        "1F468-1F3FD-200D-1F33E",
 		Value:      "👨🏽‍🌾",
 		Descriptor: "Man Farmer: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FD-200D-1F373": Emoji{
 		Key:        "1F468-1F3FD-200D-1F373",
 		Value:      "👨🏽‍🍳",
 		Descriptor: "Man Cook: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F468-1F3FD-200D-1F37C": Emoji{
+		Key:        "1F468-1F3FD-200D-1F37C",
+		Value:      "👨🏽‍🍼",
+		Descriptor: "Man Feeding Baby: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
 	},
 	"1F468-1F3FD-200D-1F393": Emoji{
 		Key:        "1F468-1F3FD-200D-1F393",
 		Value:      "👨🏽‍🎓",
 		Descriptor: "Man Student: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FD-200D-1F3A4": Emoji{
 		Key:        "1F468-1F3FD-200D-1F3A4",
 		Value:      "👨🏽‍🎤",
 		Descriptor: "Man Singer: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FD-200D-1F3A8": Emoji{
 		Key:        "1F468-1F3FD-200D-1F3A8",
 		Value:      "👨🏽‍🎨",
 		Descriptor: "Man Artist: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FD-200D-1F3EB": Emoji{
 		Key:        "1F468-1F3FD-200D-1F3EB",
 		Value:      "👨🏽‍🏫",
 		Descriptor: "Man Teacher: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FD-200D-1F3ED": Emoji{
 		Key:        "1F468-1F3FD-200D-1F3ED",
 		Value:      "👨🏽‍🏭",
 		Descriptor: "Man Factory Worker: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FD-200D-1F4BB": Emoji{
 		Key:        "1F468-1F3FD-200D-1F4BB",
 		Value:      "👨🏽‍💻",
 		Descriptor: "Man Technologist: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FD-200D-1F4BC": Emoji{
 		Key:        "1F468-1F3FD-200D-1F4BC",
 		Value:      "👨🏽‍💼",
 		Descriptor: "Man Office Worker: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FD-200D-1F527": Emoji{
 		Key:        "1F468-1F3FD-200D-1F527",
 		Value:      "👨🏽‍🔧",
 		Descriptor: "Man Mechanic: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FD-200D-1F52C": Emoji{
 		Key:        "1F468-1F3FD-200D-1F52C",
 		Value:      "👨🏽‍🔬",
 		Descriptor: "Man Scientist: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FD-200D-1F680": Emoji{
 		Key:        "1F468-1F3FD-200D-1F680",
 		Value:      "👨🏽‍🚀",
 		Descriptor: "Man Astronaut: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FD-200D-1F692": Emoji{
 		Key:        "1F468-1F3FD-200D-1F692",
 		Value:      "👨🏽‍🚒",
 		Descriptor: "Man Firefighter: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F468-1F3FD-200D-1F91D-200D-1F468-1F3FB": Emoji{
+		Key:        "1F468-1F3FD-200D-1F91D-200D-1F468-1F3FB",
+		Value:      "👨🏽‍🤝‍👨🏻",
+		Descriptor: "Men Holding Hands: Medium Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F468-1F3FD-200D-1F91D-200D-1F468-1F3FC": Emoji{
+		Key:        "1F468-1F3FD-200D-1F91D-200D-1F468-1F3FC",
+		Value:      "👨🏽‍🤝‍👨🏼",
+		Descriptor: "Men Holding Hands: Medium Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F468-1F3FD-200D-1F91D-200D-1F468-1F3FE": Emoji{
+		Key:        "1F468-1F3FD-200D-1F91D-200D-1F468-1F3FE",
+		Value:      "👨🏽‍🤝‍👨🏾",
+		Descriptor: "Men Holding Hands: Medium Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F468-1F3FD-200D-1F91D-200D-1F468-1F3FF": Emoji{
+		Key:        "1F468-1F3FD-200D-1F91D-200D-1F468-1F3FF",
+		Value:      "👨🏽‍🤝‍👨🏿",
+		Descriptor: "Men Holding Hands: Medium Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F468-1F3FD-200D-1F9AF": Emoji{
+		Key:        "1F468-1F3FD-200D-1F9AF",
+		Value:      "👨🏽‍🦯",
+		Descriptor: "Man With White Cane: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F468-1F3FD-200D-1F9AF-200D-27A1-FE0F": Emoji{
+		Key:        "1F468-1F3FD-200D-1F9AF-200D-27A1-FE0F",
+		Value:      "👨🏽‍🦯‍➡️",
+		Descriptor: "Man With White Cane Facing Right: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F468-1F3FD-200D-1F9B0": Emoji{
 		Key:        "1F468-1F3FD-200D-1F9B0",
 		Value:      "👨🏽‍🦰",
 		Descriptor: "Man: Medium Skin Tone, Red Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F468-1F3FD-200D-1F9B1": Emoji{
 		Key:        "1F468-1F3FD-200D-1F9B1",
 		Value:      "👨🏽‍🦱",
 		Descriptor: "Man: Medium Skin Tone, Curly Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F468-1F3FD-200D-1F9B2": Emoji{
 		Key:        "1F468-1F3FD-200D-1F9B2",
 		Value:      "👨🏽‍🦲",
 		Descriptor: "Man: Medium Skin Tone, Bald",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F468-1F3FD-200D-1F9B3": Emoji{
 		Key:        "1F468-1F3FD-200D-1F9B3",
 		Value:      "👨🏽‍🦳",
 		Descriptor: "Man: Medium Skin Tone, White Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
+	},
+	"1F468-1F3FD-200D-1F9BC": Emoji{
+		Key:        "1F468-1F3FD-200D-1F9BC",
+		Value:      "👨🏽‍🦼",
+		Descriptor: "Man in Motorized Wheelchair: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F468-1F3FD-200D-1F9BC-200D-27A1-FE0F": Emoji{
+		Key:        "1F468-1F3FD-200D-1F9BC-200D-27A1-FE0F",
+		Value:      "👨🏽‍🦼‍➡️",
+		Descriptor: "Man in Motorized Wheelchair Facing Right: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F468-1F3FD-200D-1F9BD": Emoji{
+		Key:        "1F468-1F3FD-200D-1F9BD",
+		Value:      "👨🏽‍🦽",
+		Descriptor: "Man in Manual Wheelchair: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F468-1F3FD-200D-1F9BD-200D-27A1-FE0F": Emoji{
+		Key:        "1F468-1F3FD-200D-1F9BD-200D-27A1-FE0F",
+		Value:      "👨🏽‍🦽‍➡️",
+		Descriptor: "Man in Manual Wheelchair Facing Right: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F468-1F3FD-200D-2695-FE0F": Emoji{
 		Key:        "1F468-1F3FD-200D-2695-FE0F",
 		Value:      "👨🏽‍⚕️",
 		Descriptor: "Man Health Worker: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FD-200D-2696-FE0F": Emoji{
 		Key:        "1F468-1F3FD-200D-2696-FE0F",
 		Value:      "👨🏽‍⚖️",
 		Descriptor: "Man Judge: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FD-200D-2708-FE0F": Emoji{
 		Key:        "1F468-1F3FD-200D-2708-FE0F",
 		Value:      "👨🏽‍✈️",
 		Descriptor: "Man Pilot: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F468-1F3FD-200D-2764-FE0F-200D-1F468-1F3FB": Emoji{
+		Key:        "1F468-1F3FD-200D-2764-FE0F-200D-1F468-1F3FB",
+		Value:      "👨🏽‍❤️‍👨🏻",
+		Descriptor: "Couple With Heart: Man, Man, Medium Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FD-200D-2764-FE0F-200D-1F468-1F3FC": Emoji{
+		Key:        "1F468-1F3FD-200D-2764-FE0F-200D-1F468-1F3FC",
+		Value:      "👨🏽‍❤️‍👨🏼",
+		Descriptor: "Couple With Heart: Man, Man, Medium Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FD-200D-2764-FE0F-200D-1F468-1F3FD": Emoji{
+		Key:        "1F468-1F3FD-200D-2764-FE0F-200D-1F468-1F3FD",
+		Value:      "👨🏽‍❤️‍👨🏽",
+		Descriptor: "Couple With Heart: Man, Man, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FD-200D-2764-FE0F-200D-1F468-1F3FE": Emoji{
+		Key:        "1F468-1F3FD-200D-2764-FE0F-200D-1F468-1F3FE",
+		Value:      "👨🏽‍❤️‍👨🏾",
+		Descriptor: "Couple With Heart: Man, Man, Medium Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FD-200D-2764-FE0F-200D-1F468-1F3FF": Emoji{
+		Key:        "1F468-1F3FD-200D-2764-FE0F-200D-1F468-1F3FF",
+		Value:      "👨🏽‍❤️‍👨🏿",
+		Descriptor: "Couple With Heart: Man, Man, Medium Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FB": Emoji{
+		Key:        "1F468-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FB",
+		Value:      "👨🏽‍❤️‍💋‍👨🏻",
+		Descriptor: "Kiss: Man, Man, Medium Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FC": Emoji{
+		Key:        "1F468-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FC",
+		Value:      "👨🏽‍❤️‍💋‍👨🏼",
+		Descriptor: "Kiss: Man, Man, Medium Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FD": Emoji{
+		Key:        "1F468-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FD",
+		Value:      "👨🏽‍❤️‍💋‍👨🏽",
+		Descriptor: "Kiss: Man, Man, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FE": Emoji{
+		Key:        "1F468-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FE",
+		Value:      "👨🏽‍❤️‍💋‍👨🏾",
+		Descriptor: "Kiss: Man, Man, Medium Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FF": Emoji{
+		Key:        "1F468-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FF",
+		Value:      "👨🏽‍❤️‍💋‍👨🏿",
+		Descriptor: "Kiss: Man, Man, Medium Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
 	},
 	"1F468-1F3FE": Emoji{
 		Key:        "1F468-1F3FE",
 		Value:      "👨🏾",
 		Descriptor: "Man: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F468-1F3FE-200D-1F33E": Emoji{
 		Key:        "1F468-1F3FE-200D-1F33E",
 		Value:      "👨🏾‍🌾",
 		Descriptor: "Man Farmer: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FE-200D-1F373": Emoji{
 		Key:        "1F468-1F3FE-200D-1F373",
 		Value:      "👨🏾‍🍳",
 		Descriptor: "Man Cook: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F468-1F3FE-200D-1F37C": Emoji{
+		Key:        "1F468-1F3FE-200D-1F37C",
+		Value:      "👨🏾‍🍼",
+		Descriptor: "Man Feeding Baby: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
 	},
 	"1F468-1F3FE-200D-1F393": Emoji{
 		Key:        "1F468-1F3FE-200D-1F393",
 		Value:      "👨🏾‍🎓",
 		Descriptor: "Man Student: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FE-200D-1F3A4": Emoji{
 		Key:        "1F468-1F3FE-200D-1F3A4",
 		Value:      "👨🏾‍🎤",
 		Descriptor: "Man Singer: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FE-200D-1F3A8": Emoji{
 		Key:        "1F468-1F3FE-200D-1F3A8",
 		Value:      "👨🏾‍🎨",
 		Descriptor: "Man Artist: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FE-200D-1F3EB": Emoji{
 		Key:        "1F468-1F3FE-200D-1F3EB",
 		Value:      "👨🏾‍🏫",
 		Descriptor: "Man Teacher: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FE-200D-1F3ED": Emoji{
 		Key:        "1F468-1F3FE-200D-1F3ED",
 		Value:      "👨🏾‍🏭",
 		Descriptor: "Man Factory Worker: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FE-200D-1F4BB": Emoji{
 		Key:        "1F468-1F3FE-200D-1F4BB",
 		Value:      "👨🏾‍💻",
 		Descriptor: "Man Technologist: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FE-200D-1F4BC": Emoji{
 		Key:        "1F468-1F3FE-200D-1F4BC",
 		Value:      "👨🏾‍💼",
 		Descriptor: "Man Office Worker: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FE-200D-1F527": Emoji{
 		Key:        "1F468-1F3FE-200D-1F527",
 		Value:      "👨🏾‍🔧",
 		Descriptor: "Man Mechanic: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FE-200D-1F52C": Emoji{
 		Key:        "1F468-1F3FE-200D-1F52C",
 		Value:      "👨🏾‍🔬",
 		Descriptor: "Man Scientist: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FE-200D-1F680": Emoji{
 		Key:        "1F468-1F3FE-200D-1F680",
 		Value:      "👨🏾‍🚀",
 		Descriptor: "Man Astronaut: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FE-200D-1F692": Emoji{
 		Key:        "1F468-1F3FE-200D-1F692",
 		Value:      "👨🏾‍🚒",
 		Descriptor: "Man Firefighter: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F468-1F3FE-200D-1F91D-200D-1F468-1F3FB": Emoji{
+		Key:        "1F468-1F3FE-200D-1F91D-200D-1F468-1F3FB",
+		Value:      "👨🏾‍🤝‍👨🏻",
+		Descriptor: "Men Holding Hands: Medium-Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F468-1F3FE-200D-1F91D-200D-1F468-1F3FC": Emoji{
+		Key:        "1F468-1F3FE-200D-1F91D-200D-1F468-1F3FC",
+		Value:      "👨🏾‍🤝‍👨🏼",
+		Descriptor: "Men Holding Hands: Medium-Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F468-1F3FE-200D-1F91D-200D-1F468-1F3FD": Emoji{
+		Key:        "1F468-1F3FE-200D-1F91D-200D-1F468-1F3FD",
+		Value:      "👨🏾‍🤝‍👨🏽",
+		Descriptor: "Men Holding Hands: Medium-Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F468-1F3FE-200D-1F91D-200D-1F468-1F3FF": Emoji{
+		Key:        "1F468-1F3FE-200D-1F91D-200D-1F468-1F3FF",
+		Value:      "👨🏾‍🤝‍👨🏿",
+		Descriptor: "Men Holding Hands: Medium-Dark Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F468-1F3FE-200D-1F9AF": Emoji{
+		Key:        "1F468-1F3FE-200D-1F9AF",
+		Value:      "👨🏾‍🦯",
+		Descriptor: "Man With White Cane: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F468-1F3FE-200D-1F9AF-200D-27A1-FE0F": Emoji{
+		Key:        "1F468-1F3FE-200D-1F9AF-200D-27A1-FE0F",
+		Value:      "👨🏾‍🦯‍➡️",
+		Descriptor: "Man With White Cane Facing Right: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F468-1F3FE-200D-1F9B0": Emoji{
 		Key:        "1F468-1F3FE-200D-1F9B0",
 		Value:      "👨🏾‍🦰",
 		Descriptor: "Man: Medium-Dark Skin Tone, Red Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F468-1F3FE-200D-1F9B1": Emoji{
 		Key:        "1F468-1F3FE-200D-1F9B1",
 		Value:      "👨🏾‍🦱",
 		Descriptor: "Man: Medium-Dark Skin Tone, Curly Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F468-1F3FE-200D-1F9B2": Emoji{
 		Key:        "1F468-1F3FE-200D-1F9B2",
 		Value:      "👨🏾‍🦲",
 		Descriptor: "Man: Medium-Dark Skin Tone, Bald",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F468-1F3FE-200D-1F9B3": Emoji{
 		Key:        "1F468-1F3FE-200D-1F9B3",
 		Value:      "👨🏾‍🦳",
 		Descriptor: "Man: Medium-Dark Skin Tone, White Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
+	},
+	"1F468-1F3FE-200D-1F9BC": Emoji{
+		Key:        "1F468-1F3FE-200D-1F9BC",
+		Value:      "👨🏾‍🦼",
+		Descriptor: "Man in Motorized Wheelchair: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F468-1F3FE-200D-1F9BC-200D-27A1-FE0F": Emoji{
+		Key:        "1F468-1F3FE-200D-1F9BC-200D-27A1-FE0F",
+		Value:      "👨🏾‍🦼‍➡️",
+		Descriptor: "Man in Motorized Wheelchair Facing Right: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F468-1F3FE-200D-1F9BD": Emoji{
+		Key:        "1F468-1F3FE-200D-1F9BD",
+		Value:      "👨🏾‍🦽",
+		Descriptor: "Man in Manual Wheelchair: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F468-1F3FE-200D-1F9BD-200D-27A1-FE0F": Emoji{
+		Key:        "1F468-1F3FE-200D-1F9BD-200D-27A1-FE0F",
+		Value:      "👨🏾‍🦽‍➡️",
+		Descriptor: "Man in Manual Wheelchair Facing Right: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F468-1F3FE-200D-2695-FE0F": Emoji{
 		Key:        "1F468-1F3FE-200D-2695-FE0F",
 		Value:      "👨🏾‍⚕️",
 		Descriptor: "Man Health Worker: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FE-200D-2696-FE0F": Emoji{
 		Key:        "1F468-1F3FE-200D-2696-FE0F",
 		Value:      "👨🏾‍⚖️",
 		Descriptor: "Man Judge: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FE-200D-2708-FE0F": Emoji{
 		Key:        "1F468-1F3FE-200D-2708-FE0F",
 		Value:      "👨🏾‍✈️",
 		Descriptor: "Man Pilot: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F468-1F3FE-200D-2764-FE0F-200D-1F468-1F3FB": Emoji{
+		Key:        "1F468-1F3FE-200D-2764-FE0F-200D-1F468-1F3FB",
+		Value:      "👨🏾‍❤️‍👨🏻",
+		Descriptor: "Couple With Heart: Man, Man, Medium-Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FE-200D-2764-FE0F-200D-1F468-1F3FC": Emoji{
+		Key:        "1F468-1F3FE-200D-2764-FE0F-200D-1F468-1F3FC",
+		Value:      "👨🏾‍❤️‍👨🏼",
+		Descriptor: "Couple With Heart: Man, Man, Medium-Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FE-200D-2764-FE0F-200D-1F468-1F3FD": Emoji{
+		Key:        "1F468-1F3FE-200D-2764-FE0F-200D-1F468-1F3FD",
+		Value:      "👨🏾‍❤️‍👨🏽",
+		Descriptor: "Couple With Heart: Man, Man, Medium-Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FE-200D-2764-FE0F-200D-1F468-1F3FE": Emoji{
+		Key:        "1F468-1F3FE-200D-2764-FE0F-200D-1F468-1F3FE",
+		Value:      "👨🏾‍❤️‍👨🏾",
+		Descriptor: "Couple With Heart: Man, Man, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FE-200D-2764-FE0F-200D-1F468-1F3FF": Emoji{
+		Key:        "1F468-1F3FE-200D-2764-FE0F-200D-1F468-1F3FF",
+		Value:      "👨🏾‍❤️‍👨🏿",
+		Descriptor: "Couple With Heart: Man, Man, Medium-Dark Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FB": Emoji{
+		Key:        "1F468-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FB",
+		Value:      "👨🏾‍❤️‍💋‍👨🏻",
+		Descriptor: "Kiss: Man, Man, Medium-Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FC": Emoji{
+		Key:        "1F468-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FC",
+		Value:      "👨🏾‍❤️‍💋‍👨🏼",
+		Descriptor: "Kiss: Man, Man, Medium-Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FD": Emoji{
+		Key:        "1F468-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FD",
+		Value:      "👨🏾‍❤️‍💋‍👨🏽",
+		Descriptor: "Kiss: Man, Man, Medium-Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FE": Emoji{
+		Key:        "1F468-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FE",
+		Value:      "👨🏾‍❤️‍💋‍👨🏾",
+		Descriptor: "Kiss: Man, Man, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FF": Emoji{
+		Key:        "1F468-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FF",
+		Value:      "👨🏾‍❤️‍💋‍👨🏿",
+		Descriptor: "Kiss: Man, Man, Medium-Dark Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
 	},
 	"1F468-1F3FF": Emoji{
 		Key:        "1F468-1F3FF",
 		Value:      "👨🏿",
 		Descriptor: "Man: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F468-1F3FF-200D-1F33E": Emoji{
 		Key:        "1F468-1F3FF-200D-1F33E",
 		Value:      "👨🏿‍🌾",
 		Descriptor: "Man Farmer: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FF-200D-1F373": Emoji{
 		Key:        "1F468-1F3FF-200D-1F373",
 		Value:      "👨🏿‍🍳",
 		Descriptor: "Man Cook: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F468-1F3FF-200D-1F37C": Emoji{
+		Key:        "1F468-1F3FF-200D-1F37C",
+		Value:      "👨🏿‍🍼",
+		Descriptor: "Man Feeding Baby: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
 	},
 	"1F468-1F3FF-200D-1F393": Emoji{
 		Key:        "1F468-1F3FF-200D-1F393",
 		Value:      "👨🏿‍🎓",
 		Descriptor: "Man Student: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FF-200D-1F3A4": Emoji{
 		Key:        "1F468-1F3FF-200D-1F3A4",
 		Value:      "👨🏿‍🎤",
 		Descriptor: "Man Singer: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FF-200D-1F3A8": Emoji{
 		Key:        "1F468-1F3FF-200D-1F3A8",
 		Value:      "👨🏿‍🎨",
 		Descriptor: "Man Artist: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FF-200D-1F3EB": Emoji{
 		Key:        "1F468-1F3FF-200D-1F3EB",
 		Value:      "👨🏿‍🏫",
 		Descriptor: "Man Teacher: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FF-200D-1F3ED": Emoji{
 		Key:        "1F468-1F3FF-200D-1F3ED",
 		Value:      "👨🏿‍🏭",
 		Descriptor: "Man Factory Worker: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FF-200D-1F4BB": Emoji{
 		Key:        "1F468-1F3FF-200D-1F4BB",
 		Value:      "👨🏿‍💻",
 		Descriptor: "Man Technologist: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FF-200D-1F4BC": Emoji{
 		Key:        "1F468-1F3FF-200D-1F4BC",
 		Value:      "👨🏿‍💼",
 		Descriptor: "Man Office Worker: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FF-200D-1F527": Emoji{
 		Key:        "1F468-1F3FF-200D-1F527",
 		Value:      "👨🏿‍🔧",
 		Descriptor: "Man Mechanic: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FF-200D-1F52C": Emoji{
 		Key:        "1F468-1F3FF-200D-1F52C",
 		Value:      "👨🏿‍🔬",
 		Descriptor: "Man Scientist: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FF-200D-1F680": Emoji{
 		Key:        "1F468-1F3FF-200D-1F680",
 		Value:      "👨🏿‍🚀",
 		Descriptor: "Man Astronaut: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FF-200D-1F692": Emoji{
 		Key:        "1F468-1F3FF-200D-1F692",
 		Value:      "👨🏿‍🚒",
 		Descriptor: "Man Firefighter: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F468-1F3FF-200D-1F91D-200D-1F468-1F3FB": Emoji{
+		Key:        "1F468-1F3FF-200D-1F91D-200D-1F468-1F3FB",
+		Value:      "👨🏿‍🤝‍👨🏻",
+		Descriptor: "Men Holding Hands: Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F468-1F3FF-200D-1F91D-200D-1F468-1F3FC": Emoji{
+		Key:        "1F468-1F3FF-200D-1F91D-200D-1F468-1F3FC",
+		Value:      "👨🏿‍🤝‍👨🏼",
+		Descriptor: "Men Holding Hands: Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F468-1F3FF-200D-1F91D-200D-1F468-1F3FD": Emoji{
+		Key:        "1F468-1F3FF-200D-1F91D-200D-1F468-1F3FD",
+		Value:      "👨🏿‍🤝‍👨🏽",
+		Descriptor: "Men Holding Hands: Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F468-1F3FF-200D-1F91D-200D-1F468-1F3FE": Emoji{
+		Key:        "1F468-1F3FF-200D-1F91D-200D-1F468-1F3FE",
+		Value:      "👨🏿‍🤝‍👨🏾",
+		Descriptor: "Men Holding Hands: Dark Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F468-1F3FF-200D-1F9AF": Emoji{
+		Key:        "1F468-1F3FF-200D-1F9AF",
+		Value:      "👨🏿‍🦯",
+		Descriptor: "Man With White Cane: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F468-1F3FF-200D-1F9AF-200D-27A1-FE0F": Emoji{
+		Key:        "1F468-1F3FF-200D-1F9AF-200D-27A1-FE0F",
+		Value:      "👨🏿‍🦯‍➡️",
+		Descriptor: "Man With White Cane Facing Right: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F468-1F3FF-200D-1F9B0": Emoji{
 		Key:        "1F468-1F3FF-200D-1F9B0",
 		Value:      "👨🏿‍🦰",
 		Descriptor: "Man: Dark Skin Tone, Red Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F468-1F3FF-200D-1F9B1": Emoji{
 		Key:        "1F468-1F3FF-200D-1F9B1",
 		Value:      "👨🏿‍🦱",
 		Descriptor: "Man: Dark Skin Tone, Curly Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F468-1F3FF-200D-1F9B2": Emoji{
 		Key:        "1F468-1F3FF-200D-1F9B2",
 		Value:      "👨🏿‍🦲",
 		Descriptor: "Man: Dark Skin Tone, Bald",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F468-1F3FF-200D-1F9B3": Emoji{
 		Key:        "1F468-1F3FF-200D-1F9B3",
 		Value:      "👨🏿‍🦳",
 		Descriptor: "Man: Dark Skin Tone, White Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
+	},
+	"1F468-1F3FF-200D-1F9BC": Emoji{
+		Key:        "1F468-1F3FF-200D-1F9BC",
+		Value:      "👨🏿‍🦼",
+		Descriptor: "Man in Motorized Wheelchair: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F468-1F3FF-200D-1F9BC-200D-27A1-FE0F": Emoji{
+		Key:        "1F468-1F3FF-200D-1F9BC-200D-27A1-FE0F",
+		Value:      "👨🏿‍🦼‍➡️",
+		Descriptor: "Man in Motorized Wheelchair Facing Right: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F468-1F3FF-200D-1F9BD": Emoji{
+		Key:        "1F468-1F3FF-200D-1F9BD",
+		Value:      "👨🏿‍🦽",
+		Descriptor: "Man in Manual Wheelchair: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F468-1F3FF-200D-1F9BD-200D-27A1-FE0F": Emoji{
+		Key:        "1F468-1F3FF-200D-1F9BD-200D-27A1-FE0F",
+		Value:      "👨🏿‍🦽‍➡️",
+		Descriptor: "Man in Manual Wheelchair Facing Right: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F468-1F3FF-200D-2695-FE0F": Emoji{
 		Key:        "1F468-1F3FF-200D-2695-FE0F",
 		Value:      "👨🏿‍⚕️",
 		Descriptor: "Man Health Worker: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FF-200D-2696-FE0F": Emoji{
 		Key:        "1F468-1F3FF-200D-2696-FE0F",
 		Value:      "👨🏿‍⚖️",
 		Descriptor: "Man Judge: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-1F3FF-200D-2708-FE0F": Emoji{
 		Key:        "1F468-1F3FF-200D-2708-FE0F",
 		Value:      "👨🏿‍✈️",
 		Descriptor: "Man Pilot: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F468-1F3FF-200D-2764-FE0F-200D-1F468-1F3FB": Emoji{
+		Key:        "1F468-1F3FF-200D-2764-FE0F-200D-1F468-1F3FB",
+		Value:      "👨🏿‍❤️‍👨🏻",
+		Descriptor: "Couple With Heart: Man, Man, Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FF-200D-2764-FE0F-200D-1F468-1F3FC": Emoji{
+		Key:        "1F468-1F3FF-200D-2764-FE0F-200D-1F468-1F3FC",
+		Value:      "👨🏿‍❤️‍👨🏼",
+		Descriptor: "Couple With Heart: Man, Man, Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FF-200D-2764-FE0F-200D-1F468-1F3FD": Emoji{
+		Key:        "1F468-1F3FF-200D-2764-FE0F-200D-1F468-1F3FD",
+		Value:      "👨🏿‍❤️‍👨🏽",
+		Descriptor: "Couple With Heart: Man, Man, Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FF-200D-2764-FE0F-200D-1F468-1F3FE": Emoji{
+		Key:        "1F468-1F3FF-200D-2764-FE0F-200D-1F468-1F3FE",
+		Value:      "👨🏿‍❤️‍👨🏾",
+		Descriptor: "Couple With Heart: Man, Man, Dark Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FF-200D-2764-FE0F-200D-1F468-1F3FF": Emoji{
+		Key:        "1F468-1F3FF-200D-2764-FE0F-200D-1F468-1F3FF",
+		Value:      "👨🏿‍❤️‍👨🏿",
+		Descriptor: "Couple With Heart: Man, Man, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FB": Emoji{
+		Key:        "1F468-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FB",
+		Value:      "👨🏿‍❤️‍💋‍👨🏻",
+		Descriptor: "Kiss: Man, Man, Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FC": Emoji{
+		Key:        "1F468-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FC",
+		Value:      "👨🏿‍❤️‍💋‍👨🏼",
+		Descriptor: "Kiss: Man, Man, Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FD": Emoji{
+		Key:        "1F468-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FD",
+		Value:      "👨🏿‍❤️‍💋‍👨🏽",
+		Descriptor: "Kiss: Man, Man, Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FE": Emoji{
+		Key:        "1F468-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FE",
+		Value:      "👨🏿‍❤️‍💋‍👨🏾",
+		Descriptor: "Kiss: Man, Man, Dark Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F468-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FF": Emoji{
+		Key:        "1F468-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FF",
+		Value:      "👨🏿‍❤️‍💋‍👨🏿",
+		Descriptor: "Kiss: Man, Man, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
 	},
 	"1F468-200D-1F33E": Emoji{
 		Key:        "1F468-200D-1F33E",
 		Value:      "👨‍🌾",
 		Descriptor: "Man Farmer",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-200D-1F373": Emoji{
 		Key:        "1F468-200D-1F373",
 		Value:      "👨‍🍳",
 		Descriptor: "Man Cook",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F468-200D-1F37C": Emoji{
+		Key:        "1F468-200D-1F37C",
+		Value:      "👨‍🍼",
+		Descriptor: "Man Feeding Baby",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
 	},
 	"1F468-200D-1F393": Emoji{
 		Key:        "1F468-200D-1F393",
 		Value:      "👨‍🎓",
 		Descriptor: "Man Student",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-200D-1F3A4": Emoji{
 		Key:        "1F468-200D-1F3A4",
 		Value:      "👨‍🎤",
 		Descriptor: "Man Singer",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-200D-1F3A8": Emoji{
 		Key:        "1F468-200D-1F3A8",
 		Value:      "👨‍🎨",
 		Descriptor: "Man Artist",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-200D-1F3EB": Emoji{
 		Key:        "1F468-200D-1F3EB",
 		Value:      "👨‍🏫",
 		Descriptor: "Man Teacher",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-200D-1F3ED": Emoji{
 		Key:        "1F468-200D-1F3ED",
 		Value:      "👨‍🏭",
 		Descriptor: "Man Factory Worker",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-200D-1F466": Emoji{
 		Key:        "1F468-200D-1F466",
 		Value:      "👨‍👦",
 		Descriptor: "Family: Man, Boy",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "4.0",
 	},
 	"1F468-200D-1F466-200D-1F466": Emoji{
 		Key:        "1F468-200D-1F466-200D-1F466",
 		Value:      "👨‍👦‍👦",
 		Descriptor: "Family: Man, Boy, Boy",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "4.0",
 	},
 	"1F468-200D-1F467": Emoji{
 		Key:        "1F468-200D-1F467",
 		Value:      "👨‍👧",
 		Descriptor: "Family: Man, Girl",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "4.0",
 	},
 	"1F468-200D-1F467-200D-1F466": Emoji{
 		Key:        "1F468-200D-1F467-200D-1F466",
 		Value:      "👨‍👧‍👦",
 		Descriptor: "Family: Man, Girl, Boy",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "4.0",
 	},
 	"1F468-200D-1F467-200D-1F467": Emoji{
 		Key:        "1F468-200D-1F467-200D-1F467",
 		Value:      "👨‍👧‍👧",
 		Descriptor: "Family: Man, Girl, Girl",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "4.0",
 	},
 	"1F468-200D-1F468-200D-1F466": Emoji{
 		Key:        "1F468-200D-1F468-200D-1F466",
 		Value:      "👨‍👨‍👦",
 		Descriptor: "Family: Man, Man, Boy",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "2.0",
 	},
 	"1F468-200D-1F468-200D-1F466-200D-1F466": Emoji{
 		Key:        "1F468-200D-1F468-200D-1F466-200D-1F466",
 		Value:      "👨‍👨‍👦‍👦",
 		Descriptor: "Family: Man, Man, Boy, Boy",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "2.0",
 	},
 	"1F468-200D-1F468-200D-1F467": Emoji{
 		Key:        "1F468-200D-1F468-200D-1F467",
 		Value:      "👨‍👨‍👧",
 		Descriptor: "Family: Man, Man, Girl",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "2.0",
 	},
 	"1F468-200D-1F468-200D-1F467-200D-1F466": Emoji{
 		Key:        "1F468-200D-1F468-200D-1F467-200D-1F466",
 		Value:      "👨‍👨‍👧‍👦",
 		Descriptor: "Family: Man, Man, Girl, Boy",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "2.0",
 	},
 	"1F468-200D-1F468-200D-1F467-200D-1F467": Emoji{
 		Key:        "1F468-200D-1F468-200D-1F467-200D-1F467",
 		Value:      "👨‍👨‍👧‍👧",
 		Descriptor: "Family: Man, Man, Girl, Girl",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "2.0",
 	},
 	"1F468-200D-1F469-200D-1F466": Emoji{
 		Key:        "1F468-200D-1F469-200D-1F466",
 		Value:      "👨‍👩‍👦",
 		Descriptor: "Family: Man, Woman, Boy",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "2.0",
 	},
 	"1F468-200D-1F469-200D-1F466-200D-1F466": Emoji{
 		Key:        "1F468-200D-1F469-200D-1F466-200D-1F466",
 		Value:      "👨‍👩‍👦‍👦",
 		Descriptor: "Family: Man, Woman, Boy, Boy",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "2.0",
 	},
 	"1F468-200D-1F469-200D-1F467": Emoji{
 		Key:        "1F468-200D-1F469-200D-1F467",
 		Value:      "👨‍👩‍👧",
 		Descriptor: "Family: Man, Woman, Girl",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "2.0",
 	},
 	"1F468-200D-1F469-200D-1F467-200D-1F466": Emoji{
 		Key:        "1F468-200D-1F469-200D-1F467-200D-1F466",
 		Value:      "👨‍👩‍👧‍👦",
 		Descriptor: "Family: Man, Woman, Girl, Boy",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "2.0",
 	},
 	"1F468-200D-1F469-200D-1F467-200D-1F467": Emoji{
 		Key:        "1F468-200D-1F469-200D-1F467-200D-1F467",
 		Value:      "👨‍👩‍👧‍👧",
 		Descriptor: "Family: Man, Woman, Girl, Girl",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "2.0",
 	},
 	"1F468-200D-1F4BB": Emoji{
 		Key:        "1F468-200D-1F4BB",
 		Value:      "👨‍💻",
 		Descriptor: "Man Technologist",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-200D-1F4BC": Emoji{
 		Key:        "1F468-200D-1F4BC",
 		Value:      "👨‍💼",
 		Descriptor: "Man Office Worker",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-200D-1F527": Emoji{
 		Key:        "1F468-200D-1F527",
 		Value:      "👨‍🔧",
 		Descriptor: "Man Mechanic",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-200D-1F52C": Emoji{
 		Key:        "1F468-200D-1F52C",
 		Value:      "👨‍🔬",
 		Descriptor: "Man Scientist",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-200D-1F680": Emoji{
 		Key:        "1F468-200D-1F680",
 		Value:      "👨‍🚀",
 		Descriptor: "Man Astronaut",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-200D-1F692": Emoji{
 		Key:        "1F468-200D-1F692",
 		Value:      "👨‍🚒",
 		Descriptor: "Man Firefighter",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F468-200D-1F9AF": Emoji{
+		Key:        "1F468-200D-1F9AF",
+		Value:      "👨‍🦯",
+		Descriptor: "Man With White Cane",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F468-200D-1F9AF-200D-27A1-FE0F": Emoji{
+		Key:        "1F468-200D-1F9AF-200D-27A1-FE0F",
+		Value:      "👨‍🦯‍➡️",
+		Descriptor: "Man With White Cane Facing Right",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F468-200D-1F9B0": Emoji{
 		Key:        "1F468-200D-1F9B0",
 		Value:      "👨‍🦰",
 		Descriptor: "Man: Red Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F468-200D-1F9B1": Emoji{
 		Key:        "1F468-200D-1F9B1",
 		Value:      "👨‍🦱",
 		Descriptor: "Man: Curly Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F468-200D-1F9B2": Emoji{
 		Key:        "1F468-200D-1F9B2",
 		Value:      "👨‍🦲",
 		Descriptor: "Man: Bald",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F468-200D-1F9B3": Emoji{
 		Key:        "1F468-200D-1F9B3",
 		Value:      "👨‍🦳",
 		Descriptor: "Man: White Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
+	},
+	"1F468-200D-1F9BC": Emoji{
+		Key:        "1F468-200D-1F9BC",
+		Value:      "👨‍🦼",
+		Descriptor: "Man in Motorized Wheelchair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F468-200D-1F9BC-200D-27A1-FE0F": Emoji{
+		Key:        "1F468-200D-1F9BC-200D-27A1-FE0F",
+		Value:      "👨‍🦼‍➡️",
+		Descriptor: "Man in Motorized Wheelchair Facing Right",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F468-200D-1F9BD": Emoji{
+		Key:        "1F468-200D-1F9BD",
+		Value:      "👨‍🦽",
+		Descriptor: "Man in Manual Wheelchair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F468-200D-1F9BD-200D-27A1-FE0F": Emoji{
+		Key:        "1F468-200D-1F9BD-200D-27A1-FE0F",
+		Value:      "👨‍🦽‍➡️",
+		Descriptor: "Man in Manual Wheelchair Facing Right",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F468-200D-2695-FE0F": Emoji{
 		Key:        "1F468-200D-2695-FE0F",
 		Value:      "👨‍⚕️",
 		Descriptor: "Man Health Worker",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-200D-2696-FE0F": Emoji{
 		Key:        "1F468-200D-2696-FE0F",
 		Value:      "👨‍⚖️",
 		Descriptor: "Man Judge",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-200D-2708-FE0F": Emoji{
 		Key:        "1F468-200D-2708-FE0F",
 		Value:      "👨‍✈️",
 		Descriptor: "Man Pilot",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F468-200D-2764-FE0F-200D-1F468": Emoji{
 		Key:        "1F468-200D-2764-FE0F-200D-1F468",
 		Value:      "👨‍❤️‍👨",
 		Descriptor: "Couple With Heart: Man, Man",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "2.0",
 	},
 	"1F468-200D-2764-FE0F-200D-1F48B-200D-1F468": Emoji{
 		Key:        "1F468-200D-2764-FE0F-200D-1F48B-200D-1F468",
 		Value:      "👨‍❤️‍💋‍👨",
 		Descriptor: "Kiss: Man, Man",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "2.0",
 	},
 	"1F469": Emoji{
 		Key:        "1F469",
 		Value:      "👩",
 		Descriptor: "Woman",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "0.6",
 	},
 	"1F469-1F3FB": Emoji{
 		Key:        "1F469-1F3FB",
 		Value:      "👩🏻",
 		Descriptor: "Woman: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F469-1F3FB-200D-1F33E": Emoji{
 		Key:        "1F469-1F3FB-200D-1F33E",
 		Value:      "👩🏻‍🌾",
 		Descriptor: "Woman Farmer: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FB-200D-1F373": Emoji{
 		Key:        "1F469-1F3FB-200D-1F373",
 		Value:      "👩🏻‍🍳",
 		Descriptor: "Woman Cook: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F469-1F3FB-200D-1F37C": Emoji{
+		Key:        "1F469-1F3FB-200D-1F37C",
+		Value:      "👩🏻‍🍼",
+		Descriptor: "Woman Feeding Baby: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
 	},
 	"1F469-1F3FB-200D-1F393": Emoji{
 		Key:        "1F469-1F3FB-200D-1F393",
 		Value:      "👩🏻‍🎓",
 		Descriptor: "Woman Student: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FB-200D-1F3A4": Emoji{
 		Key:        "1F469-1F3FB-200D-1F3A4",
 		Value:      "👩🏻‍🎤",
 		Descriptor: "Woman Singer: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FB-200D-1F3A8": Emoji{
 		Key:        "1F469-1F3FB-200D-1F3A8",
 		Value:      "👩🏻‍🎨",
 		Descriptor: "Woman Artist: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FB-200D-1F3EB": Emoji{
 		Key:        "1F469-1F3FB-200D-1F3EB",
 		Value:      "👩🏻‍🏫",
 		Descriptor: "Woman Teacher: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FB-200D-1F3ED": Emoji{
 		Key:        "1F469-1F3FB-200D-1F3ED",
 		Value:      "👩🏻‍🏭",
 		Descriptor: "Woman Factory Worker: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FB-200D-1F4BB": Emoji{
 		Key:        "1F469-1F3FB-200D-1F4BB",
 		Value:      "👩🏻‍💻",
 		Descriptor: "Woman Technologist: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FB-200D-1F4BC": Emoji{
 		Key:        "1F469-1F3FB-200D-1F4BC",
 		Value:      "👩🏻‍💼",
 		Descriptor: "Woman Office Worker: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FB-200D-1F527": Emoji{
 		Key:        "1F469-1F3FB-200D-1F527",
 		Value:      "👩🏻‍🔧",
 		Descriptor: "Woman Mechanic: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FB-200D-1F52C": Emoji{
 		Key:        "1F469-1F3FB-200D-1F52C",
 		Value:      "👩🏻‍🔬",
 		Descriptor: "Woman Scientist: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FB-200D-1F680": Emoji{
 		Key:        "1F469-1F3FB-200D-1F680",
 		Value:      "👩🏻‍🚀",
 		Descriptor: "Woman Astronaut: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FB-200D-1F692": Emoji{
 		Key:        "1F469-1F3FB-200D-1F692",
 		Value:      "👩🏻‍🚒",
 		Descriptor: "Woman Firefighter: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F469-1F3FB-200D-1F91D-200D-1F468-1F3FC": Emoji{
+		Key:        "1F469-1F3FB-200D-1F91D-200D-1F468-1F3FC",
+		Value:      "👩🏻‍🤝‍👨🏼",
+		Descriptor: "Woman and Man Holding Hands: Light Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FB-200D-1F91D-200D-1F468-1F3FD": Emoji{
+		Key:        "1F469-1F3FB-200D-1F91D-200D-1F468-1F3FD",
+		Value:      "👩🏻‍🤝‍👨🏽",
+		Descriptor: "Woman and Man Holding Hands: Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FB-200D-1F91D-200D-1F468-1F3FE": Emoji{
+		Key:        "1F469-1F3FB-200D-1F91D-200D-1F468-1F3FE",
+		Value:      "👩🏻‍🤝‍👨🏾",
+		Descriptor: "Woman and Man Holding Hands: Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FB-200D-1F91D-200D-1F468-1F3FF": Emoji{
+		Key:        "1F469-1F3FB-200D-1F91D-200D-1F468-1F3FF",
+		Value:      "👩🏻‍🤝‍👨🏿",
+		Descriptor: "Woman and Man Holding Hands: Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FB-200D-1F91D-200D-1F469-1F3FC": Emoji{
+		Key:        "1F469-1F3FB-200D-1F91D-200D-1F469-1F3FC",
+		Value:      "👩🏻‍🤝‍👩🏼",
+		Descriptor: "Women Holding Hands: Light Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F469-1F3FB-200D-1F91D-200D-1F469-1F3FD": Emoji{
+		Key:        "1F469-1F3FB-200D-1F91D-200D-1F469-1F3FD",
+		Value:      "👩🏻‍🤝‍👩🏽",
+		Descriptor: "Women Holding Hands: Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F469-1F3FB-200D-1F91D-200D-1F469-1F3FE": Emoji{
+		Key:        "1F469-1F3FB-200D-1F91D-200D-1F469-1F3FE",
+		Value:      "👩🏻‍🤝‍👩🏾",
+		Descriptor: "Women Holding Hands: Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F469-1F3FB-200D-1F91D-200D-1F469-1F3FF": Emoji{
+		Key:        "1F469-1F3FB-200D-1F91D-200D-1F469-1F3FF",
+		Value:      "👩🏻‍🤝‍👩🏿",
+		Descriptor: "Women Holding Hands: Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F469-1F3FB-200D-1F9AF": Emoji{
+		Key:        "1F469-1F3FB-200D-1F9AF",
+		Value:      "👩🏻‍🦯",
+		Descriptor: "Woman With White Cane: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F469-1F3FB-200D-1F9AF-200D-27A1-FE0F": Emoji{
+		Key:        "1F469-1F3FB-200D-1F9AF-200D-27A1-FE0F",
+		Value:      "👩🏻‍🦯‍➡️",
+		Descriptor: "Woman With White Cane Facing Right: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F469-1F3FB-200D-1F9B0": Emoji{
 		Key:        "1F469-1F3FB-200D-1F9B0",
 		Value:      "👩🏻‍🦰",
 		Descriptor: "Woman: Light Skin Tone, Red Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F469-1F3FB-200D-1F9B1": Emoji{
 		Key:        "1F469-1F3FB-200D-1F9B1",
 		Value:      "👩🏻‍🦱",
 		Descriptor: "Woman: Light Skin Tone, Curly Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F469-1F3FB-200D-1F9B2": Emoji{
 		Key:        "1F469-1F3FB-200D-1F9B2",
 		Value:      "👩🏻‍🦲",
 		Descriptor: "Woman: Light Skin Tone, Bald",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F469-1F3FB-200D-1F9B3": Emoji{
 		Key:        "1F469-1F3FB-200D-1F9B3",
 		Value:      "👩🏻‍🦳",
 		Descriptor: "Woman: Light Skin Tone, White Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
+	},
+	"1F469-1F3FB-200D-1F9BC": Emoji{
+		Key:        "1F469-1F3FB-200D-1F9BC",
+		Value:      "👩🏻‍🦼",
+		Descriptor: "Woman in Motorized Wheelchair: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F469-1F3FB-200D-1F9BC-200D-27A1-FE0F": Emoji{
+		Key:        "1F469-1F3FB-200D-1F9BC-200D-27A1-FE0F",
+		Value:      "👩🏻‍🦼‍➡️",
+		Descriptor: "Woman in Motorized Wheelchair Facing Right: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F469-1F3FB-200D-1F9BD": Emoji{
+		Key:        "1F469-1F3FB-200D-1F9BD",
+		Value:      "👩🏻‍🦽",
+		Descriptor: "Woman in Manual Wheelchair: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F469-1F3FB-200D-1F9BD-200D-27A1-FE0F": Emoji{
+		Key:        "1F469-1F3FB-200D-1F9BD-200D-27A1-FE0F",
+		Value:      "👩🏻‍🦽‍➡️",
+		Descriptor: "Woman in Manual Wheelchair Facing Right: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F469-1F3FB-200D-2695-FE0F": Emoji{
 		Key:        "1F469-1F3FB-200D-2695-FE0F",
 		Value:      "👩🏻‍⚕️",
 		Descriptor: "Woman Health Worker: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FB-200D-2696-FE0F": Emoji{
 		Key:        "1F469-1F3FB-200D-2696-FE0F",
 		Value:      "👩🏻‍⚖️",
 		Descriptor: "Woman Judge: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FB-200D-2708-FE0F": Emoji{
 		Key:        "1F469-1F3FB-200D-2708-FE0F",
 		Value:      "👩🏻‍✈️",
 		Descriptor: "Woman Pilot: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F469-1F3FB-200D-2764-FE0F-200D-1F468-1F3FB": Emoji{
+		Key:        "1F469-1F3FB-200D-2764-FE0F-200D-1F468-1F3FB",
+		Value:      "👩🏻‍❤️‍👨🏻",
+		Descriptor: "Couple With Heart: Woman, Man, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FB-200D-2764-FE0F-200D-1F468-1F3FC": Emoji{
+		Key:        "1F469-1F3FB-200D-2764-FE0F-200D-1F468-1F3FC",
+		Value:      "👩🏻‍❤️‍👨🏼",
+		Descriptor: "Couple With Heart: Woman, Man, Light Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FB-200D-2764-FE0F-200D-1F468-1F3FD": Emoji{
+		Key:        "1F469-1F3FB-200D-2764-FE0F-200D-1F468-1F3FD",
+		Value:      "👩🏻‍❤️‍👨🏽",
+		Descriptor: "Couple With Heart: Woman, Man, Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FB-200D-2764-FE0F-200D-1F468-1F3FE": Emoji{
+		Key:        "1F469-1F3FB-200D-2764-FE0F-200D-1F468-1F3FE",
+		Value:      "👩🏻‍❤️‍👨🏾",
+		Descriptor: "Couple With Heart: Woman, Man, Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FB-200D-2764-FE0F-200D-1F468-1F3FF": Emoji{
+		Key:        "1F469-1F3FB-200D-2764-FE0F-200D-1F468-1F3FF",
+		Value:      "👩🏻‍❤️‍👨🏿",
+		Descriptor: "Couple With Heart: Woman, Man, Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FB-200D-2764-FE0F-200D-1F469-1F3FB": Emoji{
+		Key:        "1F469-1F3FB-200D-2764-FE0F-200D-1F469-1F3FB",
+		Value:      "👩🏻‍❤️‍👩🏻",
+		Descriptor: "Couple With Heart: Woman, Woman, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FB-200D-2764-FE0F-200D-1F469-1F3FC": Emoji{
+		Key:        "1F469-1F3FB-200D-2764-FE0F-200D-1F469-1F3FC",
+		Value:      "👩🏻‍❤️‍👩🏼",
+		Descriptor: "Couple With Heart: Woman, Woman, Light Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FB-200D-2764-FE0F-200D-1F469-1F3FD": Emoji{
+		Key:        "1F469-1F3FB-200D-2764-FE0F-200D-1F469-1F3FD",
+		Value:      "👩🏻‍❤️‍👩🏽",
+		Descriptor: "Couple With Heart: Woman, Woman, Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FB-200D-2764-FE0F-200D-1F469-1F3FE": Emoji{
+		Key:        "1F469-1F3FB-200D-2764-FE0F-200D-1F469-1F3FE",
+		Value:      "👩🏻‍❤️‍👩🏾",
+		Descriptor: "Couple With Heart: Woman, Woman, Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FB-200D-2764-FE0F-200D-1F469-1F3FF": Emoji{
+		Key:        "1F469-1F3FB-200D-2764-FE0F-200D-1F469-1F3FF",
+		Value:      "👩🏻‍❤️‍👩🏿",
+		Descriptor: "Couple With Heart: Woman, Woman, Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FB": Emoji{
+		Key:        "1F469-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FB",
+		Value:      "👩🏻‍❤️‍💋‍👨🏻",
+		Descriptor: "Kiss: Woman, Man, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FC": Emoji{
+		Key:        "1F469-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FC",
+		Value:      "👩🏻‍❤️‍💋‍👨🏼",
+		Descriptor: "Kiss: Woman, Man, Light Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FD": Emoji{
+		Key:        "1F469-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FD",
+		Value:      "👩🏻‍❤️‍💋‍👨🏽",
+		Descriptor: "Kiss: Woman, Man, Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FE": Emoji{
+		Key:        "1F469-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FE",
+		Value:      "👩🏻‍❤️‍💋‍👨🏾",
+		Descriptor: "Kiss: Woman, Man, Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FF": Emoji{
+		Key:        "1F469-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FF",
+		Value:      "👩🏻‍❤️‍💋‍👨🏿",
+		Descriptor: "Kiss: Woman, Man, Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FB": Emoji{
+		Key:        "1F469-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FB",
+		Value:      "👩🏻‍❤️‍💋‍👩🏻",
+		Descriptor: "Kiss: Woman, Woman, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FC": Emoji{
+		Key:        "1F469-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FC",
+		Value:      "👩🏻‍❤️‍💋‍👩🏼",
+		Descriptor: "Kiss: Woman, Woman, Light Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FD": Emoji{
+		Key:        "1F469-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FD",
+		Value:      "👩🏻‍❤️‍💋‍👩🏽",
+		Descriptor: "Kiss: Woman, Woman, Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FE": Emoji{
+		Key:        "1F469-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FE",
+		Value:      "👩🏻‍❤️‍💋‍👩🏾",
+		Descriptor: "Kiss: Woman, Woman, Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FF": Emoji{
+		Key:        "1F469-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FF",
+		Value:      "👩🏻‍❤️‍💋‍👩🏿",
+		Descriptor: "Kiss: Woman, Woman, Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
 	},
 	"1F469-1F3FC": Emoji{
 		Key:        "1F469-1F3FC",
 		Value:      "👩🏼",
 		Descriptor: "Woman: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F469-1F3FC-200D-1F33E": Emoji{
 		Key:        "1F469-1F3FC-200D-1F33E",
 		Value:      "👩🏼‍🌾",
 		Descriptor: "Woman Farmer: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FC-200D-1F373": Emoji{
 		Key:        "1F469-1F3FC-200D-1F373",
 		Value:      "👩🏼‍🍳",
 		Descriptor: "Woman Cook: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F469-1F3FC-200D-1F37C": Emoji{
+		Key:        "1F469-1F3FC-200D-1F37C",
+		Value:      "👩🏼‍🍼",
+		Descriptor: "Woman Feeding Baby: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
 	},
 	"1F469-1F3FC-200D-1F393": Emoji{
 		Key:        "1F469-1F3FC-200D-1F393",
 		Value:      "👩🏼‍🎓",
 		Descriptor: "Woman Student: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FC-200D-1F3A4": Emoji{
 		Key:        "1F469-1F3FC-200D-1F3A4",
 		Value:      "👩🏼‍🎤",
 		Descriptor: "Woman Singer: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FC-200D-1F3A8": Emoji{
 		Key:        "1F469-1F3FC-200D-1F3A8",
 		Value:      "👩🏼‍🎨",
 		Descriptor: "Woman Artist: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FC-200D-1F3EB": Emoji{
 		Key:        "1F469-1F3FC-200D-1F3EB",
 		Value:      "👩🏼‍🏫",
 		Descriptor: "Woman Teacher: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FC-200D-1F3ED": Emoji{
 		Key:        "1F469-1F3FC-200D-1F3ED",
 		Value:      "👩🏼‍🏭",
 		Descriptor: "Woman Factory Worker: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FC-200D-1F4BB": Emoji{
 		Key:        "1F469-1F3FC-200D-1F4BB",
 		Value:      "👩🏼‍💻",
 		Descriptor: "Woman Technologist: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FC-200D-1F4BC": Emoji{
 		Key:        "1F469-1F3FC-200D-1F4BC",
 		Value:      "👩🏼‍💼",
 		Descriptor: "Woman Office Worker: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FC-200D-1F527": Emoji{
 		Key:        "1F469-1F3FC-200D-1F527",
 		Value:      "👩🏼‍🔧",
 		Descriptor: "Woman Mechanic: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FC-200D-1F52C": Emoji{
 		Key:        "1F469-1F3FC-200D-1F52C",
 		Value:      "👩🏼‍🔬",
 		Descriptor: "Woman Scientist: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FC-200D-1F680": Emoji{
 		Key:        "1F469-1F3FC-200D-1F680",
 		Value:      "👩🏼‍🚀",
 		Descriptor: "Woman Astronaut: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FC-200D-1F692": Emoji{
 		Key:        "1F469-1F3FC-200D-1F692",
 		Value:      "👩🏼‍🚒",
 		Descriptor: "Woman Firefighter: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F469-1F3FC-200D-1F91D-200D-1F468-1F3FB": Emoji{
+		Key:        "1F469-1F3FC-200D-1F91D-200D-1F468-1F3FB",
+		Value:      "👩🏼‍🤝‍👨🏻",
+		Descriptor: "Woman and Man Holding Hands: Medium-Light Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FC-200D-1F91D-200D-1F468-1F3FD": Emoji{
+		Key:        "1F469-1F3FC-200D-1F91D-200D-1F468-1F3FD",
+		Value:      "👩🏼‍🤝‍👨🏽",
+		Descriptor: "Woman and Man Holding Hands: Medium-Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FC-200D-1F91D-200D-1F468-1F3FE": Emoji{
+		Key:        "1F469-1F3FC-200D-1F91D-200D-1F468-1F3FE",
+		Value:      "👩🏼‍🤝‍👨🏾",
+		Descriptor: "Woman and Man Holding Hands: Medium-Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FC-200D-1F91D-200D-1F468-1F3FF": Emoji{
+		Key:        "1F469-1F3FC-200D-1F91D-200D-1F468-1F3FF",
+		Value:      "👩🏼‍🤝‍👨🏿",
+		Descriptor: "Woman and Man Holding Hands: Medium-Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FC-200D-1F91D-200D-1F469-1F3FB": Emoji{
+		Key:        "1F469-1F3FC-200D-1F91D-200D-1F469-1F3FB",
+		Value:      "👩🏼‍🤝‍👩🏻",
+		Descriptor: "Women Holding Hands: Medium-Light Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FC-200D-1F91D-200D-1F469-1F3FD": Emoji{
+		Key:        "1F469-1F3FC-200D-1F91D-200D-1F469-1F3FD",
+		Value:      "👩🏼‍🤝‍👩🏽",
+		Descriptor: "Women Holding Hands: Medium-Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F469-1F3FC-200D-1F91D-200D-1F469-1F3FE": Emoji{
+		Key:        "1F469-1F3FC-200D-1F91D-200D-1F469-1F3FE",
+		Value:      "👩🏼‍🤝‍👩🏾",
+		Descriptor: "Women Holding Hands: Medium-Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F469-1F3FC-200D-1F91D-200D-1F469-1F3FF": Emoji{
+		Key:        "1F469-1F3FC-200D-1F91D-200D-1F469-1F3FF",
+		Value:      "👩🏼‍🤝‍👩🏿",
+		Descriptor: "Women Holding Hands: Medium-Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F469-1F3FC-200D-1F9AF": Emoji{
+		Key:        "1F469-1F3FC-200D-1F9AF",
+		Value:      "👩🏼‍🦯",
+		Descriptor: "Woman With White Cane: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F469-1F3FC-200D-1F9AF-200D-27A1-FE0F": Emoji{
+		Key:        "1F469-1F3FC-200D-1F9AF-200D-27A1-FE0F",
+		Value:      "👩🏼‍🦯‍➡️",
+		Descriptor: "Woman With White Cane Facing Right: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F469-1F3FC-200D-1F9B0": Emoji{
 		Key:        "1F469-1F3FC-200D-1F9B0",
 		Value:      "👩🏼‍🦰",
 		Descriptor: "Woman: Medium-Light Skin Tone, Red Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F469-1F3FC-200D-1F9B1": Emoji{
 		Key:        "1F469-1F3FC-200D-1F9B1",
 		Value:      "👩🏼‍🦱",
 		Descriptor: "Woman: Medium-Light Skin Tone, Curly Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F469-1F3FC-200D-1F9B2": Emoji{
 		Key:        "1F469-1F3FC-200D-1F9B2",
 		Value:      "👩🏼‍🦲",
 		Descriptor: "Woman: Medium-Light Skin Tone, Bald",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F469-1F3FC-200D-1F9B3": Emoji{
 		Key:        "1F469-1F3FC-200D-1F9B3",
 		Value:      "👩🏼‍🦳",
 		Descriptor: "Woman: Medium-Light Skin Tone, White Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
+	},
+	"1F469-1F3FC-200D-1F9BC": Emoji{
+		Key:        "1F469-1F3FC-200D-1F9BC",
+		Value:      "👩🏼‍🦼",
+		Descriptor: "Woman in Motorized Wheelchair: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F469-1F3FC-200D-1F9BC-200D-27A1-FE0F": Emoji{
+		Key:        "1F469-1F3FC-200D-1F9BC-200D-27A1-FE0F",
+		Value:      "👩🏼‍🦼‍➡️",
+		Descriptor: "Woman in Motorized Wheelchair Facing Right: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F469-1F3FC-200D-1F9BD": Emoji{
+		Key:        "1F469-1F3FC-200D-1F9BD",
+		Value:      "👩🏼‍🦽",
+		Descriptor: "Woman in Manual Wheelchair: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F469-1F3FC-200D-1F9BD-200D-27A1-FE0F": Emoji{
+		Key:        "1F469-1F3FC-200D-1F9BD-200D-27A1-FE0F",
+		Value:      "👩🏼‍🦽‍➡️",
+		Descriptor: "Woman in Manual Wheelchair Facing Right: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F469-1F3FC-200D-2695-FE0F": Emoji{
 		Key:        "1F469-1F3FC-200D-2695-FE0F",
 		Value:      "👩🏼‍⚕️",
 		Descriptor: "Woman Health Worker: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FC-200D-2696-FE0F": Emoji{
 		Key:        "1F469-1F3FC-200D-2696-FE0F",
 		Value:      "👩🏼‍⚖️",
 		Descriptor: "Woman Judge: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FC-200D-2708-FE0F": Emoji{
 		Key:        "1F469-1F3FC-200D-2708-FE0F",
 		Value:      "👩🏼‍✈️",
 		Descriptor: "Woman Pilot: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F469-1F3FC-200D-2764-FE0F-200D-1F468-1F3FB": Emoji{
+		Key:        "1F469-1F3FC-200D-2764-FE0F-200D-1F468-1F3FB",
+		Value:      "👩🏼‍❤️‍👨🏻",
+		Descriptor: "Couple With Heart: Woman, Man, Medium-Light Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FC-200D-2764-FE0F-200D-1F468-1F3FC": Emoji{
+		Key:        "1F469-1F3FC-200D-2764-FE0F-200D-1F468-1F3FC",
+		Value:      "👩🏼‍❤️‍👨🏼",
+		Descriptor: "Couple With Heart: Woman, Man, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FC-200D-2764-FE0F-200D-1F468-1F3FD": Emoji{
+		Key:        "1F469-1F3FC-200D-2764-FE0F-200D-1F468-1F3FD",
+		Value:      "👩🏼‍❤️‍👨🏽",
+		Descriptor: "Couple With Heart: Woman, Man, Medium-Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FC-200D-2764-FE0F-200D-1F468-1F3FE": Emoji{
+		Key:        "1F469-1F3FC-200D-2764-FE0F-200D-1F468-1F3FE",
+		Value:      "👩🏼‍❤️‍👨🏾",
+		Descriptor: "Couple With Heart: Woman, Man, Medium-Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FC-200D-2764-FE0F-200D-1F468-1F3FF": Emoji{
+		Key:        "1F469-1F3FC-200D-2764-FE0F-200D-1F468-1F3FF",
+		Value:      "👩🏼‍❤️‍👨🏿",
+		Descriptor: "Couple With Heart: Woman, Man, Medium-Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FC-200D-2764-FE0F-200D-1F469-1F3FB": Emoji{
+		Key:        "1F469-1F3FC-200D-2764-FE0F-200D-1F469-1F3FB",
+		Value:      "👩🏼‍❤️‍👩🏻",
+		Descriptor: "Couple With Heart: Woman, Woman, Medium-Light Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FC-200D-2764-FE0F-200D-1F469-1F3FC": Emoji{
+		Key:        "1F469-1F3FC-200D-2764-FE0F-200D-1F469-1F3FC",
+		Value:      "👩🏼‍❤️‍👩🏼",
+		Descriptor: "Couple With Heart: Woman, Woman, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FC-200D-2764-FE0F-200D-1F469-1F3FD": Emoji{
+		Key:        "1F469-1F3FC-200D-2764-FE0F-200D-1F469-1F3FD",
+		Value:      "👩🏼‍❤️‍👩🏽",
+		Descriptor: "Couple With Heart: Woman, Woman, Medium-Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FC-200D-2764-FE0F-200D-1F469-1F3FE": Emoji{
+		Key:        "1F469-1F3FC-200D-2764-FE0F-200D-1F469-1F3FE",
+		Value:      "👩🏼‍❤️‍👩🏾",
+		Descriptor: "Couple With Heart: Woman, Woman, Medium-Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FC-200D-2764-FE0F-200D-1F469-1F3FF": Emoji{
+		Key:        "1F469-1F3FC-200D-2764-FE0F-200D-1F469-1F3FF",
+		Value:      "👩🏼‍❤️‍👩🏿",
+		Descriptor: "Couple With Heart: Woman, Woman, Medium-Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FB": Emoji{
+		Key:        "1F469-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FB",
+		Value:      "👩🏼‍❤️‍💋‍👨🏻",
+		Descriptor: "Kiss: Woman, Man, Medium-Light Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FC": Emoji{
+		Key:        "1F469-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FC",
+		Value:      "👩🏼‍❤️‍💋‍👨🏼",
+		Descriptor: "Kiss: Woman, Man, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FD": Emoji{
+		Key:        "1F469-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FD",
+		Value:      "👩🏼‍❤️‍💋‍👨🏽",
+		Descriptor: "Kiss: Woman, Man, Medium-Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FE": Emoji{
+		Key:        "1F469-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FE",
+		Value:      "👩🏼‍❤️‍💋‍👨🏾",
+		Descriptor: "Kiss: Woman, Man, Medium-Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FF": Emoji{
+		Key:        "1F469-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FF",
+		Value:      "👩🏼‍❤️‍💋‍👨🏿",
+		Descriptor: "Kiss: Woman, Man, Medium-Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FB": Emoji{
+		Key:        "1F469-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FB",
+		Value:      "👩🏼‍❤️‍💋‍👩🏻",
+		Descriptor: "Kiss: Woman, Woman, Medium-Light Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FC": Emoji{
+		Key:        "1F469-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FC",
+		Value:      "👩🏼‍❤️‍💋‍👩🏼",
+		Descriptor: "Kiss: Woman, Woman, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FD": Emoji{
+		Key:        "1F469-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FD",
+		Value:      "👩🏼‍❤️‍💋‍👩🏽",
+		Descriptor: "Kiss: Woman, Woman, Medium-Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FE": Emoji{
+		Key:        "1F469-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FE",
+		Value:      "👩🏼‍❤️‍💋‍👩🏾",
+		Descriptor: "Kiss: Woman, Woman, Medium-Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FF": Emoji{
+		Key:        "1F469-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FF",
+		Value:      "👩🏼‍❤️‍💋‍👩🏿",
+		Descriptor: "Kiss: Woman, Woman, Medium-Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
 	},
 	"1F469-1F3FD": Emoji{
 		Key:        "1F469-1F3FD",
 		Value:      "👩🏽",
 		Descriptor: "Woman: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F469-1F3FD-200D-1F33E": Emoji{
 		Key:        "1F469-1F3FD-200D-1F33E",
 		Value:      "👩🏽‍🌾",
 		Descriptor: "Woman Farmer: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FD-200D-1F373": Emoji{
 		Key:        "1F469-1F3FD-200D-1F373",
 		Value:      "👩🏽‍🍳",
 		Descriptor: "Woman Cook: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F469-1F3FD-200D-1F37C": Emoji{
+		Key:        "1F469-1F3FD-200D-1F37C",
+		Value:      "👩🏽‍🍼",
+		Descriptor: "Woman Feeding Baby: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
 	},
 	"1F469-1F3FD-200D-1F393": Emoji{
 		Key:        "1F469-1F3FD-200D-1F393",
 		Value:      "👩🏽‍🎓",
 		Descriptor: "Woman Student: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FD-200D-1F3A4": Emoji{
 		Key:        "1F469-1F3FD-200D-1F3A4",
 		Value:      "👩🏽‍🎤",
 		Descriptor: "Woman Singer: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FD-200D-1F3A8": Emoji{
 		Key:        "1F469-1F3FD-200D-1F3A8",
 		Value:      "👩🏽‍🎨",
 		Descriptor: "Woman Artist: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FD-200D-1F3EB": Emoji{
 		Key:        "1F469-1F3FD-200D-1F3EB",
 		Value:      "👩🏽‍🏫",
 		Descriptor: "Woman Teacher: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FD-200D-1F3ED": Emoji{
 		Key:        "1F469-1F3FD-200D-1F3ED",
 		Value:      "👩🏽‍🏭",
 		Descriptor: "Woman Factory Worker: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FD-200D-1F4BB": Emoji{
 		Key:        "1F469-1F3FD-200D-1F4BB",
 		Value:      "👩🏽‍💻",
 		Descriptor: "Woman Technologist: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FD-200D-1F4BC": Emoji{
 		Key:        "1F469-1F3FD-200D-1F4BC",
 		Value:      "👩🏽‍💼",
 		Descriptor: "Woman Office Worker: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FD-200D-1F527": Emoji{
 		Key:        "1F469-1F3FD-200D-1F527",
 		Value:      "👩🏽‍🔧",
 		Descriptor: "Woman Mechanic: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FD-200D-1F52C": Emoji{
 		Key:        "1F469-1F3FD-200D-1F52C",
 		Value:      "👩🏽‍🔬",
 		Descriptor: "Woman Scientist: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FD-200D-1F680": Emoji{
 		Key:        "1F469-1F3FD-200D-1F680",
 		Value:      "👩🏽‍🚀",
 		Descriptor: "Woman Astronaut: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FD-200D-1F692": Emoji{
 		Key:        "1F469-1F3FD-200D-1F692",
 		Value:      "👩🏽‍🚒",
 		Descriptor: "Woman Firefighter: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F469-1F3FD-200D-1F91D-200D-1F468-1F3FB": Emoji{
+		Key:        "1F469-1F3FD-200D-1F91D-200D-1F468-1F3FB",
+		Value:      "👩🏽‍🤝‍👨🏻",
+		Descriptor: "Woman and Man Holding Hands: Medium Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FD-200D-1F91D-200D-1F468-1F3FC": Emoji{
+		Key:        "1F469-1F3FD-200D-1F91D-200D-1F468-1F3FC",
+		Value:      "👩🏽‍🤝‍👨🏼",
+		Descriptor: "Woman and Man Holding Hands: Medium Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FD-200D-1F91D-200D-1F468-1F3FE": Emoji{
+		Key:        "1F469-1F3FD-200D-1F91D-200D-1F468-1F3FE",
+		Value:      "👩🏽‍🤝‍👨🏾",
+		Descriptor: "Woman and Man Holding Hands: Medium Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FD-200D-1F91D-200D-1F468-1F3FF": Emoji{
+		Key:        "1F469-1F3FD-200D-1F91D-200D-1F468-1F3FF",
+		Value:      "👩🏽‍🤝‍👨🏿",
+		Descriptor: "Woman and Man Holding Hands: Medium Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FD-200D-1F91D-200D-1F469-1F3FB": Emoji{
+		Key:        "1F469-1F3FD-200D-1F91D-200D-1F469-1F3FB",
+		Value:      "👩🏽‍🤝‍👩🏻",
+		Descriptor: "Women Holding Hands: Medium Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FD-200D-1F91D-200D-1F469-1F3FC": Emoji{
+		Key:        "1F469-1F3FD-200D-1F91D-200D-1F469-1F3FC",
+		Value:      "👩🏽‍🤝‍👩🏼",
+		Descriptor: "Women Holding Hands: Medium Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FD-200D-1F91D-200D-1F469-1F3FE": Emoji{
+		Key:        "1F469-1F3FD-200D-1F91D-200D-1F469-1F3FE",
+		Value:      "👩🏽‍🤝‍👩🏾",
+		Descriptor: "Women Holding Hands: Medium Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F469-1F3FD-200D-1F91D-200D-1F469-1F3FF": Emoji{
+		Key:        "1F469-1F3FD-200D-1F91D-200D-1F469-1F3FF",
+		Value:      "👩🏽‍🤝‍👩🏿",
+		Descriptor: "Women Holding Hands: Medium Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F469-1F3FD-200D-1F9AF": Emoji{
+		Key:        "1F469-1F3FD-200D-1F9AF",
+		Value:      "👩🏽‍🦯",
+		Descriptor: "Woman With White Cane: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F469-1F3FD-200D-1F9AF-200D-27A1-FE0F": Emoji{
+		Key:        "1F469-1F3FD-200D-1F9AF-200D-27A1-FE0F",
+		Value:      "👩🏽‍🦯‍➡️",
+		Descriptor: "Woman With White Cane Facing Right: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F469-1F3FD-200D-1F9B0": Emoji{
 		Key:        "1F469-1F3FD-200D-1F9B0",
 		Value:      "👩🏽‍🦰",
 		Descriptor: "Woman: Medium Skin Tone, Red Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F469-1F3FD-200D-1F9B1": Emoji{
 		Key:        "1F469-1F3FD-200D-1F9B1",
 		Value:      "👩🏽‍🦱",
 		Descriptor: "Woman: Medium Skin Tone, Curly Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F469-1F3FD-200D-1F9B2": Emoji{
 		Key:        "1F469-1F3FD-200D-1F9B2",
 		Value:      "👩🏽‍🦲",
 		Descriptor: "Woman: Medium Skin Tone, Bald",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F469-1F3FD-200D-1F9B3": Emoji{
 		Key:        "1F469-1F3FD-200D-1F9B3",
 		Value:      "👩🏽‍🦳",
 		Descriptor: "Woman: Medium Skin Tone, White Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
+	},
+	"1F469-1F3FD-200D-1F9BC": Emoji{
+		Key:        "1F469-1F3FD-200D-1F9BC",
+		Value:      "👩🏽‍🦼",
+		Descriptor: "Woman in Motorized Wheelchair: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F469-1F3FD-200D-1F9BC-200D-27A1-FE0F": Emoji{
+		Key:        "1F469-1F3FD-200D-1F9BC-200D-27A1-FE0F",
+		Value:      "👩🏽‍🦼‍➡️",
+		Descriptor: "Woman in Motorized Wheelchair Facing Right: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F469-1F3FD-200D-1F9BD": Emoji{
+		Key:        "1F469-1F3FD-200D-1F9BD",
+		Value:      "👩🏽‍🦽",
+		Descriptor: "Woman in Manual Wheelchair: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F469-1F3FD-200D-1F9BD-200D-27A1-FE0F": Emoji{
+		Key:        "1F469-1F3FD-200D-1F9BD-200D-27A1-FE0F",
+		Value:      "👩🏽‍🦽‍➡️",
+		Descriptor: "Woman in Manual Wheelchair Facing Right: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F469-1F3FD-200D-2695-FE0F": Emoji{
 		Key:        "1F469-1F3FD-200D-2695-FE0F",
 		Value:      "👩🏽‍⚕️",
 		Descriptor: "Woman Health Worker: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FD-200D-2696-FE0F": Emoji{
 		Key:        "1F469-1F3FD-200D-2696-FE0F",
 		Value:      "👩🏽‍⚖️",
 		Descriptor: "Woman Judge: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FD-200D-2708-FE0F": Emoji{
 		Key:        "1F469-1F3FD-200D-2708-FE0F",
 		Value:      "👩🏽‍✈️",
 		Descriptor: "Woman Pilot: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F469-1F3FD-200D-2764-FE0F-200D-1F468-1F3FB": Emoji{
+		Key:        "1F469-1F3FD-200D-2764-FE0F-200D-1F468-1F3FB",
+		Value:      "👩🏽‍❤️‍👨🏻",
+		Descriptor: "Couple With Heart: Woman, Man, Medium Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FD-200D-2764-FE0F-200D-1F468-1F3FC": Emoji{
+		Key:        "1F469-1F3FD-200D-2764-FE0F-200D-1F468-1F3FC",
+		Value:      "👩🏽‍❤️‍👨🏼",
+		Descriptor: "Couple With Heart: Woman, Man, Medium Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FD-200D-2764-FE0F-200D-1F468-1F3FD": Emoji{
+		Key:        "1F469-1F3FD-200D-2764-FE0F-200D-1F468-1F3FD",
+		Value:      "👩🏽‍❤️‍👨🏽",
+		Descriptor: "Couple With Heart: Woman, Man, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FD-200D-2764-FE0F-200D-1F468-1F3FE": Emoji{
+		Key:        "1F469-1F3FD-200D-2764-FE0F-200D-1F468-1F3FE",
+		Value:      "👩🏽‍❤️‍👨🏾",
+		Descriptor: "Couple With Heart: Woman, Man, Medium Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FD-200D-2764-FE0F-200D-1F468-1F3FF": Emoji{
+		Key:        "1F469-1F3FD-200D-2764-FE0F-200D-1F468-1F3FF",
+		Value:      "👩🏽‍❤️‍👨🏿",
+		Descriptor: "Couple With Heart: Woman, Man, Medium Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FD-200D-2764-FE0F-200D-1F469-1F3FB": Emoji{
+		Key:        "1F469-1F3FD-200D-2764-FE0F-200D-1F469-1F3FB",
+		Value:      "👩🏽‍❤️‍👩🏻",
+		Descriptor: "Couple With Heart: Woman, Woman, Medium Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FD-200D-2764-FE0F-200D-1F469-1F3FC": Emoji{
+		Key:        "1F469-1F3FD-200D-2764-FE0F-200D-1F469-1F3FC",
+		Value:      "👩🏽‍❤️‍👩🏼",
+		Descriptor: "Couple With Heart: Woman, Woman, Medium Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FD-200D-2764-FE0F-200D-1F469-1F3FD": Emoji{
+		Key:        "1F469-1F3FD-200D-2764-FE0F-200D-1F469-1F3FD",
+		Value:      "👩🏽‍❤️‍👩🏽",
+		Descriptor: "Couple With Heart: Woman, Woman, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FD-200D-2764-FE0F-200D-1F469-1F3FE": Emoji{
+		Key:        "1F469-1F3FD-200D-2764-FE0F-200D-1F469-1F3FE",
+		Value:      "👩🏽‍❤️‍👩🏾",
+		Descriptor: "Couple With Heart: Woman, Woman, Medium Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FD-200D-2764-FE0F-200D-1F469-1F3FF": Emoji{
+		Key:        "1F469-1F3FD-200D-2764-FE0F-200D-1F469-1F3FF",
+		Value:      "👩🏽‍❤️‍👩🏿",
+		Descriptor: "Couple With Heart: Woman, Woman, Medium Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FB": Emoji{
+		Key:        "1F469-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FB",
+		Value:      "👩🏽‍❤️‍💋‍👨🏻",
+		Descriptor: "Kiss: Woman, Man, Medium Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FC": Emoji{
+		Key:        "1F469-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FC",
+		Value:      "👩🏽‍❤️‍💋‍👨🏼",
+		Descriptor: "Kiss: Woman, Man, Medium Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FD": Emoji{
+		Key:        "1F469-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FD",
+		Value:      "👩🏽‍❤️‍💋‍👨🏽",
+		Descriptor: "Kiss: Woman, Man, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FE": Emoji{
+		Key:        "1F469-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FE",
+		Value:      "👩🏽‍❤️‍💋‍👨🏾",
+		Descriptor: "Kiss: Woman, Man, Medium Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FF": Emoji{
+		Key:        "1F469-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FF",
+		Value:      "👩🏽‍❤️‍💋‍👨🏿",
+		Descriptor: "Kiss: Woman, Man, Medium Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FB": Emoji{
+		Key:        "1F469-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FB",
+		Value:      "👩🏽‍❤️‍💋‍👩🏻",
+		Descriptor: "Kiss: Woman, Woman, Medium Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FC": Emoji{
+		Key:        "1F469-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FC",
+		Value:      "👩🏽‍❤️‍💋‍👩🏼",
+		Descriptor: "Kiss: Woman, Woman, Medium Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FD": Emoji{
+		Key:        "1F469-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FD",
+		Value:      "👩🏽‍❤️‍💋‍👩🏽",
+		Descriptor: "Kiss: Woman, Woman, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FE": Emoji{
+		Key:        "1F469-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FE",
+		Value:      "👩🏽‍❤️‍💋‍👩🏾",
+		Descriptor: "Kiss: Woman, Woman, Medium Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FF": Emoji{
+		Key:        "1F469-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FF",
+		Value:      "👩🏽‍❤️‍💋‍👩🏿",
+		Descriptor: "Kiss: Woman, Woman, Medium Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
 	},
 	"1F469-1F3FE": Emoji{
 		Key:        "1F469-1F3FE",
 		Value:      "👩🏾",
 		Descriptor: "Woman: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F469-1F3FE-200D-1F33E": Emoji{
 		Key:        "1F469-1F3FE-200D-1F33E",
 		Value:      "👩🏾‍🌾",
 		Descriptor: "Woman Farmer: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FE-200D-1F373": Emoji{
 		Key:        "1F469-1F3FE-200D-1F373",
 		Value:      "👩🏾‍🍳",
 		Descriptor: "Woman Cook: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F469-1F3FE-200D-1F37C": Emoji{
+		Key:        "1F469-1F3FE-200D-1F37C",
+		Value:      "👩🏾‍🍼",
+		Descriptor: "Woman Feeding Baby: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
 	},
 	"1F469-1F3FE-200D-1F393": Emoji{
 		Key:        "1F469-1F3FE-200D-1F393",
 		Value:      "👩🏾‍🎓",
 		Descriptor: "Woman Student: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FE-200D-1F3A4": Emoji{
 		Key:        "1F469-1F3FE-200D-1F3A4",
 		Value:      "👩🏾‍🎤",
 		Descriptor: "Woman Singer: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FE-200D-1F3A8": Emoji{
 		Key:        "1F469-1F3FE-200D-1F3A8",
 		Value:      "👩🏾‍🎨",
 		Descriptor: "Woman Artist: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FE-200D-1F3EB": Emoji{
 		Key:        "1F469-1F3FE-200D-1F3EB",
 		Value:      "👩🏾‍🏫",
 		Descriptor: "Woman Teacher: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FE-200D-1F3ED": Emoji{
 		Key:        "1F469-1F3FE-200D-1F3ED",
 		Value:      "👩🏾‍🏭",
 		Descriptor: "Woman Factory Worker: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FE-200D-1F4BB": Emoji{
 		Key:        "1F469-1F3FE-200D-1F4BB",
 		Value:      "👩🏾‍💻",
 		Descriptor: "Woman Technologist: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FE-200D-1F4BC": Emoji{
 		Key:        "1F469-1F3FE-200D-1F4BC",
 		Value:      "👩🏾‍💼",
 		Descriptor: "Woman Office Worker: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FE-200D-1F527": Emoji{
 		Key:        "1F469-1F3FE-200D-1F527",
 		Value:      "👩🏾‍🔧",
 		Descriptor: "Woman Mechanic: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FE-200D-1F52C": Emoji{
 		Key:        "1F469-1F3FE-200D-1F52C",
 		Value:      "👩🏾‍🔬",
 		Descriptor: "Woman Scientist: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FE-200D-1F680": Emoji{
 		Key:        "1F469-1F3FE-200D-1F680",
 		Value:      "👩🏾‍🚀",
 		Descriptor: "Woman Astronaut: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FE-200D-1F692": Emoji{
 		Key:        "1F469-1F3FE-200D-1F692",
 		Value:      "👩🏾‍🚒",
 		Descriptor: "Woman Firefighter: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F469-1F3FE-200D-1F91D-200D-1F468-1F3FB": Emoji{
+		Key:        "1F469-1F3FE-200D-1F91D-200D-1F468-1F3FB",
+		Value:      "👩🏾‍🤝‍👨🏻",
+		Descriptor: "Woman and Man Holding Hands: Medium-Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FE-200D-1F91D-200D-1F468-1F3FC": Emoji{
+		Key:        "1F469-1F3FE-200D-1F91D-200D-1F468-1F3FC",
+		Value:      "👩🏾‍🤝‍👨🏼",
+		Descriptor: "Woman and Man Holding Hands: Medium-Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FE-200D-1F91D-200D-1F468-1F3FD": Emoji{
+		Key:        "1F469-1F3FE-200D-1F91D-200D-1F468-1F3FD",
+		Value:      "👩🏾‍🤝‍👨🏽",
+		Descriptor: "Woman and Man Holding Hands: Medium-Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FE-200D-1F91D-200D-1F468-1F3FF": Emoji{
+		Key:        "1F469-1F3FE-200D-1F91D-200D-1F468-1F3FF",
+		Value:      "👩🏾‍🤝‍👨🏿",
+		Descriptor: "Woman and Man Holding Hands: Medium-Dark Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FE-200D-1F91D-200D-1F469-1F3FB": Emoji{
+		Key:        "1F469-1F3FE-200D-1F91D-200D-1F469-1F3FB",
+		Value:      "👩🏾‍🤝‍👩🏻",
+		Descriptor: "Women Holding Hands: Medium-Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FE-200D-1F91D-200D-1F469-1F3FC": Emoji{
+		Key:        "1F469-1F3FE-200D-1F91D-200D-1F469-1F3FC",
+		Value:      "👩🏾‍🤝‍👩🏼",
+		Descriptor: "Women Holding Hands: Medium-Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FE-200D-1F91D-200D-1F469-1F3FD": Emoji{
+		Key:        "1F469-1F3FE-200D-1F91D-200D-1F469-1F3FD",
+		Value:      "👩🏾‍🤝‍👩🏽",
+		Descriptor: "Women Holding Hands: Medium-Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FE-200D-1F91D-200D-1F469-1F3FF": Emoji{
+		Key:        "1F469-1F3FE-200D-1F91D-200D-1F469-1F3FF",
+		Value:      "👩🏾‍🤝‍👩🏿",
+		Descriptor: "Women Holding Hands: Medium-Dark Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F469-1F3FE-200D-1F9AF": Emoji{
+		Key:        "1F469-1F3FE-200D-1F9AF",
+		Value:      "👩🏾‍🦯",
+		Descriptor: "Woman With White Cane: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F469-1F3FE-200D-1F9AF-200D-27A1-FE0F": Emoji{
+		Key:        "1F469-1F3FE-200D-1F9AF-200D-27A1-FE0F",
+		Value:      "👩🏾‍🦯‍➡️",
+		Descriptor: "Woman With White Cane Facing Right: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F469-1F3FE-200D-1F9B0": Emoji{
 		Key:        "1F469-1F3FE-200D-1F9B0",
 		Value:      "👩🏾‍🦰",
 		Descriptor: "Woman: Medium-Dark Skin Tone, Red Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F469-1F3FE-200D-1F9B1": Emoji{
 		Key:        "1F469-1F3FE-200D-1F9B1",
 		Value:      "👩🏾‍🦱",
 		Descriptor: "Woman: Medium-Dark Skin Tone, Curly Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F469-1F3FE-200D-1F9B2": Emoji{
 		Key:        "1F469-1F3FE-200D-1F9B2",
 		Value:      "👩🏾‍🦲",
 		Descriptor: "Woman: Medium-Dark Skin Tone, Bald",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F469-1F3FE-200D-1F9B3": Emoji{
 		Key:        "1F469-1F3FE-200D-1F9B3",
 		Value:      "👩🏾‍🦳",
 		Descriptor: "Woman: Medium-Dark Skin Tone, White Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
+	},
+	"1F469-1F3FE-200D-1F9BC": Emoji{
+		Key:        "1F469-1F3FE-200D-1F9BC",
+		Value:      "👩🏾‍🦼",
+		Descriptor: "Woman in Motorized Wheelchair: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F469-1F3FE-200D-1F9BC-200D-27A1-FE0F": Emoji{
+		Key:        "1F469-1F3FE-200D-1F9BC-200D-27A1-FE0F",
+		Value:      "👩🏾‍🦼‍➡️",
+		Descriptor: "Woman in Motorized Wheelchair Facing Right: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F469-1F3FE-200D-1F9BD": Emoji{
+		Key:        "1F469-1F3FE-200D-1F9BD",
+		Value:      "👩🏾‍🦽",
+		Descriptor: "Woman in Manual Wheelchair: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F469-1F3FE-200D-1F9BD-200D-27A1-FE0F": Emoji{
+		Key:        "1F469-1F3FE-200D-1F9BD-200D-27A1-FE0F",
+		Value:      "👩🏾‍🦽‍➡️",
+		Descriptor: "Woman in Manual Wheelchair Facing Right: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F469-1F3FE-200D-2695-FE0F": Emoji{
 		Key:        "1F469-1F3FE-200D-2695-FE0F",
 		Value:      "👩🏾‍⚕️",
 		Descriptor: "Woman Health Worker: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FE-200D-2696-FE0F": Emoji{
 		Key:        "1F469-1F3FE-200D-2696-FE0F",
 		Value:      "👩🏾‍⚖️",
 		Descriptor: "Woman Judge: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FE-200D-2708-FE0F": Emoji{
 		Key:        "1F469-1F3FE-200D-2708-FE0F",
 		Value:      "👩🏾‍✈️",
 		Descriptor: "Woman Pilot: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F469-1F3FE-200D-2764-FE0F-200D-1F468-1F3FB": Emoji{
+		Key:        "1F469-1F3FE-200D-2764-FE0F-200D-1F468-1F3FB",
+		Value:      "👩🏾‍❤️‍👨🏻",
+		Descriptor: "Couple With Heart: Woman, Man, Medium-Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FE-200D-2764-FE0F-200D-1F468-1F3FC": Emoji{
+		Key:        "1F469-1F3FE-200D-2764-FE0F-200D-1F468-1F3FC",
+		Value:      "👩🏾‍❤️‍👨🏼",
+		Descriptor: "Couple With Heart: Woman, Man, Medium-Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FE-200D-2764-FE0F-200D-1F468-1F3FD": Emoji{
+		Key:        "1F469-1F3FE-200D-2764-FE0F-200D-1F468-1F3FD",
+		Value:      "👩🏾‍❤️‍👨🏽",
+		Descriptor: "Couple With Heart: Woman, Man, Medium-Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FE-200D-2764-FE0F-200D-1F468-1F3FE": Emoji{
+		Key:        "1F469-1F3FE-200D-2764-FE0F-200D-1F468-1F3FE",
+		Value:      "👩🏾‍❤️‍👨🏾",
+		Descriptor: "Couple With Heart: Woman, Man, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FE-200D-2764-FE0F-200D-1F468-1F3FF": Emoji{
+		Key:        "1F469-1F3FE-200D-2764-FE0F-200D-1F468-1F3FF",
+		Value:      "👩🏾‍❤️‍👨🏿",
+		Descriptor: "Couple With Heart: Woman, Man, Medium-Dark Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FE-200D-2764-FE0F-200D-1F469-1F3FB": Emoji{
+		Key:        "1F469-1F3FE-200D-2764-FE0F-200D-1F469-1F3FB",
+		Value:      "👩🏾‍❤️‍👩🏻",
+		Descriptor: "Couple With Heart: Woman, Woman, Medium-Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FE-200D-2764-FE0F-200D-1F469-1F3FC": Emoji{
+		Key:        "1F469-1F3FE-200D-2764-FE0F-200D-1F469-1F3FC",
+		Value:      "👩🏾‍❤️‍👩🏼",
+		Descriptor: "Couple With Heart: Woman, Woman, Medium-Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FE-200D-2764-FE0F-200D-1F469-1F3FD": Emoji{
+		Key:        "1F469-1F3FE-200D-2764-FE0F-200D-1F469-1F3FD",
+		Value:      "👩🏾‍❤️‍👩🏽",
+		Descriptor: "Couple With Heart: Woman, Woman, Medium-Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FE-200D-2764-FE0F-200D-1F469-1F3FE": Emoji{
+		Key:        "1F469-1F3FE-200D-2764-FE0F-200D-1F469-1F3FE",
+		Value:      "👩🏾‍❤️‍👩🏾",
+		Descriptor: "Couple With Heart: Woman, Woman, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FE-200D-2764-FE0F-200D-1F469-1F3FF": Emoji{
+		Key:        "1F469-1F3FE-200D-2764-FE0F-200D-1F469-1F3FF",
+		Value:      "👩🏾‍❤️‍👩🏿",
+		Descriptor: "Couple With Heart: Woman, Woman, Medium-Dark Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FB": Emoji{
+		Key:        "1F469-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FB",
+		Value:      "👩🏾‍❤️‍💋‍👨🏻",
+		Descriptor: "Kiss: Woman, Man, Medium-Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FC": Emoji{
+		Key:        "1F469-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FC",
+		Value:      "👩🏾‍❤️‍💋‍👨🏼",
+		Descriptor: "Kiss: Woman, Man, Medium-Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FD": Emoji{
+		Key:        "1F469-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FD",
+		Value:      "👩🏾‍❤️‍💋‍👨🏽",
+		Descriptor: "Kiss: Woman, Man, Medium-Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FE": Emoji{
+		Key:        "1F469-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FE",
+		Value:      "👩🏾‍❤️‍💋‍👨🏾",
+		Descriptor: "Kiss: Woman, Man, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FF": Emoji{
+		Key:        "1F469-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FF",
+		Value:      "👩🏾‍❤️‍💋‍👨🏿",
+		Descriptor: "Kiss: Woman, Man, Medium-Dark Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FB": Emoji{
+		Key:        "1F469-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FB",
+		Value:      "👩🏾‍❤️‍💋‍👩🏻",
+		Descriptor: "Kiss: Woman, Woman, Medium-Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FC": Emoji{
+		Key:        "1F469-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FC",
+		Value:      "👩🏾‍❤️‍💋‍👩🏼",
+		Descriptor: "Kiss: Woman, Woman, Medium-Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FD": Emoji{
+		Key:        "1F469-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FD",
+		Value:      "👩🏾‍❤️‍💋‍👩🏽",
+		Descriptor: "Kiss: Woman, Woman, Medium-Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FE": Emoji{
+		Key:        "1F469-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FE",
+		Value:      "👩🏾‍❤️‍💋‍👩🏾",
+		Descriptor: "Kiss: Woman, Woman, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FF": Emoji{
+		Key:        "1F469-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FF",
+		Value:      "👩🏾‍❤️‍💋‍👩🏿",
+		Descriptor: "Kiss: Woman, Woman, Medium-Dark Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
 	},
 	"1F469-1F3FF": Emoji{
 		Key:        "1F469-1F3FF",
 		Value:      "👩🏿",
 		Descriptor: "Woman: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F469-1F3FF-200D-1F33E": Emoji{
 		Key:        "1F469-1F3FF-200D-1F33E",
 		Value:      "👩🏿‍🌾",
 		Descriptor: "Woman Farmer: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FF-200D-1F373": Emoji{
 		Key:        "1F469-1F3FF-200D-1F373",
 		Value:      "👩🏿‍🍳",
 		Descriptor: "Woman Cook: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F469-1F3FF-200D-1F37C": Emoji{
+		Key:        "1F469-1F3FF-200D-1F37C",
+		Value:      "👩🏿‍🍼",
+		Descriptor: "Woman Feeding Baby: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
 	},
 	"1F469-1F3FF-200D-1F393": Emoji{
 		Key:        "1F469-1F3FF-200D-1F393",
 		Value:      "👩🏿‍🎓",
 		Descriptor: "Woman Student: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FF-200D-1F3A4": Emoji{
 		Key:        "1F469-1F3FF-200D-1F3A4",
 		Value:      "👩🏿‍🎤",
 		Descriptor: "Woman Singer: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FF-200D-1F3A8": Emoji{
 		Key:        "1F469-1F3FF-200D-1F3A8",
 		Value:      "👩🏿‍🎨",
 		Descriptor: "Woman Artist: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FF-200D-1F3EB": Emoji{
 		Key:        "1F469-1F3FF-200D-1F3EB",
 		Value:      "👩🏿‍🏫",
 		Descriptor: "Woman Teacher: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FF-200D-1F3ED": Emoji{
 		Key:        "1F469-1F3FF-200D-1F3ED",
 		Value:      "👩🏿‍🏭",
 		Descriptor: "Woman Factory Worker: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FF-200D-1F4BB": Emoji{
 		Key:        "1F469-1F3FF-200D-1F4BB",
 		Value:      "👩🏿‍💻",
 		Descriptor: "Woman Technologist: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FF-200D-1F4BC": Emoji{
 		Key:        "1F469-1F3FF-200D-1F4BC",
 		Value:      "👩🏿‍💼",
 		Descriptor: "Woman Office Worker: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FF-200D-1F527": Emoji{
 		Key:        "1F469-1F3FF-200D-1F527",
 		Value:      "👩🏿‍🔧",
 		Descriptor: "Woman Mechanic: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FF-200D-1F52C": Emoji{
 		Key:        "1F469-1F3FF-200D-1F52C",
 		Value:      "👩🏿‍🔬",
 		Descriptor: "Woman Scientist: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FF-200D-1F680": Emoji{
 		Key:        "1F469-1F3FF-200D-1F680",
 		Value:      "👩🏿‍🚀",
 		Descriptor: "Woman Astronaut: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FF-200D-1F692": Emoji{
 		Key:        "1F469-1F3FF-200D-1F692",
 		Value:      "👩🏿‍🚒",
 		Descriptor: "Woman Firefighter: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F469-1F3FF-200D-1F91D-200D-1F468-1F3FB": Emoji{
+		Key:        "1F469-1F3FF-200D-1F91D-200D-1F468-1F3FB",
+		Value:      "👩🏿‍🤝‍👨🏻",
+		Descriptor: "Woman and Man Holding Hands: Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FF-200D-1F91D-200D-1F468-1F3FC": Emoji{
+		Key:        "1F469-1F3FF-200D-1F91D-200D-1F468-1F3FC",
+		Value:      "👩🏿‍🤝‍👨🏼",
+		Descriptor: "Woman and Man Holding Hands: Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FF-200D-1F91D-200D-1F468-1F3FD": Emoji{
+		Key:        "1F469-1F3FF-200D-1F91D-200D-1F468-1F3FD",
+		Value:      "👩🏿‍🤝‍👨🏽",
+		Descriptor: "Woman and Man Holding Hands: Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FF-200D-1F91D-200D-1F468-1F3FE": Emoji{
+		Key:        "1F469-1F3FF-200D-1F91D-200D-1F468-1F3FE",
+		Value:      "👩🏿‍🤝‍👨🏾",
+		Descriptor: "Woman and Man Holding Hands: Dark Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FF-200D-1F91D-200D-1F469-1F3FB": Emoji{
+		Key:        "1F469-1F3FF-200D-1F91D-200D-1F469-1F3FB",
+		Value:      "👩🏿‍🤝‍👩🏻",
+		Descriptor: "Women Holding Hands: Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FF-200D-1F91D-200D-1F469-1F3FC": Emoji{
+		Key:        "1F469-1F3FF-200D-1F91D-200D-1F469-1F3FC",
+		Value:      "👩🏿‍🤝‍👩🏼",
+		Descriptor: "Women Holding Hands: Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FF-200D-1F91D-200D-1F469-1F3FD": Emoji{
+		Key:        "1F469-1F3FF-200D-1F91D-200D-1F469-1F3FD",
+		Value:      "👩🏿‍🤝‍👩🏽",
+		Descriptor: "Women Holding Hands: Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FF-200D-1F91D-200D-1F469-1F3FE": Emoji{
+		Key:        "1F469-1F3FF-200D-1F91D-200D-1F469-1F3FE",
+		Value:      "👩🏿‍🤝‍👩🏾",
+		Descriptor: "Women Holding Hands: Dark Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F469-1F3FF-200D-1F9AF": Emoji{
+		Key:        "1F469-1F3FF-200D-1F9AF",
+		Value:      "👩🏿‍🦯",
+		Descriptor: "Woman With White Cane: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F469-1F3FF-200D-1F9AF-200D-27A1-FE0F": Emoji{
+		Key:        "1F469-1F3FF-200D-1F9AF-200D-27A1-FE0F",
+		Value:      "👩🏿‍🦯‍➡️",
+		Descriptor: "Woman With White Cane Facing Right: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F469-1F3FF-200D-1F9B0": Emoji{
 		Key:        "1F469-1F3FF-200D-1F9B0",
 		Value:      "👩🏿‍🦰",
 		Descriptor: "Woman: Dark Skin Tone, Red Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F469-1F3FF-200D-1F9B1": Emoji{
 		Key:        "1F469-1F3FF-200D-1F9B1",
 		Value:      "👩🏿‍🦱",
 		Descriptor: "Woman: Dark Skin Tone, Curly Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F469-1F3FF-200D-1F9B2": Emoji{
 		Key:        "1F469-1F3FF-200D-1F9B2",
 		Value:      "👩🏿‍🦲",
 		Descriptor: "Woman: Dark Skin Tone, Bald",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F469-1F3FF-200D-1F9B3": Emoji{
 		Key:        "1F469-1F3FF-200D-1F9B3",
 		Value:      "👩🏿‍🦳",
 		Descriptor: "Woman: Dark Skin Tone, White Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
+	},
+	"1F469-1F3FF-200D-1F9BC": Emoji{
+		Key:        "1F469-1F3FF-200D-1F9BC",
+		Value:      "👩🏿‍🦼",
+		Descriptor: "Woman in Motorized Wheelchair: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F469-1F3FF-200D-1F9BC-200D-27A1-FE0F": Emoji{
+		Key:        "1F469-1F3FF-200D-1F9BC-200D-27A1-FE0F",
+		Value:      "👩🏿‍🦼‍➡️",
+		Descriptor: "Woman in Motorized Wheelchair Facing Right: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F469-1F3FF-200D-1F9BD": Emoji{
+		Key:        "1F469-1F3FF-200D-1F9BD",
+		Value:      "👩🏿‍🦽",
+		Descriptor: "Woman in Manual Wheelchair: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F469-1F3FF-200D-1F9BD-200D-27A1-FE0F": Emoji{
+		Key:        "1F469-1F3FF-200D-1F9BD-200D-27A1-FE0F",
+		Value:      "👩🏿‍🦽‍➡️",
+		Descriptor: "Woman in Manual Wheelchair Facing Right: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F469-1F3FF-200D-2695-FE0F": Emoji{
 		Key:        "1F469-1F3FF-200D-2695-FE0F",
 		Value:      "👩🏿‍⚕️",
 		Descriptor: "Woman Health Worker: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FF-200D-2696-FE0F": Emoji{
 		Key:        "1F469-1F3FF-200D-2696-FE0F",
 		Value:      "👩🏿‍⚖️",
 		Descriptor: "Woman Judge: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-1F3FF-200D-2708-FE0F": Emoji{
 		Key:        "1F469-1F3FF-200D-2708-FE0F",
 		Value:      "👩🏿‍✈️",
 		Descriptor: "Woman Pilot: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F469-1F3FF-200D-2764-FE0F-200D-1F468-1F3FB": Emoji{
+		Key:        "1F469-1F3FF-200D-2764-FE0F-200D-1F468-1F3FB",
+		Value:      "👩🏿‍❤️‍👨🏻",
+		Descriptor: "Couple With Heart: Woman, Man, Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FF-200D-2764-FE0F-200D-1F468-1F3FC": Emoji{
+		Key:        "1F469-1F3FF-200D-2764-FE0F-200D-1F468-1F3FC",
+		Value:      "👩🏿‍❤️‍👨🏼",
+		Descriptor: "Couple With Heart: Woman, Man, Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FF-200D-2764-FE0F-200D-1F468-1F3FD": Emoji{
+		Key:        "1F469-1F3FF-200D-2764-FE0F-200D-1F468-1F3FD",
+		Value:      "👩🏿‍❤️‍👨🏽",
+		Descriptor: "Couple With Heart: Woman, Man, Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FF-200D-2764-FE0F-200D-1F468-1F3FE": Emoji{
+		Key:        "1F469-1F3FF-200D-2764-FE0F-200D-1F468-1F3FE",
+		Value:      "👩🏿‍❤️‍👨🏾",
+		Descriptor: "Couple With Heart: Woman, Man, Dark Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FF-200D-2764-FE0F-200D-1F468-1F3FF": Emoji{
+		Key:        "1F469-1F3FF-200D-2764-FE0F-200D-1F468-1F3FF",
+		Value:      "👩🏿‍❤️‍👨🏿",
+		Descriptor: "Couple With Heart: Woman, Man, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FF-200D-2764-FE0F-200D-1F469-1F3FB": Emoji{
+		Key:        "1F469-1F3FF-200D-2764-FE0F-200D-1F469-1F3FB",
+		Value:      "👩🏿‍❤️‍👩🏻",
+		Descriptor: "Couple With Heart: Woman, Woman, Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FF-200D-2764-FE0F-200D-1F469-1F3FC": Emoji{
+		Key:        "1F469-1F3FF-200D-2764-FE0F-200D-1F469-1F3FC",
+		Value:      "👩🏿‍❤️‍👩🏼",
+		Descriptor: "Couple With Heart: Woman, Woman, Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FF-200D-2764-FE0F-200D-1F469-1F3FD": Emoji{
+		Key:        "1F469-1F3FF-200D-2764-FE0F-200D-1F469-1F3FD",
+		Value:      "👩🏿‍❤️‍👩🏽",
+		Descriptor: "Couple With Heart: Woman, Woman, Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FF-200D-2764-FE0F-200D-1F469-1F3FE": Emoji{
+		Key:        "1F469-1F3FF-200D-2764-FE0F-200D-1F469-1F3FE",
+		Value:      "👩🏿‍❤️‍👩🏾",
+		Descriptor: "Couple With Heart: Woman, Woman, Dark Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FF-200D-2764-FE0F-200D-1F469-1F3FF": Emoji{
+		Key:        "1F469-1F3FF-200D-2764-FE0F-200D-1F469-1F3FF",
+		Value:      "👩🏿‍❤️‍👩🏿",
+		Descriptor: "Couple With Heart: Woman, Woman, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FB": Emoji{
+		Key:        "1F469-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FB",
+		Value:      "👩🏿‍❤️‍💋‍👨🏻",
+		Descriptor: "Kiss: Woman, Man, Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FC": Emoji{
+		Key:        "1F469-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FC",
+		Value:      "👩🏿‍❤️‍💋‍👨🏼",
+		Descriptor: "Kiss: Woman, Man, Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FD": Emoji{
+		Key:        "1F469-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FD",
+		Value:      "👩🏿‍❤️‍💋‍👨🏽",
+		Descriptor: "Kiss: Woman, Man, Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FE": Emoji{
+		Key:        "1F469-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FE",
+		Value:      "👩🏿‍❤️‍💋‍👨🏾",
+		Descriptor: "Kiss: Woman, Man, Dark Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FF": Emoji{
+		Key:        "1F469-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F468-1F3FF",
+		Value:      "👩🏿‍❤️‍💋‍👨🏿",
+		Descriptor: "Kiss: Woman, Man, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FB": Emoji{
+		Key:        "1F469-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FB",
+		Value:      "👩🏿‍❤️‍💋‍👩🏻",
+		Descriptor: "Kiss: Woman, Woman, Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FC": Emoji{
+		Key:        "1F469-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FC",
+		Value:      "👩🏿‍❤️‍💋‍👩🏼",
+		Descriptor: "Kiss: Woman, Woman, Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FD": Emoji{
+		Key:        "1F469-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FD",
+		Value:      "👩🏿‍❤️‍💋‍👩🏽",
+		Descriptor: "Kiss: Woman, Woman, Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FE": Emoji{
+		Key:        "1F469-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FE",
+		Value:      "👩🏿‍❤️‍💋‍👩🏾",
+		Descriptor: "Kiss: Woman, Woman, Dark Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F469-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FF": Emoji{
+		Key:        "1F469-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F469-1F3FF",
+		Value:      "👩🏿‍❤️‍💋‍👩🏿",
+		Descriptor: "Kiss: Woman, Woman, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
 	},
 	"1F469-200D-1F33E": Emoji{
 		Key:        "1F469-200D-1F33E",
 		Value:      "👩‍🌾",
 		Descriptor: "Woman Farmer",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-200D-1F373": Emoji{
 		Key:        "1F469-200D-1F373",
 		Value:      "👩‍🍳",
 		Descriptor: "Woman Cook",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F469-200D-1F37C": Emoji{
+		Key:        "1F469-200D-1F37C",
+		Value:      "👩‍🍼",
+		Descriptor: "Woman Feeding Baby",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
 	},
 	"1F469-200D-1F393": Emoji{
 		Key:        "1F469-200D-1F393",
 		Value:      "👩‍🎓",
 		Descriptor: "Woman Student",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-200D-1F3A4": Emoji{
 		Key:        "1F469-200D-1F3A4",
 		Value:      "👩‍🎤",
 		Descriptor: "Woman Singer",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-200D-1F3A8": Emoji{
 		Key:        "1F469-200D-1F3A8",
 		Value:      "👩‍🎨",
 		Descriptor: "Woman Artist",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-200D-1F3EB": Emoji{
 		Key:        "1F469-200D-1F3EB",
 		Value:      "👩‍🏫",
 		Descriptor: "Woman Teacher",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-200D-1F3ED": Emoji{
 		Key:        "1F469-200D-1F3ED",
 		Value:      "👩‍🏭",
 		Descriptor: "Woman Factory Worker",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-200D-1F466": Emoji{
 		Key:        "1F469-200D-1F466",
 		Value:      "👩‍👦",
 		Descriptor: "Family: Woman, Boy",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "4.0",
 	},
 	"1F469-200D-1F466-200D-1F466": Emoji{
 		Key:        "1F469-200D-1F466-200D-1F466",
 		Value:      "👩‍👦‍👦",
 		Descriptor: "Family: Woman, Boy, Boy",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "4.0",
 	},
 	"1F469-200D-1F467": Emoji{
 		Key:        "1F469-200D-1F467",
 		Value:      "👩‍👧",
 		Descriptor: "Family: Woman, Girl",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "4.0",
 	},
 	"1F469-200D-1F467-200D-1F466": Emoji{
 		Key:        "1F469-200D-1F467-200D-1F466",
 		Value:      "👩‍👧‍👦",
 		Descriptor: "Family: Woman, Girl, Boy",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "4.0",
 	},
 	"1F469-200D-1F467-200D-1F467": Emoji{
 		Key:        "1F469-200D-1F467-200D-1F467",
 		Value:      "👩‍👧‍👧",
 		Descriptor: "Family: Woman, Girl, Girl",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "4.0",
 	},
 	"1F469-200D-1F469-200D-1F466": Emoji{
 		Key:        "1F469-200D-1F469-200D-1F466",
 		Value:      "👩‍👩‍👦",
 		Descriptor: "Family: Woman, Woman, Boy",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "2.0",
 	},
 	"1F469-200D-1F469-200D-1F466-200D-1F466": Emoji{
 		Key:        "1F469-200D-1F469-200D-1F466-200D-1F466",
 		Value:      "👩‍👩‍👦‍👦",
 		Descriptor: "Family: Woman, Woman, Boy, Boy",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "2.0",
 	},
 	"1F469-200D-1F469-200D-1F467": Emoji{
 		Key:        "1F469-200D-1F469-200D-1F467",
 		Value:      "👩‍👩‍👧",
 		Descriptor: "Family: Woman, Woman, Girl",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "2.0",
 	},
 	"1F469-200D-1F469-200D-1F467-200D-1F466": Emoji{
 		Key:        "1F469-200D-1F469-200D-1F467-200D-1F466",
 		Value:      "👩‍👩‍👧‍👦",
 		Descriptor: "Family: Woman, Woman, Girl, Boy",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "2.0",
 	},
 	"1F469-200D-1F469-200D-1F467-200D-1F467": Emoji{
 		Key:        "1F469-200D-1F469-200D-1F467-200D-1F467",
 		Value:      "👩‍👩‍👧‍👧",
 		Descriptor: "Family: Woman, Woman, Girl, Girl",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "2.0",
 	},
 	"1F469-200D-1F4BB": Emoji{
 		Key:        "1F469-200D-1F4BB",
 		Value:      "👩‍💻",
 		Descriptor: "Woman Technologist",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-200D-1F4BC": Emoji{
 		Key:        "1F469-200D-1F4BC",
 		Value:      "👩‍💼",
 		Descriptor: "Woman Office Worker",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-200D-1F527": Emoji{
 		Key:        "1F469-200D-1F527",
 		Value:      "👩‍🔧",
 		Descriptor: "Woman Mechanic",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-200D-1F52C": Emoji{
 		Key:        "1F469-200D-1F52C",
 		Value:      "👩‍🔬",
 		Descriptor: "Woman Scientist",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-200D-1F680": Emoji{
 		Key:        "1F469-200D-1F680",
 		Value:      "👩‍🚀",
 		Descriptor: "Woman Astronaut",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-200D-1F692": Emoji{
 		Key:        "1F469-200D-1F692",
 		Value:      "👩‍🚒",
 		Descriptor: "Woman Firefighter",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
+	},
+	"1F469-200D-1F9AF": Emoji{
+		Key:        "1F469-200D-1F9AF",
+		Value:      "👩‍🦯",
+		Descriptor: "Woman With White Cane",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F469-200D-1F9AF-200D-27A1-FE0F": Emoji{
+		Key:        "1F469-200D-1F9AF-200D-27A1-FE0F",
+		Value:      "👩‍🦯‍➡️",
+		Descriptor: "Woman With White Cane Facing Right",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F469-200D-1F9B0": Emoji{
 		Key:        "1F469-200D-1F9B0",
 		Value:      "👩‍🦰",
 		Descriptor: "Woman: Red Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F469-200D-1F9B1": Emoji{
 		Key:        "1F469-200D-1F9B1",
 		Value:      "👩‍🦱",
 		Descriptor: "Woman: Curly Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F469-200D-1F9B2": Emoji{
 		Key:        "1F469-200D-1F9B2",
 		Value:      "👩‍🦲",
 		Descriptor: "Woman: Bald",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
 	},
 	"1F469-200D-1F9B3": Emoji{
 		Key:        "1F469-200D-1F9B3",
 		Value:      "👩‍🦳",
 		Descriptor: "Woman: White Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "11.0",
+	},
+	"1F469-200D-1F9BC": Emoji{
+		Key:        "1F469-200D-1F9BC",
+		Value:      "👩‍🦼",
+		Descriptor: "Woman in Motorized Wheelchair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F469-200D-1F9BC-200D-27A1-FE0F": Emoji{
+		Key:        "1F469-200D-1F9BC-200D-27A1-FE0F",
+		Value:      "👩‍🦼‍➡️",
+		Descriptor: "Woman in Motorized Wheelchair Facing Right",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F469-200D-1F9BD": Emoji{
+		Key:        "1F469-200D-1F9BD",
+		Value:      "👩‍🦽",
+		Descriptor: "Woman in Manual Wheelchair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F469-200D-1F9BD-200D-27A1-FE0F": Emoji{
+		Key:        "1F469-200D-1F9BD-200D-27A1-FE0F",
+		Value:      "👩‍🦽‍➡️",
+		Descriptor: "Woman in Manual Wheelchair Facing Right",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F469-200D-2695-FE0F": Emoji{
 		Key:        "1F469-200D-2695-FE0F",
 		Value:      "👩‍⚕️",
 		Descriptor: "Woman Health Worker",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-200D-2696-FE0F": Emoji{
 		Key:        "1F469-200D-2696-FE0F",
 		Value:      "👩‍⚖️",
 		Descriptor: "Woman Judge",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-200D-2708-FE0F": Emoji{
 		Key:        "1F469-200D-2708-FE0F",
 		Value:      "👩‍✈️",
 		Descriptor: "Woman Pilot",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F469-200D-2764-FE0F-200D-1F468": Emoji{
 		Key:        "1F469-200D-2764-FE0F-200D-1F468",
 		Value:      "👩‍❤️‍👨",
 		Descriptor: "Couple With Heart: Woman, Man",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "2.0",
 	},
 	"1F469-200D-2764-FE0F-200D-1F469": Emoji{
 		Key:        "1F469-200D-2764-FE0F-200D-1F469",
 		Value:      "👩‍❤️‍👩",
 		Descriptor: "Couple With Heart: Woman, Woman",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "2.0",
 	},
 	"1F469-200D-2764-FE0F-200D-1F48B-200D-1F468": Emoji{
 		Key:        "1F469-200D-2764-FE0F-200D-1F48B-200D-1F468",
 		Value:      "👩‍❤️‍💋‍👨",
 		Descriptor: "Kiss: Woman, Man",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "2.0",
 	},
 	"1F469-200D-2764-FE0F-200D-1F48B-200D-1F469": Emoji{
 		Key:        "1F469-200D-2764-FE0F-200D-1F48B-200D-1F469",
 		Value:      "👩‍❤️‍💋‍👩",
 		Descriptor: "Kiss: Woman, Woman",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "2.0",
 	},
 	"1F46A": Emoji{
 		Key:        "1F46A",
 		Value:      "👪",
 		Descriptor: "Family",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-symbol",
+		Version:    "0.6",
 	},
 	"1F46B": Emoji{
 		Key:        "1F46B",
 		Value:      "👫",
 		Descriptor: "Woman and Man Holding Hands",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "0.6",
+	},
+	"1F46B-1F3FB": Emoji{
+		Key:        "1F46B-1F3FB",
+		Value:      "👫🏻",
+		Descriptor: "Woman and Man Holding Hands: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F46B-1F3FC": Emoji{
+		Key:        "1F46B-1F3FC",
+		Value:      "👫🏼",
+		Descriptor: "Woman and Man Holding Hands: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F46B-1F3FD": Emoji{
+		Key:        "1F46B-1F3FD",
+		Value:      "👫🏽",
+		Descriptor: "Woman and Man Holding Hands: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F46B-1F3FE": Emoji{
+		Key:        "1F46B-1F3FE",
+		Value:      "👫🏾",
+		Descriptor: "Woman and Man Holding Hands: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F46B-1F3FF": Emoji{
+		Key:        "1F46B-1F3FF",
+		Value:      "👫🏿",
+		Descriptor: "Woman and Man Holding Hands: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
 	},
 	"1F46C": Emoji{
 		Key:        "1F46C",
 		Value:      "👬",
 		Descriptor: "Men Holding Hands",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "1.0",
+	},
+	"1F46C-1F3FB": Emoji{
+		Key:        "1F46C-1F3FB",
+		Value:      "👬🏻",
+		Descriptor: "Men Holding Hands: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F46C-1F3FC": Emoji{
+		Key:        "1F46C-1F3FC",
+		Value:      "👬🏼",
+		Descriptor: "Men Holding Hands: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F46C-1F3FD": Emoji{
+		Key:        "1F46C-1F3FD",
+		Value:      "👬🏽",
+		Descriptor: "Men Holding Hands: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F46C-1F3FE": Emoji{
+		Key:        "1F46C-1F3FE",
+		Value:      "👬🏾",
+		Descriptor: "Men Holding Hands: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F46C-1F3FF": Emoji{
+		Key:        "1F46C-1F3FF",
+		Value:      "👬🏿",
+		Descriptor: "Men Holding Hands: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
 	},
 	"1F46D": Emoji{
 		Key:        "1F46D",
 		Value:      "👭",
 		Descriptor: "Women Holding Hands",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "1.0",
+	},
+	"1F46D-1F3FB": Emoji{
+		Key:        "1F46D-1F3FB",
+		Value:      "👭🏻",
+		Descriptor: "Women Holding Hands: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F46D-1F3FC": Emoji{
+		Key:        "1F46D-1F3FC",
+		Value:      "👭🏼",
+		Descriptor: "Women Holding Hands: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F46D-1F3FD": Emoji{
+		Key:        "1F46D-1F3FD",
+		Value:      "👭🏽",
+		Descriptor: "Women Holding Hands: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F46D-1F3FE": Emoji{
+		Key:        "1F46D-1F3FE",
+		Value:      "👭🏾",
+		Descriptor: "Women Holding Hands: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F46D-1F3FF": Emoji{
+		Key:        "1F46D-1F3FF",
+		Value:      "👭🏿",
+		Descriptor: "Women Holding Hands: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
 	},
 	"1F46E": Emoji{
 		Key:        "1F46E",
 		Value:      "👮",
 		Descriptor: "Police Officer",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "0.6",
 	},
 	"1F46E-1F3FB": Emoji{
 		Key:        "1F46E-1F3FB",
 		Value:      "👮🏻",
 		Descriptor: "Police Officer: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F46E-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F46E-1F3FB-200D-2640-FE0F",
 		Value:      "👮🏻‍♀️",
 		Descriptor: "Woman Police Officer: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F46E-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F46E-1F3FB-200D-2642-FE0F",
 		Value:      "👮🏻‍♂️",
 		Descriptor: "Man Police Officer: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F46E-1F3FC": Emoji{
 		Key:        "1F46E-1F3FC",
 		Value:      "👮🏼",
 		Descriptor: "Police Officer: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F46E-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F46E-1F3FC-200D-2640-FE0F",
 		Value:      "👮🏼‍♀️",
 		Descriptor: "Woman Police Officer: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F46E-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F46E-1F3FC-200D-2642-FE0F",
 		Value:      "👮🏼‍♂️",
 		Descriptor: "Man Police Officer: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F46E-1F3FD": Emoji{
 		Key:        "1F46E-1F3FD",
 		Value:      "👮🏽",
 		Descriptor: "Police Officer: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F46E-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F46E-1F3FD-200D-2640-FE0F",
 		Value:      "👮🏽‍♀️",
 		Descriptor: "Woman Police Officer: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F46E-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F46E-1F3FD-200D-2642-FE0F",
 		Value:      "👮🏽‍♂️",
 		Descriptor: "Man Police Officer: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F46E-1F3FE": Emoji{
 		Key:        "1F46E-1F3FE",
 		Value:      "👮🏾",
 		Descriptor: "Police Officer: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F46E-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F46E-1F3FE-200D-2640-FE0F",
 		Value:      "👮🏾‍♀️",
 		Descriptor: "Woman Police Officer: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F46E-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F46E-1F3FE-200D-2642-FE0F",
 		Value:      "👮🏾‍♂️",
 		Descriptor: "Man Police Officer: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F46E-1F3FF": Emoji{
 		Key:        "1F46E-1F3FF",
 		Value:      "👮🏿",
 		Descriptor: "Police Officer: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F46E-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F46E-1F3FF-200D-2640-FE0F",
 		Value:      "👮🏿‍♀️",
 		Descriptor: "Woman Police Officer: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F46E-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F46E-1F3FF-200D-2642-FE0F",
 		Value:      "👮🏿‍♂️",
 		Descriptor: "Man Police Officer: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F46E-200D-2640-FE0F": Emoji{
 		Key:        "1F46E-200D-2640-FE0F",
 		Value:      "👮‍♀️",
 		Descriptor: "Woman Police Officer",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F46E-200D-2642-FE0F": Emoji{
 		Key:        "1F46E-200D-2642-FE0F",
 		Value:      "👮‍♂️",
 		Descriptor: "Man Police Officer",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F46F": Emoji{
 		Key:        "1F46F",
 		Value:      "👯",
 		Descriptor: "People With Bunny Ears",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "0.6",
 	},
 	"1F46F-200D-2640-FE0F": Emoji{
 		Key:        "1F46F-200D-2640-FE0F",
 		Value:      "👯‍♀️",
 		Descriptor: "Women With Bunny Ears",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F46F-200D-2642-FE0F": Emoji{
 		Key:        "1F46F-200D-2642-FE0F",
 		Value:      "👯‍♂️",
 		Descriptor: "Men With Bunny Ears",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F470": Emoji{
 		Key:        "1F470",
 		Value:      "👰",
-		Descriptor: "Bride With Veil",
+		Descriptor: "Person With Veil",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "0.6",
 	},
 	"1F470-1F3FB": Emoji{
 		Key:        "1F470-1F3FB",
 		Value:      "👰🏻",
-		Descriptor: "Bride With Veil: Light Skin Tone",
+		Descriptor: "Person With Veil: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
+	},
+	"1F470-1F3FB-200D-2640-FE0F": Emoji{
+		Key:        "1F470-1F3FB-200D-2640-FE0F",
+		Value:      "👰🏻‍♀️",
+		Descriptor: "Woman With Veil: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F470-1F3FB-200D-2642-FE0F": Emoji{
+		Key:        "1F470-1F3FB-200D-2642-FE0F",
+		Value:      "👰🏻‍♂️",
+		Descriptor: "Man With Veil: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
 	},
 	"1F470-1F3FC": Emoji{
 		Key:        "1F470-1F3FC",
 		Value:      "👰🏼",
-		Descriptor: "Bride With Veil: Medium-Light Skin Tone",
+		Descriptor: "Person With Veil: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
+	},
+	"1F470-1F3FC-200D-2640-FE0F": Emoji{
+		Key:        "1F470-1F3FC-200D-2640-FE0F",
+		Value:      "👰🏼‍♀️",
+		Descriptor: "Woman With Veil: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F470-1F3FC-200D-2642-FE0F": Emoji{
+		Key:        "1F470-1F3FC-200D-2642-FE0F",
+		Value:      "👰🏼‍♂️",
+		Descriptor: "Man With Veil: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
 	},
 	"1F470-1F3FD": Emoji{
 		Key:        "1F470-1F3FD",
 		Value:      "👰🏽",
-		Descriptor: "Bride With Veil: Medium Skin Tone",
+		Descriptor: "Person With Veil: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
+	},
+	"1F470-1F3FD-200D-2640-FE0F": Emoji{
+		Key:        "1F470-1F3FD-200D-2640-FE0F",
+		Value:      "👰🏽‍♀️",
+		Descriptor: "Woman With Veil: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F470-1F3FD-200D-2642-FE0F": Emoji{
+		Key:        "1F470-1F3FD-200D-2642-FE0F",
+		Value:      "👰🏽‍♂️",
+		Descriptor: "Man With Veil: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
 	},
 	"1F470-1F3FE": Emoji{
 		Key:        "1F470-1F3FE",
 		Value:      "👰🏾",
-		Descriptor: "Bride With Veil: Medium-Dark Skin Tone",
+		Descriptor: "Person With Veil: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
+	},
+	"1F470-1F3FE-200D-2640-FE0F": Emoji{
+		Key:        "1F470-1F3FE-200D-2640-FE0F",
+		Value:      "👰🏾‍♀️",
+		Descriptor: "Woman With Veil: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F470-1F3FE-200D-2642-FE0F": Emoji{
+		Key:        "1F470-1F3FE-200D-2642-FE0F",
+		Value:      "👰🏾‍♂️",
+		Descriptor: "Man With Veil: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
 	},
 	"1F470-1F3FF": Emoji{
 		Key:        "1F470-1F3FF",
 		Value:      "👰🏿",
-		Descriptor: "Bride With Veil: Dark Skin Tone",
+		Descriptor: "Person With Veil: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
+	},
+	"1F470-1F3FF-200D-2640-FE0F": Emoji{
+		Key:        "1F470-1F3FF-200D-2640-FE0F",
+		Value:      "👰🏿‍♀️",
+		Descriptor: "Woman With Veil: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F470-1F3FF-200D-2642-FE0F": Emoji{
+		Key:        "1F470-1F3FF-200D-2642-FE0F",
+		Value:      "👰🏿‍♂️",
+		Descriptor: "Man With Veil: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F470-200D-2640-FE0F": Emoji{
+		Key:        "1F470-200D-2640-FE0F",
+		Value:      "👰‍♀️",
+		Descriptor: "Woman With Veil",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F470-200D-2642-FE0F": Emoji{
+		Key:        "1F470-200D-2642-FE0F",
+		Value:      "👰‍♂️",
+		Descriptor: "Man With Veil",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
 	},
 	"1F471": Emoji{
 		Key:        "1F471",
 		Value:      "👱",
 		Descriptor: "Person: Blond Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "0.6",
 	},
 	"1F471-1F3FB": Emoji{
 		Key:        "1F471-1F3FB",
 		Value:      "👱🏻",
 		Descriptor: "Person: Light Skin Tone, Blond Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F471-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F471-1F3FB-200D-2640-FE0F",
 		Value:      "👱🏻‍♀️",
 		Descriptor: "Woman: Light Skin Tone, Blond Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "4.0",
 	},
 	"1F471-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F471-1F3FB-200D-2642-FE0F",
 		Value:      "👱🏻‍♂️",
 		Descriptor: "Man: Light Skin Tone, Blond Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "4.0",
 	},
 	"1F471-1F3FC": Emoji{
 		Key:        "1F471-1F3FC",
 		Value:      "👱🏼",
 		Descriptor: "Person: Medium-Light Skin Tone, Blond Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F471-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F471-1F3FC-200D-2640-FE0F",
 		Value:      "👱🏼‍♀️",
 		Descriptor: "Woman: Medium-Light Skin Tone, Blond Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "4.0",
 	},
 	"1F471-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F471-1F3FC-200D-2642-FE0F",
 		Value:      "👱🏼‍♂️",
 		Descriptor: "Man: Medium-Light Skin Tone, Blond Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "4.0",
 	},
 	"1F471-1F3FD": Emoji{
 		Key:        "1F471-1F3FD",
 		Value:      "👱🏽",
 		Descriptor: "Person: Medium Skin Tone, Blond Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F471-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F471-1F3FD-200D-2640-FE0F",
 		Value:      "👱🏽‍♀️",
 		Descriptor: "Woman: Medium Skin Tone, Blond Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "4.0",
 	},
 	"1F471-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F471-1F3FD-200D-2642-FE0F",
 		Value:      "👱🏽‍♂️",
 		Descriptor: "Man: Medium Skin Tone, Blond Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "4.0",
 	},
 	"1F471-1F3FE": Emoji{
 		Key:        "1F471-1F3FE",
 		Value:      "👱🏾",
 		Descriptor: "Person: Medium-Dark Skin Tone, Blond Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F471-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F471-1F3FE-200D-2640-FE0F",
 		Value:      "👱🏾‍♀️",
 		Descriptor: "Woman: Medium-Dark Skin Tone, Blond Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "4.0",
 	},
 	"1F471-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F471-1F3FE-200D-2642-FE0F",
 		Value:      "👱🏾‍♂️",
 		Descriptor: "Man: Medium-Dark Skin Tone, Blond Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "4.0",
 	},
 	"1F471-1F3FF": Emoji{
 		Key:        "1F471-1F3FF",
 		Value:      "👱🏿",
 		Descriptor: "Person: Dark Skin Tone, Blond Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F471-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F471-1F3FF-200D-2640-FE0F",
 		Value:      "👱🏿‍♀️",
 		Descriptor: "Woman: Dark Skin Tone, Blond Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "4.0",
 	},
 	"1F471-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F471-1F3FF-200D-2642-FE0F",
 		Value:      "👱🏿‍♂️",
 		Descriptor: "Man: Dark Skin Tone, Blond Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "4.0",
 	},
 	"1F471-200D-2640-FE0F": Emoji{
 		Key:        "1F471-200D-2640-FE0F",
 		Value:      "👱‍♀️",
 		Descriptor: "Woman: Blond Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "4.0",
 	},
 	"1F471-200D-2642-FE0F": Emoji{
 		Key:        "1F471-200D-2642-FE0F",
 		Value:      "👱‍♂️",
 		Descriptor: "Man: Blond Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "4.0",
 	},
 	"1F472": Emoji{
 		Key:        "1F472",
 		Value:      "👲",
-		Descriptor: "Man With Chinese Cap",
+		Descriptor: "Person With Skullcap",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "0.6",
 	},
 	"1F472-1F3FB": Emoji{
 		Key:        "1F472-1F3FB",
 		Value:      "👲🏻",
-		Descriptor: "Man With Chinese Cap: Light Skin Tone",
+		Descriptor: "Person With Skullcap: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F472-1F3FC": Emoji{
 		Key:        "1F472-1F3FC",
 		Value:      "👲🏼",
-		Descriptor: "Man With Chinese Cap: Medium-Light Skin Tone",
+		Descriptor: "Person With Skullcap: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F472-1F3FD": Emoji{
 		Key:        "1F472-1F3FD",
 		Value:      "👲🏽",
-		Descriptor: "Man With Chinese Cap: Medium Skin Tone",
+		Descriptor: "Person With Skullcap: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F472-1F3FE": Emoji{
 		Key:        "1F472-1F3FE",
 		Value:      "👲🏾",
-		Descriptor: "Man With Chinese Cap: Medium-Dark Skin Tone",
+		Descriptor: "Person With Skullcap: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F472-1F3FF": Emoji{
 		Key:        "1F472-1F3FF",
 		Value:      "👲🏿",
-		Descriptor: "Man With Chinese Cap: Dark Skin Tone",
+		Descriptor: "Person With Skullcap: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F473": Emoji{
 		Key:        "1F473",
 		Value:      "👳",
 		Descriptor: "Person Wearing Turban",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "0.6",
 	},
 	"1F473-1F3FB": Emoji{
 		Key:        "1F473-1F3FB",
 		Value:      "👳🏻",
 		Descriptor: "Person Wearing Turban: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F473-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F473-1F3FB-200D-2640-FE0F",
 		Value:      "👳🏻‍♀️",
 		Descriptor: "Woman Wearing Turban: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F473-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F473-1F3FB-200D-2642-FE0F",
 		Value:      "👳🏻‍♂️",
 		Descriptor: "Man Wearing Turban: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F473-1F3FC": Emoji{
 		Key:        "1F473-1F3FC",
 		Value:      "👳🏼",
 		Descriptor: "Person Wearing Turban: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F473-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F473-1F3FC-200D-2640-FE0F",
 		Value:      "👳🏼‍♀️",
 		Descriptor: "Woman Wearing Turban: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F473-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F473-1F3FC-200D-2642-FE0F",
 		Value:      "👳🏼‍♂️",
 		Descriptor: "Man Wearing Turban: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F473-1F3FD": Emoji{
 		Key:        "1F473-1F3FD",
 		Value:      "👳🏽",
 		Descriptor: "Person Wearing Turban: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F473-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F473-1F3FD-200D-2640-FE0F",
 		Value:      "👳🏽‍♀️",
 		Descriptor: "Woman Wearing Turban: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F473-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F473-1F3FD-200D-2642-FE0F",
 		Value:      "👳🏽‍♂️",
 		Descriptor: "Man Wearing Turban: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F473-1F3FE": Emoji{
 		Key:        "1F473-1F3FE",
 		Value:      "👳🏾",
 		Descriptor: "Person Wearing Turban: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F473-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F473-1F3FE-200D-2640-FE0F",
 		Value:      "👳🏾‍♀️",
 		Descriptor: "Woman Wearing Turban: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F473-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F473-1F3FE-200D-2642-FE0F",
 		Value:      "👳🏾‍♂️",
 		Descriptor: "Man Wearing Turban: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F473-1F3FF": Emoji{
 		Key:        "1F473-1F3FF",
 		Value:      "👳🏿",
 		Descriptor: "Person Wearing Turban: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F473-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F473-1F3FF-200D-2640-FE0F",
 		Value:      "👳🏿‍♀️",
 		Descriptor: "Woman Wearing Turban: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F473-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F473-1F3FF-200D-2642-FE0F",
 		Value:      "👳🏿‍♂️",
 		Descriptor: "Man Wearing Turban: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F473-200D-2640-FE0F": Emoji{
 		Key:        "1F473-200D-2640-FE0F",
 		Value:      "👳‍♀️",
 		Descriptor: "Woman Wearing Turban",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F473-200D-2642-FE0F": Emoji{
 		Key:        "1F473-200D-2642-FE0F",
 		Value:      "👳‍♂️",
 		Descriptor: "Man Wearing Turban",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F474": Emoji{
 		Key:        "1F474",
 		Value:      "👴",
 		Descriptor: "Old Man",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "0.6",
 	},
 	"1F474-1F3FB": Emoji{
 		Key:        "1F474-1F3FB",
 		Value:      "👴🏻",
 		Descriptor: "Old Man: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F474-1F3FC": Emoji{
 		Key:        "1F474-1F3FC",
 		Value:      "👴🏼",
 		Descriptor: "Old Man: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F474-1F3FD": Emoji{
 		Key:        "1F474-1F3FD",
 		Value:      "👴🏽",
 		Descriptor: "Old Man: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F474-1F3FE": Emoji{
 		Key:        "1F474-1F3FE",
 		Value:      "👴🏾",
 		Descriptor: "Old Man: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F474-1F3FF": Emoji{
 		Key:        "1F474-1F3FF",
 		Value:      "👴🏿",
 		Descriptor: "Old Man: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F475": Emoji{
 		Key:        "1F475",
 		Value:      "👵",
 		Descriptor: "Old Woman",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "0.6",
 	},
 	"1F475-1F3FB": Emoji{
 		Key:        "1F475-1F3FB",
 		Value:      "👵🏻",
 		Descriptor: "Old Woman: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F475-1F3FC": Emoji{
 		Key:        "1F475-1F3FC",
 		Value:      "👵🏼",
 		Descriptor: "Old Woman: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F475-1F3FD": Emoji{
 		Key:        "1F475-1F3FD",
 		Value:      "👵🏽",
 		Descriptor: "Old Woman: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F475-1F3FE": Emoji{
 		Key:        "1F475-1F3FE",
 		Value:      "👵🏾",
 		Descriptor: "Old Woman: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F475-1F3FF": Emoji{
 		Key:        "1F475-1F3FF",
 		Value:      "👵🏿",
 		Descriptor: "Old Woman: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F476": Emoji{
 		Key:        "1F476",
 		Value:      "👶",
 		Descriptor: "Baby",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "0.6",
 	},
 	"1F476-1F3FB": Emoji{
 		Key:        "1F476-1F3FB",
 		Value:      "👶🏻",
 		Descriptor: "Baby: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F476-1F3FC": Emoji{
 		Key:        "1F476-1F3FC",
 		Value:      "👶🏼",
 		Descriptor: "Baby: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F476-1F3FD": Emoji{
 		Key:        "1F476-1F3FD",
 		Value:      "👶🏽",
 		Descriptor: "Baby: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F476-1F3FE": Emoji{
 		Key:        "1F476-1F3FE",
 		Value:      "👶🏾",
 		Descriptor: "Baby: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F476-1F3FF": Emoji{
 		Key:        "1F476-1F3FF",
 		Value:      "👶🏿",
 		Descriptor: "Baby: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "1.0",
 	},
 	"1F477": Emoji{
 		Key:        "1F477",
 		Value:      "👷",
 		Descriptor: "Construction Worker",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "0.6",
 	},
 	"1F477-1F3FB": Emoji{
 		Key:        "1F477-1F3FB",
 		Value:      "👷🏻",
 		Descriptor: "Construction Worker: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F477-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F477-1F3FB-200D-2640-FE0F",
 		Value:      "👷🏻‍♀️",
 		Descriptor: "Woman Construction Worker: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F477-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F477-1F3FB-200D-2642-FE0F",
 		Value:      "👷🏻‍♂️",
 		Descriptor: "Man Construction Worker: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F477-1F3FC": Emoji{
 		Key:        "1F477-1F3FC",
 		Value:      "👷🏼",
 		Descriptor: "Construction Worker: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F477-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F477-1F3FC-200D-2640-FE0F",
 		Value:      "👷🏼‍♀️",
 		Descriptor: "Woman Construction Worker: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F477-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F477-1F3FC-200D-2642-FE0F",
 		Value:      "👷🏼‍♂️",
 		Descriptor: "Man Construction Worker: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F477-1F3FD": Emoji{
 		Key:        "1F477-1F3FD",
 		Value:      "👷🏽",
 		Descriptor: "Construction Worker: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F477-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F477-1F3FD-200D-2640-FE0F",
 		Value:      "👷🏽‍♀️",
 		Descriptor: "Woman Construction Worker: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F477-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F477-1F3FD-200D-2642-FE0F",
 		Value:      "👷🏽‍♂️",
 		Descriptor: "Man Construction Worker: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F477-1F3FE": Emoji{
 		Key:        "1F477-1F3FE",
 		Value:      "👷🏾",
 		Descriptor: "Construction Worker: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F477-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F477-1F3FE-200D-2640-FE0F",
 		Value:      "👷🏾‍♀️",
 		Descriptor: "Woman Construction Worker: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F477-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F477-1F3FE-200D-2642-FE0F",
 		Value:      "👷🏾‍♂️",
 		Descriptor: "Man Construction Worker: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F477-1F3FF": Emoji{
 		Key:        "1F477-1F3FF",
 		Value:      "👷🏿",
 		Descriptor: "Construction Worker: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F477-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F477-1F3FF-200D-2640-FE0F",
 		Value:      "👷🏿‍♀️",
 		Descriptor: "Woman Construction Worker: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F477-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F477-1F3FF-200D-2642-FE0F",
 		Value:      "👷🏿‍♂️",
 		Descriptor: "Man Construction Worker: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F477-200D-2640-FE0F": Emoji{
 		Key:        "1F477-200D-2640-FE0F",
 		Value:      "👷‍♀️",
 		Descriptor: "Woman Construction Worker",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F477-200D-2642-FE0F": Emoji{
 		Key:        "1F477-200D-2642-FE0F",
 		Value:      "👷‍♂️",
 		Descriptor: "Man Construction Worker",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F478": Emoji{
 		Key:        "1F478",
 		Value:      "👸",
 		Descriptor: "Princess",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "0.6",
 	},
 	"1F478-1F3FB": Emoji{
 		Key:        "1F478-1F3FB",
 		Value:      "👸🏻",
 		Descriptor: "Princess: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F478-1F3FC": Emoji{
 		Key:        "1F478-1F3FC",
 		Value:      "👸🏼",
 		Descriptor: "Princess: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F478-1F3FD": Emoji{
 		Key:        "1F478-1F3FD",
 		Value:      "👸🏽",
 		Descriptor: "Princess: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F478-1F3FE": Emoji{
 		Key:        "1F478-1F3FE",
 		Value:      "👸🏾",
 		Descriptor: "Princess: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F478-1F3FF": Emoji{
 		Key:        "1F478-1F3FF",
 		Value:      "👸🏿",
 		Descriptor: "Princess: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F479": Emoji{
 		Key:        "1F479",
 		Value:      "👹",
 		Descriptor: "Ogre",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-costume",
+		Version:    "0.6",
 	},
 	"1F47A": Emoji{
 		Key:        "1F47A",
 		Value:      "👺",
 		Descriptor: "Goblin",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-costume",
+		Version:    "0.6",
 	},
 	"1F47B": Emoji{
 		Key:        "1F47B",
 		Value:      "👻",
 		Descriptor: "Ghost",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-costume",
+		Version:    "0.6",
 	},
 	"1F47C": Emoji{
 		Key:        "1F47C",
 		Value:      "👼",
 		Descriptor: "Baby Angel",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "0.6",
 	},
 	"1F47C-1F3FB": Emoji{
 		Key:        "1F47C-1F3FB",
 		Value:      "👼🏻",
 		Descriptor: "Baby Angel: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "1.0",
 	},
 	"1F47C-1F3FC": Emoji{
 		Key:        "1F47C-1F3FC",
 		Value:      "👼🏼",
 		Descriptor: "Baby Angel: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "1.0",
 	},
 	"1F47C-1F3FD": Emoji{
 		Key:        "1F47C-1F3FD",
 		Value:      "👼🏽",
 		Descriptor: "Baby Angel: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "1.0",
 	},
 	"1F47C-1F3FE": Emoji{
 		Key:        "1F47C-1F3FE",
 		Value:      "👼🏾",
 		Descriptor: "Baby Angel: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "1.0",
 	},
 	"1F47C-1F3FF": Emoji{
 		Key:        "1F47C-1F3FF",
 		Value:      "👼🏿",
 		Descriptor: "Baby Angel: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "1.0",
 	},
 	"1F47D": Emoji{
 		Key:        "1F47D",
 		Value:      "👽",
 		Descriptor: "Alien",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-costume",
+		Version:    "0.6",
 	},
 	"1F47E": Emoji{
 		Key:        "1F47E",
 		Value:      "👾",
 		Descriptor: "Alien Monster",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-costume",
+		Version:    "0.6",
 	},
 	"1F47F": Emoji{
 		Key:        "1F47F",
 		Value:      "👿",
 		Descriptor: "Angry Face With Horns",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-negative",
+		Version:    "0.6",
 	},
 	"1F480": Emoji{
 		Key:        "1F480",
 		Value:      "💀",
 		Descriptor: "Skull",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-negative",
+		Version:    "0.6",
 	},
 	"1F481": Emoji{
 		Key:        "1F481",
 		Value:      "💁",
 		Descriptor: "Person Tipping Hand",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "0.6",
 	},
 	"1F481-1F3FB": Emoji{
 		Key:        "1F481-1F3FB",
 		Value:      "💁🏻",
 		Descriptor: "Person Tipping Hand: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F481-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F481-1F3FB-200D-2640-FE0F",
 		Value:      "💁🏻‍♀️",
 		Descriptor: "Woman Tipping Hand: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F481-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F481-1F3FB-200D-2642-FE0F",
 		Value:      "💁🏻‍♂️",
 		Descriptor: "Man Tipping Hand: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F481-1F3FC": Emoji{
 		Key:        "1F481-1F3FC",
 		Value:      "💁🏼",
 		Descriptor: "Person Tipping Hand: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F481-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F481-1F3FC-200D-2640-FE0F",
 		Value:      "💁🏼‍♀️",
 		Descriptor: "Woman Tipping Hand: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F481-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F481-1F3FC-200D-2642-FE0F",
 		Value:      "💁🏼‍♂️",
 		Descriptor: "Man Tipping Hand: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F481-1F3FD": Emoji{
 		Key:        "1F481-1F3FD",
 		Value:      "💁🏽",
 		Descriptor: "Person Tipping Hand: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F481-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F481-1F3FD-200D-2640-FE0F",
 		Value:      "💁🏽‍♀️",
 		Descriptor: "Woman Tipping Hand: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F481-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F481-1F3FD-200D-2642-FE0F",
 		Value:      "💁🏽‍♂️",
 		Descriptor: "Man Tipping Hand: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F481-1F3FE": Emoji{
 		Key:        "1F481-1F3FE",
 		Value:      "💁🏾",
 		Descriptor: "Person Tipping Hand: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F481-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F481-1F3FE-200D-2640-FE0F",
 		Value:      "💁🏾‍♀️",
 		Descriptor: "Woman Tipping Hand: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F481-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F481-1F3FE-200D-2642-FE0F",
 		Value:      "💁🏾‍♂️",
 		Descriptor: "Man Tipping Hand: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F481-1F3FF": Emoji{
 		Key:        "1F481-1F3FF",
 		Value:      "💁🏿",
 		Descriptor: "Person Tipping Hand: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F481-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F481-1F3FF-200D-2640-FE0F",
 		Value:      "💁🏿‍♀️",
 		Descriptor: "Woman Tipping Hand: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F481-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F481-1F3FF-200D-2642-FE0F",
 		Value:      "💁🏿‍♂️",
 		Descriptor: "Man Tipping Hand: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F481-200D-2640-FE0F": Emoji{
 		Key:        "1F481-200D-2640-FE0F",
 		Value:      "💁‍♀️",
 		Descriptor: "Woman Tipping Hand",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F481-200D-2642-FE0F": Emoji{
 		Key:        "1F481-200D-2642-FE0F",
 		Value:      "💁‍♂️",
 		Descriptor: "Man Tipping Hand",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F482": Emoji{
 		Key:        "1F482",
 		Value:      "💂",
 		Descriptor: "Guard",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "0.6",
 	},
 	"1F482-1F3FB": Emoji{
 		Key:        "1F482-1F3FB",
 		Value:      "💂🏻",
 		Descriptor: "Guard: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F482-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F482-1F3FB-200D-2640-FE0F",
 		Value:      "💂🏻‍♀️",
 		Descriptor: "Woman Guard: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F482-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F482-1F3FB-200D-2642-FE0F",
 		Value:      "💂🏻‍♂️",
 		Descriptor: "Man Guard: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F482-1F3FC": Emoji{
 		Key:        "1F482-1F3FC",
 		Value:      "💂🏼",
 		Descriptor: "Guard: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F482-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F482-1F3FC-200D-2640-FE0F",
 		Value:      "💂🏼‍♀️",
 		Descriptor: "Woman Guard: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F482-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F482-1F3FC-200D-2642-FE0F",
 		Value:      "💂🏼‍♂️",
 		Descriptor: "Man Guard: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F482-1F3FD": Emoji{
 		Key:        "1F482-1F3FD",
 		Value:      "💂🏽",
 		Descriptor: "Guard: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F482-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F482-1F3FD-200D-2640-FE0F",
 		Value:      "💂🏽‍♀️",
 		Descriptor: "Woman Guard: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F482-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F482-1F3FD-200D-2642-FE0F",
 		Value:      "💂🏽‍♂️",
 		Descriptor: "Man Guard: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F482-1F3FE": Emoji{
 		Key:        "1F482-1F3FE",
 		Value:      "💂🏾",
 		Descriptor: "Guard: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F482-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F482-1F3FE-200D-2640-FE0F",
 		Value:      "💂🏾‍♀️",
 		Descriptor: "Woman Guard: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F482-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F482-1F3FE-200D-2642-FE0F",
 		Value:      "💂🏾‍♂️",
 		Descriptor: "Man Guard: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F482-1F3FF": Emoji{
 		Key:        "1F482-1F3FF",
 		Value:      "💂🏿",
 		Descriptor: "Guard: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "1.0",
 	},
 	"1F482-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F482-1F3FF-200D-2640-FE0F",
 		Value:      "💂🏿‍♀️",
 		Descriptor: "Woman Guard: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F482-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F482-1F3FF-200D-2642-FE0F",
 		Value:      "💂🏿‍♂️",
 		Descriptor: "Man Guard: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F482-200D-2640-FE0F": Emoji{
 		Key:        "1F482-200D-2640-FE0F",
 		Value:      "💂‍♀️",
 		Descriptor: "Woman Guard",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F482-200D-2642-FE0F": Emoji{
 		Key:        "1F482-200D-2642-FE0F",
 		Value:      "💂‍♂️",
 		Descriptor: "Man Guard",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F483": Emoji{
 		Key:        "1F483",
 		Value:      "💃",
 		Descriptor: "Woman Dancing",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "0.6",
 	},
 	"1F483-1F3FB": Emoji{
 		Key:        "1F483-1F3FB",
 		Value:      "💃🏻",
 		Descriptor: "Woman Dancing: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F483-1F3FC": Emoji{
 		Key:        "1F483-1F3FC",
 		Value:      "💃🏼",
 		Descriptor: "Woman Dancing: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F483-1F3FD": Emoji{
 		Key:        "1F483-1F3FD",
 		Value:      "💃🏽",
 		Descriptor: "Woman Dancing: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F483-1F3FE": Emoji{
 		Key:        "1F483-1F3FE",
 		Value:      "💃🏾",
 		Descriptor: "Woman Dancing: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F483-1F3FF": Emoji{
 		Key:        "1F483-1F3FF",
 		Value:      "💃🏿",
 		Descriptor: "Woman Dancing: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F484": Emoji{
 		Key:        "1F484",
 		Value:      "💄",
 		Descriptor: "Lipstick",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F485": Emoji{
 		Key:        "1F485",
 		Value:      "💅",
 		Descriptor: "Nail Polish",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-prop",
+		Version:    "0.6",
 	},
 	"1F485-1F3FB": Emoji{
 		Key:        "1F485-1F3FB",
 		Value:      "💅🏻",
 		Descriptor: "Nail Polish: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-prop",
+		Version:    "1.0",
 	},
 	"1F485-1F3FC": Emoji{
 		Key:        "1F485-1F3FC",
 		Value:      "💅🏼",
 		Descriptor: "Nail Polish: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-prop",
+		Version:    "1.0",
 	},
 	"1F485-1F3FD": Emoji{
 		Key:        "1F485-1F3FD",
 		Value:      "💅🏽",
 		Descriptor: "Nail Polish: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-prop",
+		Version:    "1.0",
 	},
 	"1F485-1F3FE": Emoji{
 		Key:        "1F485-1F3FE",
 		Value:      "💅🏾",
 		Descriptor: "Nail Polish: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-prop",
+		Version:    "1.0",
 	},
 	"1F485-1F3FF": Emoji{
 		Key:        "1F485-1F3FF",
 		Value:      "💅🏿",
 		Descriptor: "Nail Polish: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-prop",
+		Version:    "1.0",
 	},
 	"1F486": Emoji{
 		Key:        "1F486",
 		Value:      "💆",
 		Descriptor: "Person Getting Massage",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "0.6",
 	},
 	"1F486-1F3FB": Emoji{
 		Key:        "1F486-1F3FB",
 		Value:      "💆🏻",
 		Descriptor: "Person Getting Massage: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F486-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F486-1F3FB-200D-2640-FE0F",
 		Value:      "💆🏻‍♀️",
 		Descriptor: "Woman Getting Massage: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F486-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F486-1F3FB-200D-2642-FE0F",
 		Value:      "💆🏻‍♂️",
 		Descriptor: "Man Getting Massage: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F486-1F3FC": Emoji{
 		Key:        "1F486-1F3FC",
 		Value:      "💆🏼",
 		Descriptor: "Person Getting Massage: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F486-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F486-1F3FC-200D-2640-FE0F",
 		Value:      "💆🏼‍♀️",
 		Descriptor: "Woman Getting Massage: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F486-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F486-1F3FC-200D-2642-FE0F",
 		Value:      "💆🏼‍♂️",
 		Descriptor: "Man Getting Massage: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F486-1F3FD": Emoji{
 		Key:        "1F486-1F3FD",
 		Value:      "💆🏽",
 		Descriptor: "Person Getting Massage: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F486-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F486-1F3FD-200D-2640-FE0F",
 		Value:      "💆🏽‍♀️",
 		Descriptor: "Woman Getting Massage: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F486-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F486-1F3FD-200D-2642-FE0F",
 		Value:      "💆🏽‍♂️",
 		Descriptor: "Man Getting Massage: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F486-1F3FE": Emoji{
 		Key:        "1F486-1F3FE",
 		Value:      "💆🏾",
 		Descriptor: "Person Getting Massage: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F486-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F486-1F3FE-200D-2640-FE0F",
 		Value:      "💆🏾‍♀️",
 		Descriptor: "Woman Getting Massage: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F486-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F486-1F3FE-200D-2642-FE0F",
 		Value:      "💆🏾‍♂️",
 		Descriptor: "Man Getting Massage: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F486-1F3FF": Emoji{
 		Key:        "1F486-1F3FF",
 		Value:      "💆🏿",
 		Descriptor: "Person Getting Massage: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F486-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F486-1F3FF-200D-2640-FE0F",
 		Value:      "💆🏿‍♀️",
 		Descriptor: "Woman Getting Massage: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F486-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F486-1F3FF-200D-2642-FE0F",
 		Value:      "💆🏿‍♂️",
 		Descriptor: "Man Getting Massage: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F486-200D-2640-FE0F": Emoji{
 		Key:        "1F486-200D-2640-FE0F",
 		Value:      "💆‍♀️",
 		Descriptor: "Woman Getting Massage",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F486-200D-2642-FE0F": Emoji{
 		Key:        "1F486-200D-2642-FE0F",
 		Value:      "💆‍♂️",
 		Descriptor: "Man Getting Massage",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F487": Emoji{
 		Key:        "1F487",
 		Value:      "💇",
 		Descriptor: "Person Getting Haircut",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "0.6",
 	},
 	"1F487-1F3FB": Emoji{
 		Key:        "1F487-1F3FB",
 		Value:      "💇🏻",
 		Descriptor: "Person Getting Haircut: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F487-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F487-1F3FB-200D-2640-FE0F",
 		Value:      "💇🏻‍♀️",
 		Descriptor: "Woman Getting Haircut: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F487-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F487-1F3FB-200D-2642-FE0F",
 		Value:      "💇🏻‍♂️",
 		Descriptor: "Man Getting Haircut: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F487-1F3FC": Emoji{
 		Key:        "1F487-1F3FC",
 		Value:      "💇🏼",
 		Descriptor: "Person Getting Haircut: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F487-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F487-1F3FC-200D-2640-FE0F",
 		Value:      "💇🏼‍♀️",
 		Descriptor: "Woman Getting Haircut: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F487-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F487-1F3FC-200D-2642-FE0F",
 		Value:      "💇🏼‍♂️",
 		Descriptor: "Man Getting Haircut: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F487-1F3FD": Emoji{
 		Key:        "1F487-1F3FD",
 		Value:      "💇🏽",
 		Descriptor: "Person Getting Haircut: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F487-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F487-1F3FD-200D-2640-FE0F",
 		Value:      "💇🏽‍♀️",
 		Descriptor: "Woman Getting Haircut: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F487-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F487-1F3FD-200D-2642-FE0F",
 		Value:      "💇🏽‍♂️",
 		Descriptor: "Man Getting Haircut: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F487-1F3FE": Emoji{
 		Key:        "1F487-1F3FE",
 		Value:      "💇🏾",
 		Descriptor: "Person Getting Haircut: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F487-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F487-1F3FE-200D-2640-FE0F",
 		Value:      "💇🏾‍♀️",
 		Descriptor: "Woman Getting Haircut: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F487-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F487-1F3FE-200D-2642-FE0F",
 		Value:      "💇🏾‍♂️",
 		Descriptor: "Man Getting Haircut: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F487-1F3FF": Emoji{
 		Key:        "1F487-1F3FF",
 		Value:      "💇🏿",
 		Descriptor: "Person Getting Haircut: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F487-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F487-1F3FF-200D-2640-FE0F",
 		Value:      "💇🏿‍♀️",
 		Descriptor: "Woman Getting Haircut: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F487-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F487-1F3FF-200D-2642-FE0F",
 		Value:      "💇🏿‍♂️",
 		Descriptor: "Man Getting Haircut: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F487-200D-2640-FE0F": Emoji{
 		Key:        "1F487-200D-2640-FE0F",
 		Value:      "💇‍♀️",
 		Descriptor: "Woman Getting Haircut",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F487-200D-2642-FE0F": Emoji{
 		Key:        "1F487-200D-2642-FE0F",
 		Value:      "💇‍♂️",
 		Descriptor: "Man Getting Haircut",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F488": Emoji{
 		Key:        "1F488",
 		Value:      "💈",
 		Descriptor: "Barber Pole",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-other",
+		Version:    "0.6",
 	},
 	"1F489": Emoji{
 		Key:        "1F489",
 		Value:      "💉",
 		Descriptor: "Syringe",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "medical",
+		Version:    "0.6",
 	},
 	"1F48A": Emoji{
 		Key:        "1F48A",
 		Value:      "💊",
 		Descriptor: "Pill",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "medical",
+		Version:    "0.6",
 	},
 	"1F48B": Emoji{
 		Key:        "1F48B",
 		Value:      "💋",
 		Descriptor: "Kiss Mark",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "emotion",
+		Version:    "0.6",
 	},
 	"1F48C": Emoji{
 		Key:        "1F48C",
 		Value:      "💌",
 		Descriptor: "Love Letter",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "0.6",
 	},
 	"1F48D": Emoji{
 		Key:        "1F48D",
 		Value:      "💍",
 		Descriptor: "Ring",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F48E": Emoji{
 		Key:        "1F48E",
 		Value:      "💎",
 		Descriptor: "Gem Stone",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.6",
 	},
 	"1F48F": Emoji{
 		Key:        "1F48F",
 		Value:      "💏",
 		Descriptor: "Kiss",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "0.6",
+	},
+	"1F48F-1F3FB": Emoji{
+		Key:        "1F48F-1F3FB",
+		Value:      "💏🏻",
+		Descriptor: "Kiss: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F48F-1F3FC": Emoji{
+		Key:        "1F48F-1F3FC",
+		Value:      "💏🏼",
+		Descriptor: "Kiss: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F48F-1F3FD": Emoji{
+		Key:        "1F48F-1F3FD",
+		Value:      "💏🏽",
+		Descriptor: "Kiss: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F48F-1F3FE": Emoji{
+		Key:        "1F48F-1F3FE",
+		Value:      "💏🏾",
+		Descriptor: "Kiss: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F48F-1F3FF": Emoji{
+		Key:        "1F48F-1F3FF",
+		Value:      "💏🏿",
+		Descriptor: "Kiss: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
 	},
 	"1F490": Emoji{
 		Key:        "1F490",
 		Value:      "💐",
 		Descriptor: "Bouquet",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-flower",
+		Version:    "0.6",
 	},
 	"1F491": Emoji{
 		Key:        "1F491",
 		Value:      "💑",
 		Descriptor: "Couple With Heart",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "0.6",
+	},
+	"1F491-1F3FB": Emoji{
+		Key:        "1F491-1F3FB",
+		Value:      "💑🏻",
+		Descriptor: "Couple With Heart: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F491-1F3FC": Emoji{
+		Key:        "1F491-1F3FC",
+		Value:      "💑🏼",
+		Descriptor: "Couple With Heart: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F491-1F3FD": Emoji{
+		Key:        "1F491-1F3FD",
+		Value:      "💑🏽",
+		Descriptor: "Couple With Heart: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F491-1F3FE": Emoji{
+		Key:        "1F491-1F3FE",
+		Value:      "💑🏾",
+		Descriptor: "Couple With Heart: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F491-1F3FF": Emoji{
+		Key:        "1F491-1F3FF",
+		Value:      "💑🏿",
+		Descriptor: "Couple With Heart: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
 	},
 	"1F492": Emoji{
 		Key:        "1F492",
 		Value:      "💒",
 		Descriptor: "Wedding",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "0.6",
 	},
 	"1F493": Emoji{
 		Key:        "1F493",
 		Value:      "💓",
 		Descriptor: "Beating Heart",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "0.6",
 	},
 	"1F494": Emoji{
 		Key:        "1F494",
 		Value:      "💔",
 		Descriptor: "Broken Heart",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "0.6",
 	},
 	"1F495": Emoji{
 		Key:        "1F495",
 		Value:      "💕",
 		Descriptor: "Two Hearts",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "0.6",
 	},
 	"1F496": Emoji{
 		Key:        "1F496",
 		Value:      "💖",
 		Descriptor: "Sparkling Heart",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "0.6",
 	},
 	"1F497": Emoji{
 		Key:        "1F497",
 		Value:      "💗",
 		Descriptor: "Growing Heart",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "0.6",
 	},
 	"1F498": Emoji{
 		Key:        "1F498",
 		Value:      "💘",
 		Descriptor: "Heart With Arrow",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "0.6",
 	},
 	"1F499": Emoji{
 		Key:        "1F499",
 		Value:      "💙",
 		Descriptor: "Blue Heart",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "0.6",
 	},
 	"1F49A": Emoji{
 		Key:        "1F49A",
 		Value:      "💚",
 		Descriptor: "Green Heart",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "0.6",
 	},
 	"1F49B": Emoji{
 		Key:        "1F49B",
 		Value:      "💛",
 		Descriptor: "Yellow Heart",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "0.6",
 	},
 	"1F49C": Emoji{
 		Key:        "1F49C",
 		Value:      "💜",
 		Descriptor: "Purple Heart",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "0.6",
 	},
 	"1F49D": Emoji{
 		Key:        "1F49D",
 		Value:      "💝",
 		Descriptor: "Heart With Ribbon",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "0.6",
 	},
 	"1F49E": Emoji{
 		Key:        "1F49E",
 		Value:      "💞",
 		Descriptor: "Revolving Hearts",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "0.6",
 	},
 	"1F49F": Emoji{
 		Key:        "1F49F",
 		Value:      "💟",
 		Descriptor: "Heart Decoration",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "0.6",
 	},
 	"1F4A0": Emoji{
 		Key:        "1F4A0",
 		Value:      "💠",
 		Descriptor: "Diamond With a Dot",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "0.6",
 	},
 	"1F4A1": Emoji{
 		Key:        "1F4A1",
 		Value:      "💡",
 		Descriptor: "Light Bulb",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "light & video",
+		Version:    "0.6",
 	},
 	"1F4A2": Emoji{
 		Key:        "1F4A2",
 		Value:      "💢",
 		Descriptor: "Anger Symbol",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "emotion",
+		Version:    "0.6",
 	},
 	"1F4A3": Emoji{
 		Key:        "1F4A3",
 		Value:      "💣",
 		Descriptor: "Bomb",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "0.6",
 	},
 	"1F4A4": Emoji{
 		Key:        "1F4A4",
 		Value:      "💤",
-		Descriptor: "Zzz",
+		Descriptor: "ZZZ",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "emotion",
+		Version:    "0.6",
 	},
 	"1F4A5": Emoji{
 		Key:        "1F4A5",
 		Value:      "💥",
 		Descriptor: "Collision",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "emotion",
+		Version:    "0.6",
 	},
 	"1F4A6": Emoji{
 		Key:        "1F4A6",
 		Value:      "💦",
 		Descriptor: "Sweat Droplets",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "emotion",
+		Version:    "0.6",
 	},
 	"1F4A7": Emoji{
 		Key:        "1F4A7",
 		Value:      "💧",
 		Descriptor: "Droplet",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.6",
 	},
 	"1F4A8": Emoji{
 		Key:        "1F4A8",
 		Value:      "💨",
 		Descriptor: "Dashing Away",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "emotion",
+		Version:    "0.6",
 	},
 	"1F4A9": Emoji{
 		Key:        "1F4A9",
 		Value:      "💩",
 		Descriptor: "Pile of Poo",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-costume",
+		Version:    "0.6",
 	},
 	"1F4AA": Emoji{
 		Key:        "1F4AA",
 		Value:      "💪",
 		Descriptor: "Flexed Biceps",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "0.6",
 	},
 	"1F4AA-1F3FB": Emoji{
 		Key:        "1F4AA-1F3FB",
 		Value:      "💪🏻",
 		Descriptor: "Flexed Biceps: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "1.0",
 	},
 	"1F4AA-1F3FC": Emoji{
 		Key:        "1F4AA-1F3FC",
 		Value:      "💪🏼",
 		Descriptor: "Flexed Biceps: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "1.0",
 	},
 	"1F4AA-1F3FD": Emoji{
 		Key:        "1F4AA-1F3FD",
 		Value:      "💪🏽",
 		Descriptor: "Flexed Biceps: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "1.0",
 	},
 	"1F4AA-1F3FE": Emoji{
 		Key:        "1F4AA-1F3FE",
 		Value:      "💪🏾",
 		Descriptor: "Flexed Biceps: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "1.0",
 	},
 	"1F4AA-1F3FF": Emoji{
 		Key:        "1F4AA-1F3FF",
 		Value:      "💪🏿",
 		Descriptor: "Flexed Biceps: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "1.0",
 	},
 	"1F4AB": Emoji{
 		Key:        "1F4AB",
 		Value:      "💫",
 		Descriptor: "Dizzy",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "emotion",
+		Version:    "0.6",
 	},
 	"1F4AC": Emoji{
 		Key:        "1F4AC",
 		Value:      "💬",
 		Descriptor: "Speech Balloon",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "emotion",
+		Version:    "0.6",
 	},
 	"1F4AD": Emoji{
 		Key:        "1F4AD",
 		Value:      "💭",
 		Descriptor: "Thought Balloon",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "emotion",
+		Version:    "1.0",
 	},
 	"1F4AE": Emoji{
 		Key:        "1F4AE",
 		Value:      "💮",
 		Descriptor: "White Flower",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-flower",
+		Version:    "0.6",
 	},
 	"1F4AF": Emoji{
 		Key:        "1F4AF",
 		Value:      "💯",
 		Descriptor: "Hundred Points",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "emotion",
+		Version:    "0.6",
 	},
 	"1F4B0": Emoji{
 		Key:        "1F4B0",
 		Value:      "💰",
 		Descriptor: "Money Bag",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "money",
+		Version:    "0.6",
 	},
 	"1F4B1": Emoji{
 		Key:        "1F4B1",
 		Value:      "💱",
 		Descriptor: "Currency Exchange",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "currency",
+		Version:    "0.6",
 	},
 	"1F4B2": Emoji{
 		Key:        "1F4B2",
 		Value:      "💲",
 		Descriptor: "Heavy Dollar Sign",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "currency",
+		Version:    "0.6",
 	},
 	"1F4B3": Emoji{
 		Key:        "1F4B3",
 		Value:      "💳",
 		Descriptor: "Credit Card",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "money",
+		Version:    "0.6",
 	},
 	"1F4B4": Emoji{
 		Key:        "1F4B4",
 		Value:      "💴",
 		Descriptor: "Yen Banknote",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "money",
+		Version:    "0.6",
 	},
 	"1F4B5": Emoji{
 		Key:        "1F4B5",
 		Value:      "💵",
 		Descriptor: "Dollar Banknote",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "money",
+		Version:    "0.6",
 	},
 	"1F4B6": Emoji{
 		Key:        "1F4B6",
 		Value:      "💶",
 		Descriptor: "Euro Banknote",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "money",
+		Version:    "1.0",
 	},
 	"1F4B7": Emoji{
 		Key:        "1F4B7",
 		Value:      "💷",
 		Descriptor: "Pound Banknote",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "money",
+		Version:    "1.0",
 	},
 	"1F4B8": Emoji{
 		Key:        "1F4B8",
 		Value:      "💸",
 		Descriptor: "Money With Wings",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "money",
+		Version:    "0.6",
 	},
 	"1F4B9": Emoji{
 		Key:        "1F4B9",
 		Value:      "💹",
 		Descriptor: "Chart Increasing With Yen",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "money",
+		Version:    "0.6",
 	},
 	"1F4BA": Emoji{
 		Key:        "1F4BA",
 		Value:      "💺",
 		Descriptor: "Seat",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-air",
+		Version:    "0.6",
 	},
 	"1F4BB": Emoji{
 		Key:        "1F4BB",
 		Value:      "💻",
-		Descriptor: "Laptop Computer",
+		Descriptor: "Laptop",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "computer",
+		Version:    "0.6",
 	},
 	"1F4BC": Emoji{
 		Key:        "1F4BC",
 		Value:      "💼",
 		Descriptor: "Briefcase",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "office",
+		Version:    "0.6",
 	},
 	"1F4BD": Emoji{
 		Key:        "1F4BD",
 		Value:      "💽",
 		Descriptor: "Computer Disk",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "computer",
+		Version:    "0.6",
 	},
 	"1F4BE": Emoji{
 		Key:        "1F4BE",
 		Value:      "💾",
 		Descriptor: "Floppy Disk",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "computer",
+		Version:    "0.6",
 	},
 	"1F4BF": Emoji{
 		Key:        "1F4BF",
 		Value:      "💿",
 		Descriptor: "Optical Disk",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "computer",
+		Version:    "0.6",
 	},
 	"1F4C0": Emoji{
 		Key:        "1F4C0",
 		Value:      "📀",
-		Descriptor: "DVD",
+		Descriptor: "Dvd",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "computer",
+		Version:    "0.6",
 	},
 	"1F4C1": Emoji{
 		Key:        "1F4C1",
 		Value:      "📁",
 		Descriptor: "File Folder",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "office",
+		Version:    "0.6",
 	},
 	"1F4C2": Emoji{
 		Key:        "1F4C2",
 		Value:      "📂",
 		Descriptor: "Open File Folder",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "office",
+		Version:    "0.6",
 	},
 	"1F4C3": Emoji{
 		Key:        "1F4C3",
 		Value:      "📃",
 		Descriptor: "Page With Curl",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "book-paper",
+		Version:    "0.6",
 	},
 	"1F4C4": Emoji{
 		Key:        "1F4C4",
 		Value:      "📄",
 		Descriptor: "Page Facing Up",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "book-paper",
+		Version:    "0.6",
 	},
 	"1F4C5": Emoji{
 		Key:        "1F4C5",
 		Value:      "📅",
 		Descriptor: "Calendar",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "office",
+		Version:    "0.6",
 	},
 	"1F4C6": Emoji{
 		Key:        "1F4C6",
 		Value:      "📆",
 		Descriptor: "Tear-Off Calendar",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "office",
+		Version:    "0.6",
 	},
 	"1F4C7": Emoji{
 		Key:        "1F4C7",
 		Value:      "📇",
 		Descriptor: "Card Index",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "office",
+		Version:    "0.6",
 	},
 	"1F4C8": Emoji{
 		Key:        "1F4C8",
 		Value:      "📈",
 		Descriptor: "Chart Increasing",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "office",
+		Version:    "0.6",
 	},
 	"1F4C9": Emoji{
 		Key:        "1F4C9",
 		Value:      "📉",
 		Descriptor: "Chart Decreasing",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "office",
+		Version:    "0.6",
 	},
 	"1F4CA": Emoji{
 		Key:        "1F4CA",
 		Value:      "📊",
 		Descriptor: "Bar Chart",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "office",
+		Version:    "0.6",
 	},
 	"1F4CB": Emoji{
 		Key:        "1F4CB",
 		Value:      "📋",
 		Descriptor: "Clipboard",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "office",
+		Version:    "0.6",
 	},
 	"1F4CC": Emoji{
 		Key:        "1F4CC",
 		Value:      "📌",
 		Descriptor: "Pushpin",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "office",
+		Version:    "0.6",
 	},
 	"1F4CD": Emoji{
 		Key:        "1F4CD",
 		Value:      "📍",
 		Descriptor: "Round Pushpin",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "office",
+		Version:    "0.6",
 	},
 	"1F4CE": Emoji{
 		Key:        "1F4CE",
 		Value:      "📎",
 		Descriptor: "Paperclip",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "office",
+		Version:    "0.6",
 	},
 	"1F4CF": Emoji{
 		Key:        "1F4CF",
 		Value:      "📏",
 		Descriptor: "Straight Ruler",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "office",
+		Version:    "0.6",
 	},
 	"1F4D0": Emoji{
 		Key:        "1F4D0",
 		Value:      "📐",
 		Descriptor: "Triangular Ruler",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "office",
+		Version:    "0.6",
 	},
 	"1F4D1": Emoji{
 		Key:        "1F4D1",
 		Value:      "📑",
 		Descriptor: "Bookmark Tabs",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "book-paper",
+		Version:    "0.6",
 	},
 	"1F4D2": Emoji{
 		Key:        "1F4D2",
 		Value:      "📒",
 		Descriptor: "Ledger",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "book-paper",
+		Version:    "0.6",
 	},
 	"1F4D3": Emoji{
 		Key:        "1F4D3",
 		Value:      "📓",
 		Descriptor: "Notebook",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "book-paper",
+		Version:    "0.6",
 	},
 	"1F4D4": Emoji{
 		Key:        "1F4D4",
 		Value:      "📔",
 		Descriptor: "Notebook With Decorative Cover",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "book-paper",
+		Version:    "0.6",
 	},
 	"1F4D5": Emoji{
 		Key:        "1F4D5",
 		Value:      "📕",
 		Descriptor: "Closed Book",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "book-paper",
+		Version:    "0.6",
 	},
 	"1F4D6": Emoji{
 		Key:        "1F4D6",
 		Value:      "📖",
 		Descriptor: "Open Book",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "book-paper",
+		Version:    "0.6",
 	},
 	"1F4D7": Emoji{
 		Key:        "1F4D7",
 		Value:      "📗",
 		Descriptor: "Green Book",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "book-paper",
+		Version:    "0.6",
 	},
 	"1F4D8": Emoji{
 		Key:        "1F4D8",
 		Value:      "📘",
 		Descriptor: "Blue Book",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "book-paper",
+		Version:    "0.6",
 	},
 	"1F4D9": Emoji{
 		Key:        "1F4D9",
 		Value:      "📙",
 		Descriptor: "Orange Book",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "book-paper",
+		Version:    "0.6",
 	},
 	"1F4DA": Emoji{
 		Key:        "1F4DA",
 		Value:      "📚",
 		Descriptor: "Books",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "book-paper",
+		Version:    "0.6",
 	},
 	"1F4DB": Emoji{
 		Key:        "1F4DB",
 		Value:      "📛",
 		Descriptor: "Name Badge",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "other-symbol",
+		Version:    "0.6",
 	},
 	"1F4DC": Emoji{
 		Key:        "1F4DC",
 		Value:      "📜",
 		Descriptor: "Scroll",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "book-paper",
+		Version:    "0.6",
 	},
 	"1F4DD": Emoji{
 		Key:        "1F4DD",
 		Value:      "📝",
 		Descriptor: "Memo",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "writing",
+		Version:    "0.6",
 	},
 	"1F4DE": Emoji{
 		Key:        "1F4DE",
 		Value:      "📞",
 		Descriptor: "Telephone Receiver",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "phone",
+		Version:    "0.6",
 	},
 	"1F4DF": Emoji{
 		Key:        "1F4DF",
 		Value:      "📟",
 		Descriptor: "Pager",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "phone",
+		Version:    "0.6",
 	},
 	"1F4E0": Emoji{
 		Key:        "1F4E0",
 		Value:      "📠",
 		Descriptor: "Fax Machine",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "phone",
+		Version:    "0.6",
 	},
 	"1F4E1": Emoji{
 		Key:        "1F4E1",
 		Value:      "📡",
 		Descriptor: "Satellite Antenna",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "science",
+		Version:    "0.6",
 	},
 	"1F4E2": Emoji{
 		Key:        "1F4E2",
 		Value:      "📢",
 		Descriptor: "Loudspeaker",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "sound",
+		Version:    "0.6",
 	},
 	"1F4E3": Emoji{
 		Key:        "1F4E3",
 		Value:      "📣",
 		Descriptor: "Megaphone",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "sound",
+		Version:    "0.6",
 	},
 	"1F4E4": Emoji{
 		Key:        "1F4E4",
 		Value:      "📤",
 		Descriptor: "Outbox Tray",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "mail",
+		Version:    "0.6",
 	},
 	"1F4E5": Emoji{
 		Key:        "1F4E5",
 		Value:      "📥",
 		Descriptor: "Inbox Tray",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "mail",
+		Version:    "0.6",
 	},
 	"1F4E6": Emoji{
 		Key:        "1F4E6",
 		Value:      "📦",
 		Descriptor: "Package",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "mail",
+		Version:    "0.6",
 	},
 	"1F4E7": Emoji{
 		Key:        "1F4E7",
 		Value:      "📧",
 		Descriptor: "E-Mail",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "mail",
+		Version:    "0.6",
 	},
 	"1F4E8": Emoji{
 		Key:        "1F4E8",
 		Value:      "📨",
 		Descriptor: "Incoming Envelope",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "mail",
+		Version:    "0.6",
 	},
 	"1F4E9": Emoji{
 		Key:        "1F4E9",
 		Value:      "📩",
 		Descriptor: "Envelope With Arrow",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "mail",
+		Version:    "0.6",
 	},
 	"1F4EA": Emoji{
 		Key:        "1F4EA",
 		Value:      "📪",
 		Descriptor: "Closed Mailbox With Lowered Flag",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "mail",
+		Version:    "0.6",
 	},
 	"1F4EB": Emoji{
 		Key:        "1F4EB",
 		Value:      "📫",
 		Descriptor: "Closed Mailbox With Raised Flag",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "mail",
+		Version:    "0.6",
 	},
 	"1F4EC": Emoji{
 		Key:        "1F4EC",
 		Value:      "📬",
 		Descriptor: "Open Mailbox With Raised Flag",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "mail",
+		Version:    "0.7",
 	},
 	"1F4ED": Emoji{
 		Key:        "1F4ED",
 		Value:      "📭",
 		Descriptor: "Open Mailbox With Lowered Flag",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "mail",
+		Version:    "0.7",
 	},
 	"1F4EE": Emoji{
 		Key:        "1F4EE",
 		Value:      "📮",
 		Descriptor: "Postbox",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "mail",
+		Version:    "0.6",
 	},
 	"1F4EF": Emoji{
 		Key:        "1F4EF",
 		Value:      "📯",
 		Descriptor: "Postal Horn",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "sound",
+		Version:    "1.0",
 	},
 	"1F4F0": Emoji{
 		Key:        "1F4F0",
 		Value:      "📰",
 		Descriptor: "Newspaper",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "book-paper",
+		Version:    "0.6",
 	},
 	"1F4F1": Emoji{
 		Key:        "1F4F1",
 		Value:      "📱",
 		Descriptor: "Mobile Phone",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "phone",
+		Version:    "0.6",
 	},
 	"1F4F2": Emoji{
 		Key:        "1F4F2",
 		Value:      "📲",
 		Descriptor: "Mobile Phone With Arrow",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "phone",
+		Version:    "0.6",
 	},
 	"1F4F3": Emoji{
 		Key:        "1F4F3",
 		Value:      "📳",
 		Descriptor: "Vibration Mode",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "0.6",
 	},
 	"1F4F4": Emoji{
 		Key:        "1F4F4",
 		Value:      "📴",
 		Descriptor: "Mobile Phone Off",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "0.6",
 	},
 	"1F4F5": Emoji{
 		Key:        "1F4F5",
 		Value:      "📵",
 		Descriptor: "No Mobile Phones",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "warning",
+		Version:    "1.0",
 	},
 	"1F4F6": Emoji{
 		Key:        "1F4F6",
 		Value:      "📶",
 		Descriptor: "Antenna Bars",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "0.6",
 	},
 	"1F4F7": Emoji{
 		Key:        "1F4F7",
 		Value:      "📷",
 		Descriptor: "Camera",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "light & video",
+		Version:    "0.6",
 	},
 	"1F4F8": Emoji{
 		Key:        "1F4F8",
 		Value:      "📸",
 		Descriptor: "Camera With Flash",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "light & video",
+		Version:    "1.0",
 	},
 	"1F4F9": Emoji{
 		Key:        "1F4F9",
 		Value:      "📹",
 		Descriptor: "Video Camera",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "light & video",
+		Version:    "0.6",
 	},
 	"1F4FA": Emoji{
 		Key:        "1F4FA",
 		Value:      "📺",
 		Descriptor: "Television",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "light & video",
+		Version:    "0.6",
 	},
 	"1F4FB": Emoji{
 		Key:        "1F4FB",
 		Value:      "📻",
 		Descriptor: "Radio",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "music",
+		Version:    "0.6",
 	},
 	"1F4FC": Emoji{
 		Key:        "1F4FC",
 		Value:      "📼",
 		Descriptor: "Videocassette",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "light & video",
+		Version:    "0.6",
 	},
 	"1F4FD-FE0F": Emoji{
 		Key:        "1F4FD-FE0F",
 		Value:      "📽️",
 		Descriptor: "Film Projector",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "light & video",
+		Version:    "0.7",
 	},
 	"1F4FF": Emoji{
 		Key:        "1F4FF",
 		Value:      "📿",
 		Descriptor: "Prayer Beads",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "1.0",
 	},
 	"1F500": Emoji{
 		Key:        "1F500",
 		Value:      "🔀",
 		Descriptor: "Shuffle Tracks Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "1.0",
 	},
 	"1F501": Emoji{
 		Key:        "1F501",
 		Value:      "🔁",
 		Descriptor: "Repeat Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "1.0",
 	},
 	"1F502": Emoji{
 		Key:        "1F502",
 		Value:      "🔂",
 		Descriptor: "Repeat Single Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "1.0",
 	},
 	"1F503": Emoji{
 		Key:        "1F503",
 		Value:      "🔃",
 		Descriptor: "Clockwise Vertical Arrows",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "arrow",
+		Version:    "0.6",
 	},
 	"1F504": Emoji{
 		Key:        "1F504",
 		Value:      "🔄",
 		Descriptor: "Counterclockwise Arrows Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "arrow",
+		Version:    "1.0",
 	},
 	"1F505": Emoji{
 		Key:        "1F505",
 		Value:      "🔅",
 		Descriptor: "Dim Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "1.0",
 	},
 	"1F506": Emoji{
 		Key:        "1F506",
 		Value:      "🔆",
 		Descriptor: "Bright Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "1.0",
 	},
 	"1F507": Emoji{
 		Key:        "1F507",
 		Value:      "🔇",
 		Descriptor: "Muted Speaker",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "sound",
+		Version:    "1.0",
 	},
 	"1F508": Emoji{
 		Key:        "1F508",
 		Value:      "🔈",
 		Descriptor: "Speaker Low Volume",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "sound",
+		Version:    "0.7",
 	},
 	"1F509": Emoji{
 		Key:        "1F509",
 		Value:      "🔉",
 		Descriptor: "Speaker Medium Volume",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "sound",
+		Version:    "1.0",
 	},
 	"1F50A": Emoji{
 		Key:        "1F50A",
 		Value:      "🔊",
 		Descriptor: "Speaker High Volume",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "sound",
+		Version:    "0.6",
 	},
 	"1F50B": Emoji{
 		Key:        "1F50B",
 		Value:      "🔋",
 		Descriptor: "Battery",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "computer",
+		Version:    "0.6",
 	},
 	"1F50C": Emoji{
 		Key:        "1F50C",
 		Value:      "🔌",
 		Descriptor: "Electric Plug",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "computer",
+		Version:    "0.6",
 	},
 	"1F50D": Emoji{
 		Key:        "1F50D",
 		Value:      "🔍",
 		Descriptor: "Magnifying Glass Tilted Left",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "light & video",
+		Version:    "0.6",
 	},
 	"1F50E": Emoji{
 		Key:        "1F50E",
 		Value:      "🔎",
 		Descriptor: "Magnifying Glass Tilted Right",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "light & video",
+		Version:    "0.6",
 	},
 	"1F50F": Emoji{
 		Key:        "1F50F",
 		Value:      "🔏",
 		Descriptor: "Locked With Pen",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "lock",
+		Version:    "0.6",
 	},
 	"1F510": Emoji{
 		Key:        "1F510",
 		Value:      "🔐",
 		Descriptor: "Locked With Key",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "lock",
+		Version:    "0.6",
 	},
 	"1F511": Emoji{
 		Key:        "1F511",
 		Value:      "🔑",
 		Descriptor: "Key",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "lock",
+		Version:    "0.6",
 	},
 	"1F512": Emoji{
 		Key:        "1F512",
 		Value:      "🔒",
 		Descriptor: "Locked",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "lock",
+		Version:    "0.6",
 	},
 	"1F513": Emoji{
 		Key:        "1F513",
 		Value:      "🔓",
 		Descriptor: "Unlocked",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "lock",
+		Version:    "0.6",
 	},
 	"1F514": Emoji{
 		Key:        "1F514",
 		Value:      "🔔",
 		Descriptor: "Bell",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "sound",
+		Version:    "0.6",
 	},
 	"1F515": Emoji{
 		Key:        "1F515",
 		Value:      "🔕",
 		Descriptor: "Bell With Slash",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "sound",
+		Version:    "1.0",
 	},
 	"1F516": Emoji{
 		Key:        "1F516",
 		Value:      "🔖",
 		Descriptor: "Bookmark",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "book-paper",
+		Version:    "0.6",
 	},
 	"1F517": Emoji{
 		Key:        "1F517",
 		Value:      "🔗",
 		Descriptor: "Link",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "0.6",
 	},
 	"1F518": Emoji{
 		Key:        "1F518",
 		Value:      "🔘",
 		Descriptor: "Radio Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "0.6",
 	},
 	"1F519": Emoji{
 		Key:        "1F519",
 		Value:      "🔙",
-		Descriptor: "Back Arrow",
+		Descriptor: "BACK Arrow",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "arrow",
+		Version:    "0.6",
 	},
 	"1F51A": Emoji{
 		Key:        "1F51A",
 		Value:      "🔚",
-		Descriptor: "End Arrow",
+		Descriptor: "END Arrow",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "arrow",
+		Version:    "0.6",
 	},
 	"1F51B": Emoji{
 		Key:        "1F51B",
 		Value:      "🔛",
-		Descriptor: "On! Arrow",
+		Descriptor: "ON! Arrow",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "arrow",
+		Version:    "0.6",
 	},
 	"1F51C": Emoji{
 		Key:        "1F51C",
 		Value:      "🔜",
-		Descriptor: "Soon Arrow",
+		Descriptor: "SOON Arrow",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "arrow",
+		Version:    "0.6",
 	},
 	"1F51D": Emoji{
 		Key:        "1F51D",
 		Value:      "🔝",
-		Descriptor: "Top Arrow",
+		Descriptor: "TOP Arrow",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "arrow",
+		Version:    "0.6",
 	},
 	"1F51E": Emoji{
 		Key:        "1F51E",
 		Value:      "🔞",
 		Descriptor: "No One Under Eighteen",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "warning",
+		Version:    "0.6",
 	},
 	"1F51F": Emoji{
 		Key:        "1F51F",
 		Value:      "🔟",
 		Descriptor: "Keycap: 10",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "keycap",
+		Version:    "0.6",
 	},
 	"1F520": Emoji{
 		Key:        "1F520",
 		Value:      "🔠",
 		Descriptor: "Input Latin Uppercase",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F521": Emoji{
 		Key:        "1F521",
 		Value:      "🔡",
 		Descriptor: "Input Latin Lowercase",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F522": Emoji{
 		Key:        "1F522",
 		Value:      "🔢",
 		Descriptor: "Input Numbers",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F523": Emoji{
 		Key:        "1F523",
 		Value:      "🔣",
 		Descriptor: "Input Symbols",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F524": Emoji{
 		Key:        "1F524",
 		Value:      "🔤",
 		Descriptor: "Input Latin Letters",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"1F525": Emoji{
 		Key:        "1F525",
 		Value:      "🔥",
 		Descriptor: "Fire",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.6",
 	},
 	"1F526": Emoji{
 		Key:        "1F526",
 		Value:      "🔦",
 		Descriptor: "Flashlight",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "light & video",
+		Version:    "0.6",
 	},
 	"1F527": Emoji{
 		Key:        "1F527",
 		Value:      "🔧",
 		Descriptor: "Wrench",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "0.6",
 	},
 	"1F528": Emoji{
 		Key:        "1F528",
 		Value:      "🔨",
 		Descriptor: "Hammer",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "0.6",
 	},
 	"1F529": Emoji{
 		Key:        "1F529",
 		Value:      "🔩",
 		Descriptor: "Nut and Bolt",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "0.6",
 	},
 	"1F52A": Emoji{
 		Key:        "1F52A",
 		Value:      "🔪",
 		Descriptor: "Kitchen Knife",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "dishware",
+		Version:    "0.6",
 	},
 	"1F52B": Emoji{
 		Key:        "1F52B",
 		Value:      "🔫",
-		Descriptor: "Pistol",
+		Descriptor: "Water Pistol",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "0.6",
 	},
 	"1F52C": Emoji{
 		Key:        "1F52C",
 		Value:      "🔬",
 		Descriptor: "Microscope",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "science",
+		Version:    "1.0",
 	},
 	"1F52D": Emoji{
 		Key:        "1F52D",
 		Value:      "🔭",
 		Descriptor: "Telescope",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "science",
+		Version:    "1.0",
 	},
 	"1F52E": Emoji{
 		Key:        "1F52E",
 		Value:      "🔮",
 		Descriptor: "Crystal Ball",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "0.6",
 	},
 	"1F52F": Emoji{
 		Key:        "1F52F",
 		Value:      "🔯",
 		Descriptor: "Dotted Six-Pointed Star",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "religion",
+		Version:    "0.6",
 	},
 	"1F530": Emoji{
 		Key:        "1F530",
 		Value:      "🔰",
 		Descriptor: "Japanese Symbol for Beginner",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "other-symbol",
+		Version:    "0.6",
 	},
 	"1F531": Emoji{
 		Key:        "1F531",
 		Value:      "🔱",
 		Descriptor: "Trident Emblem",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "other-symbol",
+		Version:    "0.6",
 	},
 	"1F532": Emoji{
 		Key:        "1F532",
 		Value:      "🔲",
 		Descriptor: "Black Square Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "0.6",
 	},
 	"1F533": Emoji{
 		Key:        "1F533",
 		Value:      "🔳",
 		Descriptor: "White Square Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "0.6",
 	},
 	"1F534": Emoji{
 		Key:        "1F534",
 		Value:      "🔴",
 		Descriptor: "Red Circle",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "0.6",
 	},
 	"1F535": Emoji{
 		Key:        "1F535",
 		Value:      "🔵",
 		Descriptor: "Blue Circle",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "0.6",
 	},
 	"1F536": Emoji{
 		Key:        "1F536",
 		Value:      "🔶",
 		Descriptor: "Large Orange Diamond",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "0.6",
 	},
 	"1F537": Emoji{
 		Key:        "1F537",
 		Value:      "🔷",
 		Descriptor: "Large Blue Diamond",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "0.6",
 	},
 	"1F538": Emoji{
 		Key:        "1F538",
 		Value:      "🔸",
 		Descriptor: "Small Orange Diamond",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "0.6",
 	},
 	"1F539": Emoji{
 		Key:        "1F539",
 		Value:      "🔹",
 		Descriptor: "Small Blue Diamond",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "0.6",
 	},
 	"1F53A": Emoji{
 		Key:        "1F53A",
 		Value:      "🔺",
 		Descriptor: "Red Triangle Pointed Up",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "0.6",
 	},
 	"1F53B": Emoji{
 		Key:        "1F53B",
 		Value:      "🔻",
 		Descriptor: "Red Triangle Pointed Down",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "0.6",
 	},
 	"1F53C": Emoji{
 		Key:        "1F53C",
 		Value:      "🔼",
 		Descriptor: "Upwards Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "0.6",
 	},
 	"1F53D": Emoji{
 		Key:        "1F53D",
 		Value:      "🔽",
 		Descriptor: "Downwards Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "0.6",
 	},
 	"1F549-FE0F": Emoji{
 		Key:        "1F549-FE0F",
 		Value:      "🕉️",
 		Descriptor: "Om",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "religion",
+		Version:    "0.7",
 	},
 	"1F54A-FE0F": Emoji{
 		Key:        "1F54A-FE0F",
 		Value:      "🕊️",
 		Descriptor: "Dove",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bird",
+		Version:    "0.7",
 	},
 	"1F54B": Emoji{
 		Key:        "1F54B",
 		Value:      "🕋",
 		Descriptor: "Kaaba",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-religious",
+		Version:    "1.0",
 	},
 	"1F54C": Emoji{
 		Key:        "1F54C",
 		Value:      "🕌",
 		Descriptor: "Mosque",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-religious",
+		Version:    "1.0",
 	},
 	"1F54D": Emoji{
 		Key:        "1F54D",
 		Value:      "🕍",
 		Descriptor: "Synagogue",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-religious",
+		Version:    "1.0",
 	},
 	"1F54E": Emoji{
 		Key:        "1F54E",
 		Value:      "🕎",
 		Descriptor: "Menorah",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "religion",
+		Version:    "1.0",
 	},
 	"1F550": Emoji{
 		Key:        "1F550",
 		Value:      "🕐",
-		Descriptor: "One O’Clock",
+		Descriptor: "One O’clock",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.6",
 	},
 	"1F551": Emoji{
 		Key:        "1F551",
 		Value:      "🕑",
-		Descriptor: "Two O’Clock",
+		Descriptor: "Two O’clock",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.6",
 	},
 	"1F552": Emoji{
 		Key:        "1F552",
 		Value:      "🕒",
-		Descriptor: "Three O’Clock",
+		Descriptor: "Three O’clock",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.6",
 	},
 	"1F553": Emoji{
 		Key:        "1F553",
 		Value:      "🕓",
-		Descriptor: "Four O’Clock",
+		Descriptor: "Four O’clock",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.6",
 	},
 	"1F554": Emoji{
 		Key:        "1F554",
 		Value:      "🕔",
-		Descriptor: "Five O’Clock",
+		Descriptor: "Five O’clock",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.6",
 	},
 	"1F555": Emoji{
 		Key:        "1F555",
 		Value:      "🕕",
-		Descriptor: "Six O’Clock",
+		Descriptor: "Six O’clock",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.6",
 	},
 	"1F556": Emoji{
 		Key:        "1F556",
 		Value:      "🕖",
-		Descriptor: "Seven O’Clock",
+		Descriptor: "Seven O’clock",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.6",
 	},
 	"1F557": Emoji{
 		Key:        "1F557",
 		Value:      "🕗",
-		Descriptor: "Eight O’Clock",
+		Descriptor: "Eight O’clock",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.6",
 	},
 	"1F558": Emoji{
 		Key:        "1F558",
 		Value:      "🕘",
-		Descriptor: "Nine O’Clock",
+		Descriptor: "Nine O’clock",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.6",
 	},
 	"1F559": Emoji{
 		Key:        "1F559",
 		Value:      "🕙",
-		Descriptor: "Ten O’Clock",
+		Descriptor: "Ten O’clock",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.6",
 	},
 	"1F55A": Emoji{
 		Key:        "1F55A",
 		Value:      "🕚",
-		Descriptor: "Eleven O’Clock",
+		Descriptor: "Eleven O’clock",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.6",
 	},
 	"1F55B": Emoji{
 		Key:        "1F55B",
 		Value:      "🕛",
-		Descriptor: "Twelve O’Clock",
+		Descriptor: "Twelve O’clock",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.6",
 	},
 	"1F55C": Emoji{
 		Key:        "1F55C",
 		Value:      "🕜",
 		Descriptor: "One-Thirty",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.7",
 	},
 	"1F55D": Emoji{
 		Key:        "1F55D",
 		Value:      "🕝",
 		Descriptor: "Two-Thirty",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.7",
 	},
 	"1F55E": Emoji{
 		Key:        "1F55E",
 		Value:      "🕞",
 		Descriptor: "Three-Thirty",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.7",
 	},
 	"1F55F": Emoji{
 		Key:        "1F55F",
 		Value:      "🕟",
 		Descriptor: "Four-Thirty",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.7",
 	},
 	"1F560": Emoji{
 		Key:        "1F560",
 		Value:      "🕠",
 		Descriptor: "Five-Thirty",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.7",
 	},
 	"1F561": Emoji{
 		Key:        "1F561",
 		Value:      "🕡",
 		Descriptor: "Six-Thirty",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.7",
 	},
 	"1F562": Emoji{
 		Key:        "1F562",
 		Value:      "🕢",
 		Descriptor: "Seven-Thirty",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.7",
 	},
 	"1F563": Emoji{
 		Key:        "1F563",
 		Value:      "🕣",
 		Descriptor: "Eight-Thirty",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.7",
 	},
 	"1F564": Emoji{
 		Key:        "1F564",
 		Value:      "🕤",
 		Descriptor: "Nine-Thirty",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.7",
 	},
 	"1F565": Emoji{
 		Key:        "1F565",
 		Value:      "🕥",
 		Descriptor: "Ten-Thirty",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.7",
 	},
 	"1F566": Emoji{
 		Key:        "1F566",
 		Value:      "🕦",
 		Descriptor: "Eleven-Thirty",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.7",
 	},
 	"1F567": Emoji{
 		Key:        "1F567",
 		Value:      "🕧",
 		Descriptor: "Twelve-Thirty",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.7",
 	},
 	"1F56F-FE0F": Emoji{
 		Key:        "1F56F-FE0F",
 		Value:      "🕯️",
 		Descriptor: "Candle",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "light & video",
+		Version:    "0.7",
 	},
 	"1F570-FE0F": Emoji{
 		Key:        "1F570-FE0F",
 		Value:      "🕰️",
 		Descriptor: "Mantelpiece Clock",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.7",
 	},
 	"1F573-FE0F": Emoji{
 		Key:        "1F573-FE0F",
 		Value:      "🕳️",
 		Descriptor: "Hole",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "emotion",
+		Version:    "0.7",
 	},
 	"1F574-1F3FB": Emoji{
 		Key:        "1F574-1F3FB",
 		Value:      "🕴🏻",
-		Descriptor: "Man in Suit Levitating: Light Skin Tone",
+		Descriptor: "Person in Suit Levitating: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F574-1F3FC": Emoji{
 		Key:        "1F574-1F3FC",
 		Value:      "🕴🏼",
-		Descriptor: "Man in Suit Levitating: Medium-Light Skin Tone",
+		Descriptor: "Person in Suit Levitating: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F574-1F3FD": Emoji{
 		Key:        "1F574-1F3FD",
 		Value:      "🕴🏽",
-		Descriptor: "Man in Suit Levitating: Medium Skin Tone",
+		Descriptor: "Person in Suit Levitating: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F574-1F3FE": Emoji{
 		Key:        "1F574-1F3FE",
 		Value:      "🕴🏾",
-		Descriptor: "Man in Suit Levitating: Medium-Dark Skin Tone",
+		Descriptor: "Person in Suit Levitating: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F574-1F3FF": Emoji{
 		Key:        "1F574-1F3FF",
 		Value:      "🕴🏿",
-		Descriptor: "Man in Suit Levitating: Dark Skin Tone",
+		Descriptor: "Person in Suit Levitating: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
 	},
 	"1F574-FE0F": Emoji{
 		Key:        "1F574-FE0F",
 		Value:      "🕴️",
-		Descriptor: "Man in Suit Levitating",
+		Descriptor: "Person in Suit Levitating",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "0.7",
 	},
 	"1F575-1F3FB": Emoji{
 		Key:        "1F575-1F3FB",
 		Value:      "🕵🏻",
 		Descriptor: "Detective: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "2.0",
 	},
 	"1F575-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F575-1F3FB-200D-2640-FE0F",
 		Value:      "🕵🏻‍♀️",
 		Descriptor: "Woman Detective: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F575-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F575-1F3FB-200D-2642-FE0F",
 		Value:      "🕵🏻‍♂️",
 		Descriptor: "Man Detective: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F575-1F3FC": Emoji{
 		Key:        "1F575-1F3FC",
 		Value:      "🕵🏼",
 		Descriptor: "Detective: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "2.0",
 	},
 	"1F575-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F575-1F3FC-200D-2640-FE0F",
 		Value:      "🕵🏼‍♀️",
 		Descriptor: "Woman Detective: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F575-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F575-1F3FC-200D-2642-FE0F",
 		Value:      "🕵🏼‍♂️",
 		Descriptor: "Man Detective: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F575-1F3FD": Emoji{
 		Key:        "1F575-1F3FD",
 		Value:      "🕵🏽",
 		Descriptor: "Detective: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "2.0",
 	},
 	"1F575-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F575-1F3FD-200D-2640-FE0F",
 		Value:      "🕵🏽‍♀️",
 		Descriptor: "Woman Detective: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F575-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F575-1F3FD-200D-2642-FE0F",
 		Value:      "🕵🏽‍♂️",
 		Descriptor: "Man Detective: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F575-1F3FE": Emoji{
 		Key:        "1F575-1F3FE",
 		Value:      "🕵🏾",
 		Descriptor: "Detective: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "2.0",
 	},
 	"1F575-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F575-1F3FE-200D-2640-FE0F",
 		Value:      "🕵🏾‍♀️",
 		Descriptor: "Woman Detective: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F575-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F575-1F3FE-200D-2642-FE0F",
 		Value:      "🕵🏾‍♂️",
 		Descriptor: "Man Detective: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F575-1F3FF": Emoji{
 		Key:        "1F575-1F3FF",
 		Value:      "🕵🏿",
 		Descriptor: "Detective: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "2.0",
 	},
 	"1F575-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F575-1F3FF-200D-2640-FE0F",
 		Value:      "🕵🏿‍♀️",
 		Descriptor: "Woman Detective: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F575-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F575-1F3FF-200D-2642-FE0F",
 		Value:      "🕵🏿‍♂️",
 		Descriptor: "Man Detective: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F575-FE0F": Emoji{
 		Key:        "1F575-FE0F",
 		Value:      "🕵️",
 		Descriptor: "Detective",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "0.7",
 	},
 	"1F575-FE0F-200D-2640-FE0F": Emoji{
 		Key:        "1F575-FE0F-200D-2640-FE0F",
 		Value:      "🕵️‍♀️",
 		Descriptor: "Woman Detective",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F575-FE0F-200D-2642-FE0F": Emoji{
 		Key:        "1F575-FE0F-200D-2642-FE0F",
 		Value:      "🕵️‍♂️",
 		Descriptor: "Man Detective",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "4.0",
 	},
 	"1F576-FE0F": Emoji{
 		Key:        "1F576-FE0F",
 		Value:      "🕶️",
 		Descriptor: "Sunglasses",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.7",
 	},
 	"1F577-FE0F": Emoji{
 		Key:        "1F577-FE0F",
 		Value:      "🕷️",
 		Descriptor: "Spider",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bug",
+		Version:    "0.7",
 	},
 	"1F578-FE0F": Emoji{
 		Key:        "1F578-FE0F",
 		Value:      "🕸️",
 		Descriptor: "Spider Web",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bug",
+		Version:    "0.7",
 	},
 	"1F579-FE0F": Emoji{
 		Key:        "1F579-FE0F",
 		Value:      "🕹️",
 		Descriptor: "Joystick",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "0.7",
 	},
 	"1F57A": Emoji{
 		Key:        "1F57A",
 		Value:      "🕺",
 		Descriptor: "Man Dancing",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "3.0",
 	},
 	"1F57A-1F3FB": Emoji{
 		Key:        "1F57A-1F3FB",
 		Value:      "🕺🏻",
 		Descriptor: "Man Dancing: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "3.0",
 	},
 	"1F57A-1F3FC": Emoji{
 		Key:        "1F57A-1F3FC",
 		Value:      "🕺🏼",
 		Descriptor: "Man Dancing: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "3.0",
 	},
 	"1F57A-1F3FD": Emoji{
 		Key:        "1F57A-1F3FD",
 		Value:      "🕺🏽",
 		Descriptor: "Man Dancing: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "3.0",
 	},
 	"1F57A-1F3FE": Emoji{
 		Key:        "1F57A-1F3FE",
 		Value:      "🕺🏾",
 		Descriptor: "Man Dancing: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "3.0",
 	},
 	"1F57A-1F3FF": Emoji{
 		Key:        "1F57A-1F3FF",
 		Value:      "🕺🏿",
 		Descriptor: "Man Dancing: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "3.0",
 	},
 	"1F587-FE0F": Emoji{
 		Key:        "1F587-FE0F",
 		Value:      "🖇️",
 		Descriptor: "Linked Paperclips",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "office",
+		Version:    "0.7",
 	},
 	"1F58A-FE0F": Emoji{
 		Key:        "1F58A-FE0F",
 		Value:      "🖊️",
 		Descriptor: "Pen",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "writing",
+		Version:    "0.7",
 	},
 	"1F58B-FE0F": Emoji{
 		Key:        "1F58B-FE0F",
 		Value:      "🖋️",
 		Descriptor: "Fountain Pen",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "writing",
+		Version:    "0.7",
 	},
 	"1F58C-FE0F": Emoji{
 		Key:        "1F58C-FE0F",
 		Value:      "🖌️",
 		Descriptor: "Paintbrush",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "writing",
+		Version:    "0.7",
 	},
 	"1F58D-FE0F": Emoji{
 		Key:        "1F58D-FE0F",
 		Value:      "🖍️",
 		Descriptor: "Crayon",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "writing",
+		Version:    "0.7",
 	},
 	"1F590-1F3FB": Emoji{
 		Key:        "1F590-1F3FB",
 		Value:      "🖐🏻",
 		Descriptor: "Hand With Fingers Splayed: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "1.0",
 	},
 	"1F590-1F3FC": Emoji{
 		Key:        "1F590-1F3FC",
 		Value:      "🖐🏼",
 		Descriptor: "Hand With Fingers Splayed: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "1.0",
 	},
 	"1F590-1F3FD": Emoji{
 		Key:        "1F590-1F3FD",
 		Value:      "🖐🏽",
 		Descriptor: "Hand With Fingers Splayed: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "1.0",
 	},
 	"1F590-1F3FE": Emoji{
 		Key:        "1F590-1F3FE",
 		Value:      "🖐🏾",
 		Descriptor: "Hand With Fingers Splayed: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "1.0",
 	},
 	"1F590-1F3FF": Emoji{
 		Key:        "1F590-1F3FF",
 		Value:      "🖐🏿",
 		Descriptor: "Hand With Fingers Splayed: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "1.0",
 	},
 	"1F590-FE0F": Emoji{
 		Key:        "1F590-FE0F",
 		Value:      "🖐️",
 		Descriptor: "Hand With Fingers Splayed",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "0.7",
 	},
 	"1F595": Emoji{
 		Key:        "1F595",
 		Value:      "🖕",
 		Descriptor: "Middle Finger",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F595-1F3FB": Emoji{
 		Key:        "1F595-1F3FB",
 		Value:      "🖕🏻",
 		Descriptor: "Middle Finger: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F595-1F3FC": Emoji{
 		Key:        "1F595-1F3FC",
 		Value:      "🖕🏼",
 		Descriptor: "Middle Finger: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F595-1F3FD": Emoji{
 		Key:        "1F595-1F3FD",
 		Value:      "🖕🏽",
 		Descriptor: "Middle Finger: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F595-1F3FE": Emoji{
 		Key:        "1F595-1F3FE",
 		Value:      "🖕🏾",
 		Descriptor: "Middle Finger: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F595-1F3FF": Emoji{
 		Key:        "1F595-1F3FF",
 		Value:      "🖕🏿",
 		Descriptor: "Middle Finger: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"1F596": Emoji{
 		Key:        "1F596",
 		Value:      "🖖",
 		Descriptor: "Vulcan Salute",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "1.0",
 	},
 	"1F596-1F3FB": Emoji{
 		Key:        "1F596-1F3FB",
 		Value:      "🖖🏻",
 		Descriptor: "Vulcan Salute: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "1.0",
 	},
 	"1F596-1F3FC": Emoji{
 		Key:        "1F596-1F3FC",
 		Value:      "🖖🏼",
 		Descriptor: "Vulcan Salute: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "1.0",
 	},
 	"1F596-1F3FD": Emoji{
 		Key:        "1F596-1F3FD",
 		Value:      "🖖🏽",
 		Descriptor: "Vulcan Salute: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "1.0",
 	},
 	"1F596-1F3FE": Emoji{
 		Key:        "1F596-1F3FE",
 		Value:      "🖖🏾",
 		Descriptor: "Vulcan Salute: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "1.0",
 	},
 	"1F596-1F3FF": Emoji{
 		Key:        "1F596-1F3FF",
 		Value:      "🖖🏿",
 		Descriptor: "Vulcan Salute: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "1.0",
 	},
 	"1F5A4": Emoji{
 		Key:        "1F5A4",
 		Value:      "🖤",
 		Descriptor: "Black Heart",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "3.0",
 	},
 	"1F5A5-FE0F": Emoji{
 		Key:        "1F5A5-FE0F",
 		Value:      "🖥️",
 		Descriptor: "Desktop Computer",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "computer",
+		Version:    "0.7",
 	},
 	"1F5A8-FE0F": Emoji{
 		Key:        "1F5A8-FE0F",
 		Value:      "🖨️",
 		Descriptor: "Printer",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "computer",
+		Version:    "0.7",
 	},
 	"1F5B1-FE0F": Emoji{
 		Key:        "1F5B1-FE0F",
 		Value:      "🖱️",
 		Descriptor: "Computer Mouse",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "computer",
+		Version:    "0.7",
 	},
 	"1F5B2-FE0F": Emoji{
 		Key:        "1F5B2-FE0F",
 		Value:      "🖲️",
 		Descriptor: "Trackball",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "computer",
+		Version:    "0.7",
 	},
 	"1F5BC-FE0F": Emoji{
 		Key:        "1F5BC-FE0F",
 		Value:      "🖼️",
 		Descriptor: "Framed Picture",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "arts & crafts",
+		Version:    "0.7",
 	},
 	"1F5C2-FE0F": Emoji{
 		Key:        "1F5C2-FE0F",
 		Value:      "🗂️",
 		Descriptor: "Card Index Dividers",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "office",
+		Version:    "0.7",
 	},
 	"1F5C3-FE0F": Emoji{
 		Key:        "1F5C3-FE0F",
 		Value:      "🗃️",
 		Descriptor: "Card File Box",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "office",
+		Version:    "0.7",
 	},
 	"1F5C4-FE0F": Emoji{
 		Key:        "1F5C4-FE0F",
 		Value:      "🗄️",
 		Descriptor: "File Cabinet",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "office",
+		Version:    "0.7",
 	},
 	"1F5D1-FE0F": Emoji{
 		Key:        "1F5D1-FE0F",
 		Value:      "🗑️",
 		Descriptor: "Wastebasket",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "office",
+		Version:    "0.7",
 	},
 	"1F5D2-FE0F": Emoji{
 		Key:        "1F5D2-FE0F",
 		Value:      "🗒️",
 		Descriptor: "Spiral Notepad",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "office",
+		Version:    "0.7",
 	},
 	"1F5D3-FE0F": Emoji{
 		Key:        "1F5D3-FE0F",
 		Value:      "🗓️",
 		Descriptor: "Spiral Calendar",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "office",
+		Version:    "0.7",
 	},
 	"1F5DC-FE0F": Emoji{
 		Key:        "1F5DC-FE0F",
 		Value:      "🗜️",
 		Descriptor: "Clamp",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "0.7",
 	},
 	"1F5DD-FE0F": Emoji{
 		Key:        "1F5DD-FE0F",
 		Value:      "🗝️",
 		Descriptor: "Old Key",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "lock",
+		Version:    "0.7",
 	},
 	"1F5DE-FE0F": Emoji{
 		Key:        "1F5DE-FE0F",
 		Value:      "🗞️",
 		Descriptor: "Rolled-Up Newspaper",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "book-paper",
+		Version:    "0.7",
 	},
 	"1F5E1-FE0F": Emoji{
 		Key:        "1F5E1-FE0F",
 		Value:      "🗡️",
 		Descriptor: "Dagger",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "0.7",
 	},
 	"1F5E3-FE0F": Emoji{
 		Key:        "1F5E3-FE0F",
 		Value:      "🗣️",
 		Descriptor: "Speaking Head",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-symbol",
+		Version:    "0.7",
 	},
 	"1F5E8-FE0F": Emoji{
 		Key:        "1F5E8-FE0F",
 		Value:      "🗨️",
 		Descriptor: "Left Speech Bubble",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "emotion",
+		Version:    "2.0",
 	},
 	"1F5EF-FE0F": Emoji{
 		Key:        "1F5EF-FE0F",
 		Value:      "🗯️",
 		Descriptor: "Right Anger Bubble",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "emotion",
+		Version:    "0.7",
 	},
 	"1F5F3-FE0F": Emoji{
 		Key:        "1F5F3-FE0F",
 		Value:      "🗳️",
 		Descriptor: "Ballot Box With Ballot",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "mail",
+		Version:    "0.7",
 	},
 	"1F5FA-FE0F": Emoji{
 		Key:        "1F5FA-FE0F",
 		Value:      "🗺️",
 		Descriptor: "World Map",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-map",
+		Version:    "0.7",
 	},
 	"1F5FB": Emoji{
 		Key:        "1F5FB",
 		Value:      "🗻",
 		Descriptor: "Mount Fuji",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-geographic",
+		Version:    "0.6",
 	},
 	"1F5FC": Emoji{
 		Key:        "1F5FC",
 		Value:      "🗼",
 		Descriptor: "Tokyo Tower",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "0.6",
 	},
 	"1F5FD": Emoji{
 		Key:        "1F5FD",
 		Value:      "🗽",
 		Descriptor: "Statue of Liberty",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "0.6",
 	},
 	"1F5FE": Emoji{
 		Key:        "1F5FE",
 		Value:      "🗾",
 		Descriptor: "Map of Japan",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-map",
+		Version:    "0.6",
 	},
 	"1F5FF": Emoji{
 		Key:        "1F5FF",
 		Value:      "🗿",
 		Descriptor: "Moai",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "other-object",
+		Version:    "0.6",
 	},
 	"1F600": Emoji{
 		Key:        "1F600",
 		Value:      "😀",
 		Descriptor: "Grinning Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-smiling",
+		Version:    "1.0",
 	},
 	"1F601": Emoji{
 		Key:        "1F601",
 		Value:      "😁",
 		Descriptor: "Beaming Face With Smiling Eyes",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-smiling",
+		Version:    "0.6",
 	},
 	"1F602": Emoji{
 		Key:        "1F602",
 		Value:      "😂",
 		Descriptor: "Face With Tears of Joy",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-smiling",
+		Version:    "0.6",
 	},
 	"1F603": Emoji{
 		Key:        "1F603",
 		Value:      "😃",
 		Descriptor: "Grinning Face With Big Eyes",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-smiling",
+		Version:    "0.6",
 	},
 	"1F604": Emoji{
 		Key:        "1F604",
 		Value:      "😄",
 		Descriptor: "Grinning Face With Smiling Eyes",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-smiling",
+		Version:    "0.6",
 	},
 	"1F605": Emoji{
 		Key:        "1F605",
 		Value:      "😅",
 		Descriptor: "Grinning Face With Sweat",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-smiling",
+		Version:    "0.6",
 	},
 	"1F606": Emoji{
 		Key:        "1F606",
 		Value:      "😆",
 		Descriptor: "Grinning Squinting Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-smiling",
+		Version:    "0.6",
 	},
 	"1F607": Emoji{
 		Key:        "1F607",
 		Value:      "😇",
 		Descriptor: "Smiling Face With Halo",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-smiling",
+		Version:    "1.0",
 	},
 	"1F608": Emoji{
 		Key:        "1F608",
 		Value:      "😈",
 		Descriptor: "Smiling Face With Horns",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-negative",
+		Version:    "1.0",
 	},
 	"1F609": Emoji{
 		Key:        "1F609",
 		Value:      "😉",
 		Descriptor: "Winking Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-smiling",
+		Version:    "0.6",
 	},
 	"1F60A": Emoji{
 		Key:        "1F60A",
 		Value:      "😊",
 		Descriptor: "Smiling Face With Smiling Eyes",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-smiling",
+		Version:    "0.6",
 	},
 	"1F60B": Emoji{
 		Key:        "1F60B",
 		Value:      "😋",
 		Descriptor: "Face Savoring Food",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-tongue",
+		Version:    "0.6",
 	},
 	"1F60C": Emoji{
 		Key:        "1F60C",
 		Value:      "😌",
 		Descriptor: "Relieved Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-sleepy",
+		Version:    "0.6",
 	},
 	"1F60D": Emoji{
 		Key:        "1F60D",
 		Value:      "😍",
 		Descriptor: "Smiling Face With Heart-Eyes",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-affection",
+		Version:    "0.6",
 	},
 	"1F60E": Emoji{
 		Key:        "1F60E",
 		Value:      "😎",
 		Descriptor: "Smiling Face With Sunglasses",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-glasses",
+		Version:    "1.0",
 	},
 	"1F60F": Emoji{
 		Key:        "1F60F",
 		Value:      "😏",
 		Descriptor: "Smirking Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-neutral-skeptical",
+		Version:    "0.6",
 	},
 	"1F610": Emoji{
 		Key:        "1F610",
 		Value:      "😐",
 		Descriptor: "Neutral Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-neutral-skeptical",
+		Version:    "0.7",
 	},
 	"1F611": Emoji{
 		Key:        "1F611",
 		Value:      "😑",
 		Descriptor: "Expressionless Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-neutral-skeptical",
+		Version:    "1.0",
 	},
 	"1F612": Emoji{
 		Key:        "1F612",
 		Value:      "😒",
 		Descriptor: "Unamused Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-neutral-skeptical",
+		Version:    "0.6",
 	},
 	"1F613": Emoji{
 		Key:        "1F613",
 		Value:      "😓",
 		Descriptor: "Downcast Face With Sweat",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "0.6",
 	},
 	"1F614": Emoji{
 		Key:        "1F614",
 		Value:      "😔",
 		Descriptor: "Pensive Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-sleepy",
+		Version:    "0.6",
 	},
 	"1F615": Emoji{
 		Key:        "1F615",
 		Value:      "😕",
 		Descriptor: "Confused Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "1.0",
 	},
 	"1F616": Emoji{
 		Key:        "1F616",
 		Value:      "😖",
 		Descriptor: "Confounded Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "0.6",
 	},
 	"1F617": Emoji{
 		Key:        "1F617",
 		Value:      "😗",
 		Descriptor: "Kissing Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-affection",
+		Version:    "1.0",
 	},
 	"1F618": Emoji{
 		Key:        "1F618",
 		Value:      "😘",
 		Descriptor: "Face Blowing a Kiss",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-affection",
+		Version:    "0.6",
 	},
 	"1F619": Emoji{
 		Key:        "1F619",
 		Value:      "😙",
 		Descriptor: "Kissing Face With Smiling Eyes",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-affection",
+		Version:    "1.0",
 	},
 	"1F61A": Emoji{
 		Key:        "1F61A",
 		Value:      "😚",
 		Descriptor: "Kissing Face With Closed Eyes",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-affection",
+		Version:    "0.6",
 	},
 	"1F61B": Emoji{
 		Key:        "1F61B",
 		Value:      "😛",
 		Descriptor: "Face With Tongue",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-tongue",
+		Version:    "1.0",
 	},
 	"1F61C": Emoji{
 		Key:        "1F61C",
 		Value:      "😜",
 		Descriptor: "Winking Face With Tongue",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-tongue",
+		Version:    "0.6",
 	},
 	"1F61D": Emoji{
 		Key:        "1F61D",
 		Value:      "😝",
 		Descriptor: "Squinting Face With Tongue",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-tongue",
+		Version:    "0.6",
 	},
 	"1F61E": Emoji{
 		Key:        "1F61E",
 		Value:      "😞",
 		Descriptor: "Disappointed Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "0.6",
 	},
 	"1F61F": Emoji{
 		Key:        "1F61F",
 		Value:      "😟",
 		Descriptor: "Worried Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "1.0",
 	},
 	"1F620": Emoji{
 		Key:        "1F620",
 		Value:      "😠",
 		Descriptor: "Angry Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-negative",
+		Version:    "0.6",
 	},
 	"1F621": Emoji{
 		Key:        "1F621",
 		Value:      "😡",
-		Descriptor: "Pouting Face",
+		Descriptor: "Enraged Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-negative",
+		Version:    "0.6",
 	},
 	"1F622": Emoji{
 		Key:        "1F622",
 		Value:      "😢",
 		Descriptor: "Crying Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "0.6",
 	},
 	"1F623": Emoji{
 		Key:        "1F623",
 		Value:      "😣",
 		Descriptor: "Persevering Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "0.6",
 	},
 	"1F624": Emoji{
 		Key:        "1F624",
 		Value:      "😤",
-		Descriptor: "Face With Steam From Nose",
+		Descriptor: "Face With Steam from Nose",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-negative",
+		Version:    "0.6",
 	},
 	"1F625": Emoji{
 		Key:        "1F625",
 		Value:      "😥",
-		Descriptor: "Sad but Relieved Face",
+		Descriptor: "Sad But Relieved Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "0.6",
 	},
 	"1F626": Emoji{
 		Key:        "1F626",
 		Value:      "😦",
 		Descriptor: "Frowning Face With Open Mouth",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "1.0",
 	},
 	"1F627": Emoji{
 		Key:        "1F627",
 		Value:      "😧",
 		Descriptor: "Anguished Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "1.0",
 	},
 	"1F628": Emoji{
 		Key:        "1F628",
 		Value:      "😨",
 		Descriptor: "Fearful Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "0.6",
 	},
 	"1F629": Emoji{
 		Key:        "1F629",
 		Value:      "😩",
 		Descriptor: "Weary Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "0.6",
 	},
 	"1F62A": Emoji{
 		Key:        "1F62A",
 		Value:      "😪",
 		Descriptor: "Sleepy Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-sleepy",
+		Version:    "0.6",
 	},
 	"1F62B": Emoji{
 		Key:        "1F62B",
 		Value:      "😫",
 		Descriptor: "Tired Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "0.6",
 	},
 	"1F62C": Emoji{
 		Key:        "1F62C",
 		Value:      "😬",
 		Descriptor: "Grimacing Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-neutral-skeptical",
+		Version:    "1.0",
 	},
 	"1F62D": Emoji{
 		Key:        "1F62D",
 		Value:      "😭",
 		Descriptor: "Loudly Crying Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "0.6",
 	},
 	"1F62E": Emoji{
 		Key:        "1F62E",
 		Value:      "😮",
 		Descriptor: "Face With Open Mouth",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "1.0",
+	},
+	"1F62E-200D-1F4A8": Emoji{
+		Key:        "1F62E-200D-1F4A8",
+		Value:      "😮‍💨",
+		Descriptor: "Face Exhaling",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-neutral-skeptical",
+		Version:    "13.1",
 	},
 	"1F62F": Emoji{
 		Key:        "1F62F",
 		Value:      "😯",
 		Descriptor: "Hushed Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "1.0",
 	},
 	"1F630": Emoji{
 		Key:        "1F630",
 		Value:      "😰",
 		Descriptor: "Anxious Face With Sweat",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "0.6",
 	},
 	"1F631": Emoji{
 		Key:        "1F631",
 		Value:      "😱",
 		Descriptor: "Face Screaming in Fear",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "0.6",
 	},
 	"1F632": Emoji{
 		Key:        "1F632",
 		Value:      "😲",
 		Descriptor: "Astonished Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "0.6",
 	},
 	"1F633": Emoji{
 		Key:        "1F633",
 		Value:      "😳",
 		Descriptor: "Flushed Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "0.6",
 	},
 	"1F634": Emoji{
 		Key:        "1F634",
 		Value:      "😴",
 		Descriptor: "Sleeping Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-sleepy",
+		Version:    "1.0",
 	},
 	"1F635": Emoji{
 		Key:        "1F635",
 		Value:      "😵",
-		Descriptor: "Dizzy Face",
+		Descriptor: "Face With Crossed-Out Eyes",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-unwell",
+		Version:    "0.6",
+	},
+	"1F635-200D-1F4AB": Emoji{
+		Key:        "1F635-200D-1F4AB",
+		Value:      "😵‍💫",
+		Descriptor: "Face With Spiral Eyes",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-unwell",
+		Version:    "13.1",
 	},
 	"1F636": Emoji{
 		Key:        "1F636",
 		Value:      "😶",
 		Descriptor: "Face Without Mouth",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-neutral-skeptical",
+		Version:    "1.0",
+	},
+	"1F636-200D-1F32B-FE0F": Emoji{
+		Key:        "1F636-200D-1F32B-FE0F",
+		Value:      "😶‍🌫️",
+		Descriptor: "Face in Clouds",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-neutral-skeptical",
+		Version:    "13.1",
 	},
 	"1F637": Emoji{
 		Key:        "1F637",
 		Value:      "😷",
 		Descriptor: "Face With Medical Mask",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-unwell",
+		Version:    "0.6",
 	},
 	"1F638": Emoji{
 		Key:        "1F638",
 		Value:      "😸",
-		Descriptor: "Grinning Cat Face With Smiling Eyes",
+		Descriptor: "Grinning Cat With Smiling Eyes",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "cat-face",
+		Version:    "0.6",
 	},
 	"1F639": Emoji{
 		Key:        "1F639",
 		Value:      "😹",
-		Descriptor: "Cat Face With Tears of Joy",
+		Descriptor: "Cat With Tears of Joy",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "cat-face",
+		Version:    "0.6",
 	},
 	"1F63A": Emoji{
 		Key:        "1F63A",
 		Value:      "😺",
-		Descriptor: "Grinning Cat Face",
+		Descriptor: "Grinning Cat",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "cat-face",
+		Version:    "0.6",
 	},
 	"1F63B": Emoji{
 		Key:        "1F63B",
 		Value:      "😻",
-		Descriptor: "Smiling Cat Face With Heart-Eyes",
+		Descriptor: "Smiling Cat With Heart-Eyes",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "cat-face",
+		Version:    "0.6",
 	},
 	"1F63C": Emoji{
 		Key:        "1F63C",
 		Value:      "😼",
-		Descriptor: "Cat Face With Wry Smile",
+		Descriptor: "Cat With Wry Smile",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "cat-face",
+		Version:    "0.6",
 	},
 	"1F63D": Emoji{
 		Key:        "1F63D",
 		Value:      "😽",
-		Descriptor: "Kissing Cat Face",
+		Descriptor: "Kissing Cat",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "cat-face",
+		Version:    "0.6",
 	},
 	"1F63E": Emoji{
 		Key:        "1F63E",
 		Value:      "😾",
-		Descriptor: "Pouting Cat Face",
+		Descriptor: "Pouting Cat",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "cat-face",
+		Version:    "0.6",
 	},
 	"1F63F": Emoji{
 		Key:        "1F63F",
 		Value:      "😿",
-		Descriptor: "Crying Cat Face",
+		Descriptor: "Crying Cat",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "cat-face",
+		Version:    "0.6",
 	},
 	"1F640": Emoji{
 		Key:        "1F640",
 		Value:      "🙀",
-		Descriptor: "Weary Cat Face",
+		Descriptor: "Weary Cat",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "cat-face",
+		Version:    "0.6",
 	},
 	"1F641": Emoji{
 		Key:        "1F641",
 		Value:      "🙁",
 		Descriptor: "Slightly Frowning Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "1.0",
 	},
 	"1F642": Emoji{
 		Key:        "1F642",
 		Value:      "🙂",
 		Descriptor: "Slightly Smiling Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-smiling",
+		Version:    "1.0",
+	},
+	"1F642-200D-2194-FE0F": Emoji{
+		Key:        "1F642-200D-2194-FE0F",
+		Value:      "🙂‍↔️",
+		Descriptor: "Head Shaking Horizontally",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-neutral-skeptical",
+		Version:    "15.1",
+	},
+	"1F642-200D-2195-FE0F": Emoji{
+		Key:        "1F642-200D-2195-FE0F",
+		Value:      "🙂‍↕️",
+		Descriptor: "Head Shaking Vertically",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-neutral-skeptical",
+		Version:    "15.1",
 	},
 	"1F643": Emoji{
 		Key:        "1F643",
 		Value:      "🙃",
 		Descriptor: "Upside-Down Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-smiling",
+		Version:    "1.0",
 	},
 	"1F644": Emoji{
 		Key:        "1F644",
 		Value:      "🙄",
 		Descriptor: "Face With Rolling Eyes",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-neutral-skeptical",
+		Version:    "1.0",
 	},
 	"1F645": Emoji{
 		Key:        "1F645",
 		Value:      "🙅",
-		Descriptor: "Person Gesturing No",
+		Descriptor: "Person Gesturing NO",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "0.6",
 	},
 	"1F645-1F3FB": Emoji{
 		Key:        "1F645-1F3FB",
 		Value:      "🙅🏻",
-		Descriptor: "Person Gesturing No: Light Skin Tone",
+		Descriptor: "Person Gesturing NO: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F645-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F645-1F3FB-200D-2640-FE0F",
 		Value:      "🙅🏻‍♀️",
-		Descriptor: "Woman Gesturing No: Light Skin Tone",
+		Descriptor: "Woman Gesturing NO: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F645-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F645-1F3FB-200D-2642-FE0F",
 		Value:      "🙅🏻‍♂️",
-		Descriptor: "Man Gesturing No: Light Skin Tone",
+		Descriptor: "Man Gesturing NO: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F645-1F3FC": Emoji{
 		Key:        "1F645-1F3FC",
 		Value:      "🙅🏼",
-		Descriptor: "Person Gesturing No: Medium-Light Skin Tone",
+		Descriptor: "Person Gesturing NO: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F645-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F645-1F3FC-200D-2640-FE0F",
 		Value:      "🙅🏼‍♀️",
-		Descriptor: "Woman Gesturing No: Medium-Light Skin Tone",
+		Descriptor: "Woman Gesturing NO: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F645-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F645-1F3FC-200D-2642-FE0F",
 		Value:      "🙅🏼‍♂️",
-		Descriptor: "Man Gesturing No: Medium-Light Skin Tone",
+		Descriptor: "Man Gesturing NO: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F645-1F3FD": Emoji{
 		Key:        "1F645-1F3FD",
 		Value:      "🙅🏽",
-		Descriptor: "Person Gesturing No: Medium Skin Tone",
+		Descriptor: "Person Gesturing NO: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F645-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F645-1F3FD-200D-2640-FE0F",
 		Value:      "🙅🏽‍♀️",
-		Descriptor: "Woman Gesturing No: Medium Skin Tone",
+		Descriptor: "Woman Gesturing NO: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F645-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F645-1F3FD-200D-2642-FE0F",
 		Value:      "🙅🏽‍♂️",
-		Descriptor: "Man Gesturing No: Medium Skin Tone",
+		Descriptor: "Man Gesturing NO: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F645-1F3FE": Emoji{
 		Key:        "1F645-1F3FE",
 		Value:      "🙅🏾",
-		Descriptor: "Person Gesturing No: Medium-Dark Skin Tone",
+		Descriptor: "Person Gesturing NO: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F645-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F645-1F3FE-200D-2640-FE0F",
 		Value:      "🙅🏾‍♀️",
-		Descriptor: "Woman Gesturing No: Medium-Dark Skin Tone",
+		Descriptor: "Woman Gesturing NO: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F645-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F645-1F3FE-200D-2642-FE0F",
 		Value:      "🙅🏾‍♂️",
-		Descriptor: "Man Gesturing No: Medium-Dark Skin Tone",
+		Descriptor: "Man Gesturing NO: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F645-1F3FF": Emoji{
 		Key:        "1F645-1F3FF",
 		Value:      "🙅🏿",
-		Descriptor: "Person Gesturing No: Dark Skin Tone",
+		Descriptor: "Person Gesturing NO: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F645-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F645-1F3FF-200D-2640-FE0F",
 		Value:      "🙅🏿‍♀️",
-		Descriptor: "Woman Gesturing No: Dark Skin Tone",
+		Descriptor: "Woman Gesturing NO: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F645-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F645-1F3FF-200D-2642-FE0F",
 		Value:      "🙅🏿‍♂️",
-		Descriptor: "Man Gesturing No: Dark Skin Tone",
+		Descriptor: "Man Gesturing NO: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F645-200D-2640-FE0F": Emoji{
 		Key:        "1F645-200D-2640-FE0F",
 		Value:      "🙅‍♀️",
-		Descriptor: "Woman Gesturing No",
+		Descriptor: "Woman Gesturing NO",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F645-200D-2642-FE0F": Emoji{
 		Key:        "1F645-200D-2642-FE0F",
 		Value:      "🙅‍♂️",
-		Descriptor: "Man Gesturing No",
+		Descriptor: "Man Gesturing NO",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F646": Emoji{
 		Key:        "1F646",
 		Value:      "🙆",
 		Descriptor: "Person Gesturing OK",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "0.6",
 	},
 	"1F646-1F3FB": Emoji{
 		Key:        "1F646-1F3FB",
 		Value:      "🙆🏻",
 		Descriptor: "Person Gesturing OK: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F646-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F646-1F3FB-200D-2640-FE0F",
 		Value:      "🙆🏻‍♀️",
 		Descriptor: "Woman Gesturing OK: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F646-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F646-1F3FB-200D-2642-FE0F",
 		Value:      "🙆🏻‍♂️",
 		Descriptor: "Man Gesturing OK: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F646-1F3FC": Emoji{
 		Key:        "1F646-1F3FC",
 		Value:      "🙆🏼",
 		Descriptor: "Person Gesturing OK: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F646-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F646-1F3FC-200D-2640-FE0F",
 		Value:      "🙆🏼‍♀️",
 		Descriptor: "Woman Gesturing OK: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F646-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F646-1F3FC-200D-2642-FE0F",
 		Value:      "🙆🏼‍♂️",
 		Descriptor: "Man Gesturing OK: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F646-1F3FD": Emoji{
 		Key:        "1F646-1F3FD",
 		Value:      "🙆🏽",
 		Descriptor: "Person Gesturing OK: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F646-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F646-1F3FD-200D-2640-FE0F",
 		Value:      "🙆🏽‍♀️",
 		Descriptor: "Woman Gesturing OK: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F646-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F646-1F3FD-200D-2642-FE0F",
 		Value:      "🙆🏽‍♂️",
 		Descriptor: "Man Gesturing OK: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F646-1F3FE": Emoji{
 		Key:        "1F646-1F3FE",
 		Value:      "🙆🏾",
 		Descriptor: "Person Gesturing OK: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F646-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F646-1F3FE-200D-2640-FE0F",
 		Value:      "🙆🏾‍♀️",
 		Descriptor: "Woman Gesturing OK: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F646-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F646-1F3FE-200D-2642-FE0F",
 		Value:      "🙆🏾‍♂️",
 		Descriptor: "Man Gesturing OK: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F646-1F3FF": Emoji{
 		Key:        "1F646-1F3FF",
 		Value:      "🙆🏿",
 		Descriptor: "Person Gesturing OK: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F646-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F646-1F3FF-200D-2640-FE0F",
 		Value:      "🙆🏿‍♀️",
 		Descriptor: "Woman Gesturing OK: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F646-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F646-1F3FF-200D-2642-FE0F",
 		Value:      "🙆🏿‍♂️",
 		Descriptor: "Man Gesturing OK: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F646-200D-2640-FE0F": Emoji{
 		Key:        "1F646-200D-2640-FE0F",
 		Value:      "🙆‍♀️",
 		Descriptor: "Woman Gesturing OK",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F646-200D-2642-FE0F": Emoji{
 		Key:        "1F646-200D-2642-FE0F",
 		Value:      "🙆‍♂️",
 		Descriptor: "Man Gesturing OK",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F647": Emoji{
 		Key:        "1F647",
 		Value:      "🙇",
 		Descriptor: "Person Bowing",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "0.6",
 	},
 	"1F647-1F3FB": Emoji{
 		Key:        "1F647-1F3FB",
 		Value:      "🙇🏻",
 		Descriptor: "Person Bowing: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F647-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F647-1F3FB-200D-2640-FE0F",
 		Value:      "🙇🏻‍♀️",
 		Descriptor: "Woman Bowing: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F647-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F647-1F3FB-200D-2642-FE0F",
 		Value:      "🙇🏻‍♂️",
 		Descriptor: "Man Bowing: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F647-1F3FC": Emoji{
 		Key:        "1F647-1F3FC",
 		Value:      "🙇🏼",
 		Descriptor: "Person Bowing: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F647-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F647-1F3FC-200D-2640-FE0F",
 		Value:      "🙇🏼‍♀️",
 		Descriptor: "Woman Bowing: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F647-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F647-1F3FC-200D-2642-FE0F",
 		Value:      "🙇🏼‍♂️",
 		Descriptor: "Man Bowing: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F647-1F3FD": Emoji{
 		Key:        "1F647-1F3FD",
 		Value:      "🙇🏽",
 		Descriptor: "Person Bowing: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F647-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F647-1F3FD-200D-2640-FE0F",
 		Value:      "🙇🏽‍♀️",
 		Descriptor: "Woman Bowing: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F647-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F647-1F3FD-200D-2642-FE0F",
 		Value:      "🙇🏽‍♂️",
 		Descriptor: "Man Bowing: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F647-1F3FE": Emoji{
 		Key:        "1F647-1F3FE",
 		Value:      "🙇🏾",
 		Descriptor: "Person Bowing: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F647-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F647-1F3FE-200D-2640-FE0F",
 		Value:      "🙇🏾‍♀️",
 		Descriptor: "Woman Bowing: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F647-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F647-1F3FE-200D-2642-FE0F",
 		Value:      "🙇🏾‍♂️",
 		Descriptor: "Man Bowing: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F647-1F3FF": Emoji{
 		Key:        "1F647-1F3FF",
 		Value:      "🙇🏿",
 		Descriptor: "Person Bowing: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F647-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F647-1F3FF-200D-2640-FE0F",
 		Value:      "🙇🏿‍♀️",
 		Descriptor: "Woman Bowing: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F647-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F647-1F3FF-200D-2642-FE0F",
 		Value:      "🙇🏿‍♂️",
 		Descriptor: "Man Bowing: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F647-200D-2640-FE0F": Emoji{
 		Key:        "1F647-200D-2640-FE0F",
 		Value:      "🙇‍♀️",
 		Descriptor: "Woman Bowing",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F647-200D-2642-FE0F": Emoji{
 		Key:        "1F647-200D-2642-FE0F",
 		Value:      "🙇‍♂️",
 		Descriptor: "Man Bowing",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F648": Emoji{
 		Key:        "1F648",
 		Value:      "🙈",
 		Descriptor: "See-No-Evil Monkey",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "monkey-face",
+		Version:    "0.6",
 	},
 	"1F649": Emoji{
 		Key:        "1F649",
 		Value:      "🙉",
 		Descriptor: "Hear-No-Evil Monkey",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "monkey-face",
+		Version:    "0.6",
 	},
 	"1F64A": Emoji{
 		Key:        "1F64A",
 		Value:      "🙊",
 		Descriptor: "Speak-No-Evil Monkey",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "monkey-face",
+		Version:    "0.6",
 	},
 	"1F64B": Emoji{
 		Key:        "1F64B",
 		Value:      "🙋",
 		Descriptor: "Person Raising Hand",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "0.6",
 	},
 	"1F64B-1F3FB": Emoji{
 		Key:        "1F64B-1F3FB",
 		Value:      "🙋🏻",
 		Descriptor: "Person Raising Hand: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F64B-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F64B-1F3FB-200D-2640-FE0F",
 		Value:      "🙋🏻‍♀️",
 		Descriptor: "Woman Raising Hand: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64B-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F64B-1F3FB-200D-2642-FE0F",
 		Value:      "🙋🏻‍♂️",
 		Descriptor: "Man Raising Hand: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64B-1F3FC": Emoji{
 		Key:        "1F64B-1F3FC",
 		Value:      "🙋🏼",
 		Descriptor: "Person Raising Hand: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F64B-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F64B-1F3FC-200D-2640-FE0F",
 		Value:      "🙋🏼‍♀️",
 		Descriptor: "Woman Raising Hand: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64B-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F64B-1F3FC-200D-2642-FE0F",
 		Value:      "🙋🏼‍♂️",
 		Descriptor: "Man Raising Hand: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64B-1F3FD": Emoji{
 		Key:        "1F64B-1F3FD",
 		Value:      "🙋🏽",
 		Descriptor: "Person Raising Hand: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F64B-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F64B-1F3FD-200D-2640-FE0F",
 		Value:      "🙋🏽‍♀️",
 		Descriptor: "Woman Raising Hand: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64B-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F64B-1F3FD-200D-2642-FE0F",
 		Value:      "🙋🏽‍♂️",
 		Descriptor: "Man Raising Hand: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64B-1F3FE": Emoji{
 		Key:        "1F64B-1F3FE",
 		Value:      "🙋🏾",
 		Descriptor: "Person Raising Hand: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F64B-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F64B-1F3FE-200D-2640-FE0F",
 		Value:      "🙋🏾‍♀️",
 		Descriptor: "Woman Raising Hand: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64B-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F64B-1F3FE-200D-2642-FE0F",
 		Value:      "🙋🏾‍♂️",
 		Descriptor: "Man Raising Hand: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64B-1F3FF": Emoji{
 		Key:        "1F64B-1F3FF",
 		Value:      "🙋🏿",
 		Descriptor: "Person Raising Hand: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F64B-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F64B-1F3FF-200D-2640-FE0F",
 		Value:      "🙋🏿‍♀️",
 		Descriptor: "Woman Raising Hand: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64B-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F64B-1F3FF-200D-2642-FE0F",
 		Value:      "🙋🏿‍♂️",
 		Descriptor: "Man Raising Hand: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64B-200D-2640-FE0F": Emoji{
 		Key:        "1F64B-200D-2640-FE0F",
 		Value:      "🙋‍♀️",
 		Descriptor: "Woman Raising Hand",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64B-200D-2642-FE0F": Emoji{
 		Key:        "1F64B-200D-2642-FE0F",
 		Value:      "🙋‍♂️",
 		Descriptor: "Man Raising Hand",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64C": Emoji{
 		Key:        "1F64C",
 		Value:      "🙌",
 		Descriptor: "Raising Hands",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "0.6",
 	},
 	"1F64C-1F3FB": Emoji{
 		Key:        "1F64C-1F3FB",
 		Value:      "🙌🏻",
 		Descriptor: "Raising Hands: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "1.0",
 	},
 	"1F64C-1F3FC": Emoji{
 		Key:        "1F64C-1F3FC",
 		Value:      "🙌🏼",
 		Descriptor: "Raising Hands: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "1.0",
 	},
 	"1F64C-1F3FD": Emoji{
 		Key:        "1F64C-1F3FD",
 		Value:      "🙌🏽",
 		Descriptor: "Raising Hands: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "1.0",
 	},
 	"1F64C-1F3FE": Emoji{
 		Key:        "1F64C-1F3FE",
 		Value:      "🙌🏾",
 		Descriptor: "Raising Hands: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "1.0",
 	},
 	"1F64C-1F3FF": Emoji{
 		Key:        "1F64C-1F3FF",
 		Value:      "🙌🏿",
 		Descriptor: "Raising Hands: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "1.0",
 	},
 	"1F64D": Emoji{
 		Key:        "1F64D",
 		Value:      "🙍",
 		Descriptor: "Person Frowning",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "0.6",
 	},
 	"1F64D-1F3FB": Emoji{
 		Key:        "1F64D-1F3FB",
 		Value:      "🙍🏻",
 		Descriptor: "Person Frowning: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F64D-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F64D-1F3FB-200D-2640-FE0F",
 		Value:      "🙍🏻‍♀️",
 		Descriptor: "Woman Frowning: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64D-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F64D-1F3FB-200D-2642-FE0F",
 		Value:      "🙍🏻‍♂️",
 		Descriptor: "Man Frowning: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64D-1F3FC": Emoji{
 		Key:        "1F64D-1F3FC",
 		Value:      "🙍🏼",
 		Descriptor: "Person Frowning: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F64D-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F64D-1F3FC-200D-2640-FE0F",
 		Value:      "🙍🏼‍♀️",
 		Descriptor: "Woman Frowning: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64D-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F64D-1F3FC-200D-2642-FE0F",
 		Value:      "🙍🏼‍♂️",
 		Descriptor: "Man Frowning: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64D-1F3FD": Emoji{
 		Key:        "1F64D-1F3FD",
 		Value:      "🙍🏽",
 		Descriptor: "Person Frowning: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F64D-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F64D-1F3FD-200D-2640-FE0F",
 		Value:      "🙍🏽‍♀️",
 		Descriptor: "Woman Frowning: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64D-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F64D-1F3FD-200D-2642-FE0F",
 		Value:      "🙍🏽‍♂️",
 		Descriptor: "Man Frowning: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64D-1F3FE": Emoji{
 		Key:        "1F64D-1F3FE",
 		Value:      "🙍🏾",
 		Descriptor: "Person Frowning: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F64D-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F64D-1F3FE-200D-2640-FE0F",
 		Value:      "🙍🏾‍♀️",
 		Descriptor: "Woman Frowning: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64D-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F64D-1F3FE-200D-2642-FE0F",
 		Value:      "🙍🏾‍♂️",
 		Descriptor: "Man Frowning: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64D-1F3FF": Emoji{
 		Key:        "1F64D-1F3FF",
 		Value:      "🙍🏿",
 		Descriptor: "Person Frowning: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F64D-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F64D-1F3FF-200D-2640-FE0F",
 		Value:      "🙍🏿‍♀️",
 		Descriptor: "Woman Frowning: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64D-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F64D-1F3FF-200D-2642-FE0F",
 		Value:      "🙍🏿‍♂️",
 		Descriptor: "Man Frowning: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64D-200D-2640-FE0F": Emoji{
 		Key:        "1F64D-200D-2640-FE0F",
 		Value:      "🙍‍♀️",
 		Descriptor: "Woman Frowning",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64D-200D-2642-FE0F": Emoji{
 		Key:        "1F64D-200D-2642-FE0F",
 		Value:      "🙍‍♂️",
 		Descriptor: "Man Frowning",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64E": Emoji{
 		Key:        "1F64E",
 		Value:      "🙎",
 		Descriptor: "Person Pouting",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "0.6",
 	},
 	"1F64E-1F3FB": Emoji{
 		Key:        "1F64E-1F3FB",
 		Value:      "🙎🏻",
 		Descriptor: "Person Pouting: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F64E-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F64E-1F3FB-200D-2640-FE0F",
 		Value:      "🙎🏻‍♀️",
 		Descriptor: "Woman Pouting: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64E-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F64E-1F3FB-200D-2642-FE0F",
 		Value:      "🙎🏻‍♂️",
 		Descriptor: "Man Pouting: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64E-1F3FC": Emoji{
 		Key:        "1F64E-1F3FC",
 		Value:      "🙎🏼",
 		Descriptor: "Person Pouting: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F64E-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F64E-1F3FC-200D-2640-FE0F",
 		Value:      "🙎🏼‍♀️",
 		Descriptor: "Woman Pouting: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64E-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F64E-1F3FC-200D-2642-FE0F",
 		Value:      "🙎🏼‍♂️",
 		Descriptor: "Man Pouting: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64E-1F3FD": Emoji{
 		Key:        "1F64E-1F3FD",
 		Value:      "🙎🏽",
 		Descriptor: "Person Pouting: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F64E-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F64E-1F3FD-200D-2640-FE0F",
 		Value:      "🙎🏽‍♀️",
 		Descriptor: "Woman Pouting: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64E-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F64E-1F3FD-200D-2642-FE0F",
 		Value:      "🙎🏽‍♂️",
 		Descriptor: "Man Pouting: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64E-1F3FE": Emoji{
 		Key:        "1F64E-1F3FE",
 		Value:      "🙎🏾",
 		Descriptor: "Person Pouting: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F64E-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F64E-1F3FE-200D-2640-FE0F",
 		Value:      "🙎🏾‍♀️",
 		Descriptor: "Woman Pouting: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64E-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F64E-1F3FE-200D-2642-FE0F",
 		Value:      "🙎🏾‍♂️",
 		Descriptor: "Man Pouting: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64E-1F3FF": Emoji{
 		Key:        "1F64E-1F3FF",
 		Value:      "🙎🏿",
 		Descriptor: "Person Pouting: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "1.0",
 	},
 	"1F64E-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F64E-1F3FF-200D-2640-FE0F",
 		Value:      "🙎🏿‍♀️",
 		Descriptor: "Woman Pouting: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64E-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F64E-1F3FF-200D-2642-FE0F",
 		Value:      "🙎🏿‍♂️",
 		Descriptor: "Man Pouting: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64E-200D-2640-FE0F": Emoji{
 		Key:        "1F64E-200D-2640-FE0F",
 		Value:      "🙎‍♀️",
 		Descriptor: "Woman Pouting",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64E-200D-2642-FE0F": Emoji{
 		Key:        "1F64E-200D-2642-FE0F",
 		Value:      "🙎‍♂️",
 		Descriptor: "Man Pouting",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F64F": Emoji{
 		Key:        "1F64F",
 		Value:      "🙏",
 		Descriptor: "Folded Hands",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "0.6",
 	},
 	"1F64F-1F3FB": Emoji{
 		Key:        "1F64F-1F3FB",
 		Value:      "🙏🏻",
 		Descriptor: "Folded Hands: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "1.0",
 	},
 	"1F64F-1F3FC": Emoji{
 		Key:        "1F64F-1F3FC",
 		Value:      "🙏🏼",
 		Descriptor: "Folded Hands: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "1.0",
 	},
 	"1F64F-1F3FD": Emoji{
 		Key:        "1F64F-1F3FD",
 		Value:      "🙏🏽",
 		Descriptor: "Folded Hands: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "1.0",
 	},
 	"1F64F-1F3FE": Emoji{
 		Key:        "1F64F-1F3FE",
 		Value:      "🙏🏾",
 		Descriptor: "Folded Hands: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "1.0",
 	},
 	"1F64F-1F3FF": Emoji{
 		Key:        "1F64F-1F3FF",
 		Value:      "🙏🏿",
 		Descriptor: "Folded Hands: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "1.0",
 	},
 	"1F680": Emoji{
 		Key:        "1F680",
 		Value:      "🚀",
 		Descriptor: "Rocket",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-air",
+		Version:    "0.6",
 	},
 	"1F681": Emoji{
 		Key:        "1F681",
 		Value:      "🚁",
 		Descriptor: "Helicopter",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-air",
+		Version:    "1.0",
 	},
 	"1F682": Emoji{
 		Key:        "1F682",
 		Value:      "🚂",
 		Descriptor: "Locomotive",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "1.0",
 	},
 	"1F683": Emoji{
 		Key:        "1F683",
 		Value:      "🚃",
 		Descriptor: "Railway Car",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.6",
 	},
 	"1F684": Emoji{
 		Key:        "1F684",
 		Value:      "🚄",
 		Descriptor: "High-Speed Train",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.6",
 	},
 	"1F685": Emoji{
 		Key:        "1F685",
 		Value:      "🚅",
 		Descriptor: "Bullet Train",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.6",
 	},
 	"1F686": Emoji{
 		Key:        "1F686",
 		Value:      "🚆",
 		Descriptor: "Train",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "1.0",
 	},
 	"1F687": Emoji{
 		Key:        "1F687",
 		Value:      "🚇",
 		Descriptor: "Metro",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.6",
 	},
 	"1F688": Emoji{
 		Key:        "1F688",
 		Value:      "🚈",
 		Descriptor: "Light Rail",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "1.0",
 	},
 	"1F689": Emoji{
 		Key:        "1F689",
 		Value:      "🚉",
 		Descriptor: "Station",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.6",
 	},
 	"1F68A": Emoji{
 		Key:        "1F68A",
 		Value:      "🚊",
 		Descriptor: "Tram",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "1.0",
 	},
 	"1F68B": Emoji{
 		Key:        "1F68B",
 		Value:      "🚋",
 		Descriptor: "Tram Car",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "1.0",
 	},
 	"1F68C": Emoji{
 		Key:        "1F68C",
 		Value:      "🚌",
 		Descriptor: "Bus",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.6",
 	},
 	"1F68D": Emoji{
 		Key:        "1F68D",
 		Value:      "🚍",
 		Descriptor: "Oncoming Bus",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.7",
 	},
 	"1F68E": Emoji{
 		Key:        "1F68E",
 		Value:      "🚎",
 		Descriptor: "Trolleybus",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "1.0",
 	},
 	"1F68F": Emoji{
 		Key:        "1F68F",
 		Value:      "🚏",
 		Descriptor: "Bus Stop",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.6",
 	},
 	"1F690": Emoji{
 		Key:        "1F690",
 		Value:      "🚐",
 		Descriptor: "Minibus",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "1.0",
 	},
 	"1F691": Emoji{
 		Key:        "1F691",
 		Value:      "🚑",
 		Descriptor: "Ambulance",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.6",
 	},
 	"1F692": Emoji{
 		Key:        "1F692",
 		Value:      "🚒",
 		Descriptor: "Fire Engine",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.6",
 	},
 	"1F693": Emoji{
 		Key:        "1F693",
 		Value:      "🚓",
 		Descriptor: "Police Car",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.6",
 	},
 	"1F694": Emoji{
 		Key:        "1F694",
 		Value:      "🚔",
 		Descriptor: "Oncoming Police Car",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.7",
 	},
 	"1F695": Emoji{
 		Key:        "1F695",
 		Value:      "🚕",
 		Descriptor: "Taxi",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.6",
 	},
 	"1F696": Emoji{
 		Key:        "1F696",
 		Value:      "🚖",
 		Descriptor: "Oncoming Taxi",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "1.0",
 	},
 	"1F697": Emoji{
 		Key:        "1F697",
 		Value:      "🚗",
 		Descriptor: "Automobile",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.6",
 	},
 	"1F698": Emoji{
 		Key:        "1F698",
 		Value:      "🚘",
 		Descriptor: "Oncoming Automobile",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.7",
 	},
 	"1F699": Emoji{
 		Key:        "1F699",
 		Value:      "🚙",
 		Descriptor: "Sport Utility Vehicle",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.6",
 	},
 	"1F69A": Emoji{
 		Key:        "1F69A",
 		Value:      "🚚",
 		Descriptor: "Delivery Truck",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.6",
 	},
 	"1F69B": Emoji{
 		Key:        "1F69B",
 		Value:      "🚛",
 		Descriptor: "Articulated Lorry",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "1.0",
 	},
 	"1F69C": Emoji{
 		Key:        "1F69C",
 		Value:      "🚜",
 		Descriptor: "Tractor",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "1.0",
 	},
 	"1F69D": Emoji{
 		Key:        "1F69D",
 		Value:      "🚝",
 		Descriptor: "Monorail",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "1.0",
 	},
 	"1F69E": Emoji{
 		Key:        "1F69E",
 		Value:      "🚞",
 		Descriptor: "Mountain Railway",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "1.0",
 	},
 	"1F69F": Emoji{
 		Key:        "1F69F",
 		Value:      "🚟",
 		Descriptor: "Suspension Railway",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-air",
+		Version:    "1.0",
 	},
 	"1F6A0": Emoji{
 		Key:        "1F6A0",
 		Value:      "🚠",
 		Descriptor: "Mountain Cableway",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-air",
+		Version:    "1.0",
 	},
 	"1F6A1": Emoji{
 		Key:        "1F6A1",
 		Value:      "🚡",
 		Descriptor: "Aerial Tramway",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-air",
+		Version:    "1.0",
 	},
 	"1F6A2": Emoji{
 		Key:        "1F6A2",
 		Value:      "🚢",
 		Descriptor: "Ship",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-water",
+		Version:    "0.6",
 	},
 	"1F6A3": Emoji{
 		Key:        "1F6A3",
 		Value:      "🚣",
 		Descriptor: "Person Rowing Boat",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F6A3-1F3FB": Emoji{
 		Key:        "1F6A3-1F3FB",
 		Value:      "🚣🏻",
 		Descriptor: "Person Rowing Boat: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F6A3-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F6A3-1F3FB-200D-2640-FE0F",
 		Value:      "🚣🏻‍♀️",
 		Descriptor: "Woman Rowing Boat: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6A3-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F6A3-1F3FB-200D-2642-FE0F",
 		Value:      "🚣🏻‍♂️",
 		Descriptor: "Man Rowing Boat: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6A3-1F3FC": Emoji{
 		Key:        "1F6A3-1F3FC",
 		Value:      "🚣🏼",
 		Descriptor: "Person Rowing Boat: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F6A3-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F6A3-1F3FC-200D-2640-FE0F",
 		Value:      "🚣🏼‍♀️",
 		Descriptor: "Woman Rowing Boat: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6A3-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F6A3-1F3FC-200D-2642-FE0F",
 		Value:      "🚣🏼‍♂️",
 		Descriptor: "Man Rowing Boat: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6A3-1F3FD": Emoji{
 		Key:        "1F6A3-1F3FD",
 		Value:      "🚣🏽",
 		Descriptor: "Person Rowing Boat: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F6A3-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F6A3-1F3FD-200D-2640-FE0F",
 		Value:      "🚣🏽‍♀️",
 		Descriptor: "Woman Rowing Boat: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6A3-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F6A3-1F3FD-200D-2642-FE0F",
 		Value:      "🚣🏽‍♂️",
 		Descriptor: "Man Rowing Boat: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6A3-1F3FE": Emoji{
 		Key:        "1F6A3-1F3FE",
 		Value:      "🚣🏾",
 		Descriptor: "Person Rowing Boat: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F6A3-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F6A3-1F3FE-200D-2640-FE0F",
 		Value:      "🚣🏾‍♀️",
 		Descriptor: "Woman Rowing Boat: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6A3-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F6A3-1F3FE-200D-2642-FE0F",
 		Value:      "🚣🏾‍♂️",
 		Descriptor: "Man Rowing Boat: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6A3-1F3FF": Emoji{
 		Key:        "1F6A3-1F3FF",
 		Value:      "🚣🏿",
 		Descriptor: "Person Rowing Boat: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F6A3-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F6A3-1F3FF-200D-2640-FE0F",
 		Value:      "🚣🏿‍♀️",
 		Descriptor: "Woman Rowing Boat: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6A3-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F6A3-1F3FF-200D-2642-FE0F",
 		Value:      "🚣🏿‍♂️",
 		Descriptor: "Man Rowing Boat: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6A3-200D-2640-FE0F": Emoji{
 		Key:        "1F6A3-200D-2640-FE0F",
 		Value:      "🚣‍♀️",
 		Descriptor: "Woman Rowing Boat",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6A3-200D-2642-FE0F": Emoji{
 		Key:        "1F6A3-200D-2642-FE0F",
 		Value:      "🚣‍♂️",
 		Descriptor: "Man Rowing Boat",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6A4": Emoji{
 		Key:        "1F6A4",
 		Value:      "🚤",
 		Descriptor: "Speedboat",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-water",
+		Version:    "0.6",
 	},
 	"1F6A5": Emoji{
 		Key:        "1F6A5",
 		Value:      "🚥",
 		Descriptor: "Horizontal Traffic Light",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.6",
 	},
 	"1F6A6": Emoji{
 		Key:        "1F6A6",
 		Value:      "🚦",
 		Descriptor: "Vertical Traffic Light",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "1.0",
 	},
 	"1F6A7": Emoji{
 		Key:        "1F6A7",
 		Value:      "🚧",
 		Descriptor: "Construction",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.6",
 	},
 	"1F6A8": Emoji{
 		Key:        "1F6A8",
 		Value:      "🚨",
 		Descriptor: "Police Car Light",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.6",
 	},
 	"1F6A9": Emoji{
 		Key:        "1F6A9",
 		Value:      "🚩",
 		Descriptor: "Triangular Flag",
+		Status:     "fully-qualified",
+		Group:      "Flags",
+		Subgroup:   "flag",
+		Version:    "0.6",
 	},
 	"1F6AA": Emoji{
 		Key:        "1F6AA",
 		Value:      "🚪",
 		Descriptor: "Door",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "0.6",
 	},
 	"1F6AB": Emoji{
 		Key:        "1F6AB",
 		Value:      "🚫",
 		Descriptor: "Prohibited",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "warning",
+		Version:    "0.6",
 	},
 	"1F6AC": Emoji{
 		Key:        "1F6AC",
 		Value:      "🚬",
 		Descriptor: "Cigarette",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "other-object",
+		Version:    "0.6",
 	},
 	"1F6AD": Emoji{
 		Key:        "1F6AD",
 		Value:      "🚭",
 		Descriptor: "No Smoking",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "warning",
+		Version:    "0.6",
 	},
 	"1F6AE": Emoji{
 		Key:        "1F6AE",
 		Value:      "🚮",
 		Descriptor: "Litter in Bin Sign",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "transport-sign",
+		Version:    "1.0",
 	},
 	"1F6AF": Emoji{
 		Key:        "1F6AF",
 		Value:      "🚯",
 		Descriptor: "No Littering",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "warning",
+		Version:    "1.0",
 	},
 	"1F6B0": Emoji{
 		Key:        "1F6B0",
 		Value:      "🚰",
 		Descriptor: "Potable Water",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "transport-sign",
+		Version:    "1.0",
 	},
 	"1F6B1": Emoji{
 		Key:        "1F6B1",
 		Value:      "🚱",
 		Descriptor: "Non-Potable Water",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "warning",
+		Version:    "1.0",
 	},
 	"1F6B2": Emoji{
 		Key:        "1F6B2",
 		Value:      "🚲",
 		Descriptor: "Bicycle",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.6",
 	},
 	"1F6B3": Emoji{
 		Key:        "1F6B3",
 		Value:      "🚳",
 		Descriptor: "No Bicycles",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "warning",
+		Version:    "1.0",
 	},
 	"1F6B4": Emoji{
 		Key:        "1F6B4",
 		Value:      "🚴",
 		Descriptor: "Person Biking",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F6B4-1F3FB": Emoji{
 		Key:        "1F6B4-1F3FB",
 		Value:      "🚴🏻",
 		Descriptor: "Person Biking: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F6B4-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F6B4-1F3FB-200D-2640-FE0F",
 		Value:      "🚴🏻‍♀️",
 		Descriptor: "Woman Biking: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B4-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F6B4-1F3FB-200D-2642-FE0F",
 		Value:      "🚴🏻‍♂️",
 		Descriptor: "Man Biking: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B4-1F3FC": Emoji{
 		Key:        "1F6B4-1F3FC",
 		Value:      "🚴🏼",
 		Descriptor: "Person Biking: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F6B4-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F6B4-1F3FC-200D-2640-FE0F",
 		Value:      "🚴🏼‍♀️",
 		Descriptor: "Woman Biking: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B4-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F6B4-1F3FC-200D-2642-FE0F",
 		Value:      "🚴🏼‍♂️",
 		Descriptor: "Man Biking: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B4-1F3FD": Emoji{
 		Key:        "1F6B4-1F3FD",
 		Value:      "🚴🏽",
 		Descriptor: "Person Biking: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F6B4-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F6B4-1F3FD-200D-2640-FE0F",
 		Value:      "🚴🏽‍♀️",
 		Descriptor: "Woman Biking: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B4-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F6B4-1F3FD-200D-2642-FE0F",
 		Value:      "🚴🏽‍♂️",
 		Descriptor: "Man Biking: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B4-1F3FE": Emoji{
 		Key:        "1F6B4-1F3FE",
 		Value:      "🚴🏾",
 		Descriptor: "Person Biking: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F6B4-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F6B4-1F3FE-200D-2640-FE0F",
 		Value:      "🚴🏾‍♀️",
 		Descriptor: "Woman Biking: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B4-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F6B4-1F3FE-200D-2642-FE0F",
 		Value:      "🚴🏾‍♂️",
 		Descriptor: "Man Biking: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B4-1F3FF": Emoji{
 		Key:        "1F6B4-1F3FF",
 		Value:      "🚴🏿",
 		Descriptor: "Person Biking: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F6B4-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F6B4-1F3FF-200D-2640-FE0F",
 		Value:      "🚴🏿‍♀️",
 		Descriptor: "Woman Biking: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B4-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F6B4-1F3FF-200D-2642-FE0F",
 		Value:      "🚴🏿‍♂️",
 		Descriptor: "Man Biking: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B4-200D-2640-FE0F": Emoji{
 		Key:        "1F6B4-200D-2640-FE0F",
 		Value:      "🚴‍♀️",
 		Descriptor: "Woman Biking",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B4-200D-2642-FE0F": Emoji{
 		Key:        "1F6B4-200D-2642-FE0F",
 		Value:      "🚴‍♂️",
 		Descriptor: "Man Biking",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B5": Emoji{
 		Key:        "1F6B5",
 		Value:      "🚵",
 		Descriptor: "Person Mountain Biking",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F6B5-1F3FB": Emoji{
 		Key:        "1F6B5-1F3FB",
 		Value:      "🚵🏻",
 		Descriptor: "Person Mountain Biking: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F6B5-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F6B5-1F3FB-200D-2640-FE0F",
 		Value:      "🚵🏻‍♀️",
 		Descriptor: "Woman Mountain Biking: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B5-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F6B5-1F3FB-200D-2642-FE0F",
 		Value:      "🚵🏻‍♂️",
 		Descriptor: "Man Mountain Biking: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B5-1F3FC": Emoji{
 		Key:        "1F6B5-1F3FC",
 		Value:      "🚵🏼",
 		Descriptor: "Person Mountain Biking: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F6B5-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F6B5-1F3FC-200D-2640-FE0F",
 		Value:      "🚵🏼‍♀️",
 		Descriptor: "Woman Mountain Biking: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B5-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F6B5-1F3FC-200D-2642-FE0F",
 		Value:      "🚵🏼‍♂️",
 		Descriptor: "Man Mountain Biking: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B5-1F3FD": Emoji{
 		Key:        "1F6B5-1F3FD",
 		Value:      "🚵🏽",
 		Descriptor: "Person Mountain Biking: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F6B5-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F6B5-1F3FD-200D-2640-FE0F",
 		Value:      "🚵🏽‍♀️",
 		Descriptor: "Woman Mountain Biking: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B5-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F6B5-1F3FD-200D-2642-FE0F",
 		Value:      "🚵🏽‍♂️",
 		Descriptor: "Man Mountain Biking: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B5-1F3FE": Emoji{
 		Key:        "1F6B5-1F3FE",
 		Value:      "🚵🏾",
 		Descriptor: "Person Mountain Biking: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F6B5-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F6B5-1F3FE-200D-2640-FE0F",
 		Value:      "🚵🏾‍♀️",
 		Descriptor: "Woman Mountain Biking: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B5-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F6B5-1F3FE-200D-2642-FE0F",
 		Value:      "🚵🏾‍♂️",
 		Descriptor: "Man Mountain Biking: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B5-1F3FF": Emoji{
 		Key:        "1F6B5-1F3FF",
 		Value:      "🚵🏿",
 		Descriptor: "Person Mountain Biking: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "1.0",
 	},
 	"1F6B5-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F6B5-1F3FF-200D-2640-FE0F",
 		Value:      "🚵🏿‍♀️",
 		Descriptor: "Woman Mountain Biking: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B5-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F6B5-1F3FF-200D-2642-FE0F",
 		Value:      "🚵🏿‍♂️",
 		Descriptor: "Man Mountain Biking: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B5-200D-2640-FE0F": Emoji{
 		Key:        "1F6B5-200D-2640-FE0F",
 		Value:      "🚵‍♀️",
 		Descriptor: "Woman Mountain Biking",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B5-200D-2642-FE0F": Emoji{
 		Key:        "1F6B5-200D-2642-FE0F",
 		Value:      "🚵‍♂️",
 		Descriptor: "Man Mountain Biking",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F6B6": Emoji{
 		Key:        "1F6B6",
 		Value:      "🚶",
 		Descriptor: "Person Walking",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "0.6",
 	},
 	"1F6B6-1F3FB": Emoji{
 		Key:        "1F6B6-1F3FB",
 		Value:      "🚶🏻",
 		Descriptor: "Person Walking: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F6B6-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F6B6-1F3FB-200D-2640-FE0F",
 		Value:      "🚶🏻‍♀️",
 		Descriptor: "Woman Walking: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F6B6-1F3FB-200D-2640-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F6B6-1F3FB-200D-2640-FE0F-200D-27A1-FE0F",
+		Value:      "🚶🏻‍♀️‍➡️",
+		Descriptor: "Woman Walking Facing Right: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F6B6-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F6B6-1F3FB-200D-2642-FE0F",
 		Value:      "🚶🏻‍♂️",
 		Descriptor: "Man Walking: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F6B6-1F3FB-200D-2642-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F6B6-1F3FB-200D-2642-FE0F-200D-27A1-FE0F",
+		Value:      "🚶🏻‍♂️‍➡️",
+		Descriptor: "Man Walking Facing Right: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F6B6-1F3FB-200D-27A1-FE0F": Emoji{
+		Key:        "1F6B6-1F3FB-200D-27A1-FE0F",
+		Value:      "🚶🏻‍➡️",
+		Descriptor: "Person Walking Facing Right: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F6B6-1F3FC": Emoji{
 		Key:        "1F6B6-1F3FC",
 		Value:      "🚶🏼",
 		Descriptor: "Person Walking: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F6B6-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F6B6-1F3FC-200D-2640-FE0F",
 		Value:      "🚶🏼‍♀️",
 		Descriptor: "Woman Walking: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F6B6-1F3FC-200D-2640-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F6B6-1F3FC-200D-2640-FE0F-200D-27A1-FE0F",
+		Value:      "🚶🏼‍♀️‍➡️",
+		Descriptor: "Woman Walking Facing Right: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F6B6-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F6B6-1F3FC-200D-2642-FE0F",
 		Value:      "🚶🏼‍♂️",
 		Descriptor: "Man Walking: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F6B6-1F3FC-200D-2642-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F6B6-1F3FC-200D-2642-FE0F-200D-27A1-FE0F",
+		Value:      "🚶🏼‍♂️‍➡️",
+		Descriptor: "Man Walking Facing Right: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F6B6-1F3FC-200D-27A1-FE0F": Emoji{
+		Key:        "1F6B6-1F3FC-200D-27A1-FE0F",
+		Value:      "🚶🏼‍➡️",
+		Descriptor: "Person Walking Facing Right: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F6B6-1F3FD": Emoji{
 		Key:        "1F6B6-1F3FD",
 		Value:      "🚶🏽",
 		Descriptor: "Person Walking: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F6B6-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F6B6-1F3FD-200D-2640-FE0F",
 		Value:      "🚶🏽‍♀️",
 		Descriptor: "Woman Walking: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F6B6-1F3FD-200D-2640-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F6B6-1F3FD-200D-2640-FE0F-200D-27A1-FE0F",
+		Value:      "🚶🏽‍♀️‍➡️",
+		Descriptor: "Woman Walking Facing Right: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F6B6-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F6B6-1F3FD-200D-2642-FE0F",
 		Value:      "🚶🏽‍♂️",
 		Descriptor: "Man Walking: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F6B6-1F3FD-200D-2642-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F6B6-1F3FD-200D-2642-FE0F-200D-27A1-FE0F",
+		Value:      "🚶🏽‍♂️‍➡️",
+		Descriptor: "Man Walking Facing Right: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F6B6-1F3FD-200D-27A1-FE0F": Emoji{
+		Key:        "1F6B6-1F3FD-200D-27A1-FE0F",
+		Value:      "🚶🏽‍➡️",
+		Descriptor: "Person Walking Facing Right: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F6B6-1F3FE": Emoji{
 		Key:        "1F6B6-1F3FE",
 		Value:      "🚶🏾",
 		Descriptor: "Person Walking: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F6B6-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F6B6-1F3FE-200D-2640-FE0F",
 		Value:      "🚶🏾‍♀️",
 		Descriptor: "Woman Walking: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F6B6-1F3FE-200D-2640-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F6B6-1F3FE-200D-2640-FE0F-200D-27A1-FE0F",
+		Value:      "🚶🏾‍♀️‍➡️",
+		Descriptor: "Woman Walking Facing Right: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F6B6-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F6B6-1F3FE-200D-2642-FE0F",
 		Value:      "🚶🏾‍♂️",
 		Descriptor: "Man Walking: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F6B6-1F3FE-200D-2642-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F6B6-1F3FE-200D-2642-FE0F-200D-27A1-FE0F",
+		Value:      "🚶🏾‍♂️‍➡️",
+		Descriptor: "Man Walking Facing Right: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F6B6-1F3FE-200D-27A1-FE0F": Emoji{
+		Key:        "1F6B6-1F3FE-200D-27A1-FE0F",
+		Value:      "🚶🏾‍➡️",
+		Descriptor: "Person Walking Facing Right: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F6B6-1F3FF": Emoji{
 		Key:        "1F6B6-1F3FF",
 		Value:      "🚶🏿",
 		Descriptor: "Person Walking: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "1.0",
 	},
 	"1F6B6-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F6B6-1F3FF-200D-2640-FE0F",
 		Value:      "🚶🏿‍♀️",
 		Descriptor: "Woman Walking: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F6B6-1F3FF-200D-2640-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F6B6-1F3FF-200D-2640-FE0F-200D-27A1-FE0F",
+		Value:      "🚶🏿‍♀️‍➡️",
+		Descriptor: "Woman Walking Facing Right: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F6B6-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F6B6-1F3FF-200D-2642-FE0F",
 		Value:      "🚶🏿‍♂️",
 		Descriptor: "Man Walking: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F6B6-1F3FF-200D-2642-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F6B6-1F3FF-200D-2642-FE0F-200D-27A1-FE0F",
+		Value:      "🚶🏿‍♂️‍➡️",
+		Descriptor: "Man Walking Facing Right: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F6B6-1F3FF-200D-27A1-FE0F": Emoji{
+		Key:        "1F6B6-1F3FF-200D-27A1-FE0F",
+		Value:      "🚶🏿‍➡️",
+		Descriptor: "Person Walking Facing Right: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F6B6-200D-2640-FE0F": Emoji{
 		Key:        "1F6B6-200D-2640-FE0F",
 		Value:      "🚶‍♀️",
 		Descriptor: "Woman Walking",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F6B6-200D-2640-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F6B6-200D-2640-FE0F-200D-27A1-FE0F",
+		Value:      "🚶‍♀️‍➡️",
+		Descriptor: "Woman Walking Facing Right",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F6B6-200D-2642-FE0F": Emoji{
 		Key:        "1F6B6-200D-2642-FE0F",
 		Value:      "🚶‍♂️",
 		Descriptor: "Man Walking",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "4.0",
+	},
+	"1F6B6-200D-2642-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F6B6-200D-2642-FE0F-200D-27A1-FE0F",
+		Value:      "🚶‍♂️‍➡️",
+		Descriptor: "Man Walking Facing Right",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F6B6-200D-27A1-FE0F": Emoji{
+		Key:        "1F6B6-200D-27A1-FE0F",
+		Value:      "🚶‍➡️",
+		Descriptor: "Person Walking Facing Right",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
 	},
 	"1F6B7": Emoji{
 		Key:        "1F6B7",
 		Value:      "🚷",
 		Descriptor: "No Pedestrians",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "warning",
+		Version:    "1.0",
 	},
 	"1F6B8": Emoji{
 		Key:        "1F6B8",
 		Value:      "🚸",
 		Descriptor: "Children Crossing",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "warning",
+		Version:    "1.0",
 	},
 	"1F6B9": Emoji{
 		Key:        "1F6B9",
 		Value:      "🚹",
 		Descriptor: "Men’s Room",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "transport-sign",
+		Version:    "0.6",
 	},
 	"1F6BA": Emoji{
 		Key:        "1F6BA",
 		Value:      "🚺",
 		Descriptor: "Women’s Room",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "transport-sign",
+		Version:    "0.6",
 	},
 	"1F6BB": Emoji{
 		Key:        "1F6BB",
 		Value:      "🚻",
 		Descriptor: "Restroom",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "transport-sign",
+		Version:    "0.6",
 	},
 	"1F6BC": Emoji{
 		Key:        "1F6BC",
 		Value:      "🚼",
 		Descriptor: "Baby Symbol",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "transport-sign",
+		Version:    "0.6",
 	},
 	"1F6BD": Emoji{
 		Key:        "1F6BD",
 		Value:      "🚽",
 		Descriptor: "Toilet",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "0.6",
 	},
 	"1F6BE": Emoji{
 		Key:        "1F6BE",
 		Value:      "🚾",
 		Descriptor: "Water Closet",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "transport-sign",
+		Version:    "0.6",
 	},
 	"1F6BF": Emoji{
 		Key:        "1F6BF",
 		Value:      "🚿",
 		Descriptor: "Shower",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "1.0",
 	},
 	"1F6C0": Emoji{
 		Key:        "1F6C0",
 		Value:      "🛀",
 		Descriptor: "Person Taking Bath",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "0.6",
 	},
 	"1F6C0-1F3FB": Emoji{
 		Key:        "1F6C0-1F3FB",
 		Value:      "🛀🏻",
 		Descriptor: "Person Taking Bath: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "1.0",
 	},
 	"1F6C0-1F3FC": Emoji{
 		Key:        "1F6C0-1F3FC",
 		Value:      "🛀🏼",
 		Descriptor: "Person Taking Bath: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "1.0",
 	},
 	"1F6C0-1F3FD": Emoji{
 		Key:        "1F6C0-1F3FD",
 		Value:      "🛀🏽",
 		Descriptor: "Person Taking Bath: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "1.0",
 	},
 	"1F6C0-1F3FE": Emoji{
 		Key:        "1F6C0-1F3FE",
 		Value:      "🛀🏾",
 		Descriptor: "Person Taking Bath: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "1.0",
 	},
 	"1F6C0-1F3FF": Emoji{
 		Key:        "1F6C0-1F3FF",
 		Value:      "🛀🏿",
 		Descriptor: "Person Taking Bath: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "1.0",
 	},
 	"1F6C1": Emoji{
 		Key:        "1F6C1",
 		Value:      "🛁",
 		Descriptor: "Bathtub",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "1.0",
 	},
 	"1F6C2": Emoji{
 		Key:        "1F6C2",
 		Value:      "🛂",
 		Descriptor: "Passport Control",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "transport-sign",
+		Version:    "1.0",
 	},
 	"1F6C3": Emoji{
 		Key:        "1F6C3",
 		Value:      "🛃",
 		Descriptor: "Customs",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "transport-sign",
+		Version:    "1.0",
 	},
 	"1F6C4": Emoji{
 		Key:        "1F6C4",
 		Value:      "🛄",
 		Descriptor: "Baggage Claim",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "transport-sign",
+		Version:    "1.0",
 	},
 	"1F6C5": Emoji{
 		Key:        "1F6C5",
 		Value:      "🛅",
 		Descriptor: "Left Luggage",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "transport-sign",
+		Version:    "1.0",
 	},
 	"1F6CB-FE0F": Emoji{
 		Key:        "1F6CB-FE0F",
 		Value:      "🛋️",
 		Descriptor: "Couch and Lamp",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "0.7",
 	},
 	"1F6CC": Emoji{
 		Key:        "1F6CC",
 		Value:      "🛌",
 		Descriptor: "Person in Bed",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "1.0",
+	},
+	"1F6CC-1F3FB": Emoji{
+		Key:        "1F6CC-1F3FB",
+		Value:      "🛌🏻",
+		Descriptor: "Person in Bed: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "4.0",
+	},
+	"1F6CC-1F3FC": Emoji{
+		Key:        "1F6CC-1F3FC",
+		Value:      "🛌🏼",
+		Descriptor: "Person in Bed: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "4.0",
+	},
+	"1F6CC-1F3FD": Emoji{
+		Key:        "1F6CC-1F3FD",
+		Value:      "🛌🏽",
+		Descriptor: "Person in Bed: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "4.0",
+	},
+	"1F6CC-1F3FE": Emoji{
+		Key:        "1F6CC-1F3FE",
+		Value:      "🛌🏾",
+		Descriptor: "Person in Bed: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "4.0",
+	},
+	"1F6CC-1F3FF": Emoji{
+		Key:        "1F6CC-1F3FF",
+		Value:      "🛌🏿",
+		Descriptor: "Person in Bed: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "4.0",
 	},
 	"1F6CD-FE0F": Emoji{
 		Key:        "1F6CD-FE0F",
 		Value:      "🛍️",
 		Descriptor: "Shopping Bags",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.7",
 	},
 	"1F6CE-FE0F": Emoji{
 		Key:        "1F6CE-FE0F",
 		Value:      "🛎️",
 		Descriptor: "Bellhop Bell",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "hotel",
+		Version:    "0.7",
 	},
 	"1F6CF-FE0F": Emoji{
 		Key:        "1F6CF-FE0F",
 		Value:      "🛏️",
 		Descriptor: "Bed",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "0.7",
 	},
 	"1F6D0": Emoji{
 		Key:        "1F6D0",
 		Value:      "🛐",
 		Descriptor: "Place of Worship",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "religion",
+		Version:    "1.0",
 	},
 	"1F6D1": Emoji{
 		Key:        "1F6D1",
 		Value:      "🛑",
 		Descriptor: "Stop Sign",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "3.0",
 	},
 	"1F6D2": Emoji{
 		Key:        "1F6D2",
 		Value:      "🛒",
 		Descriptor: "Shopping Cart",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "3.0",
+	},
+	"1F6D5": Emoji{
+		Key:        "1F6D5",
+		Value:      "🛕",
+		Descriptor: "Hindu Temple",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-religious",
+		Version:    "12.0",
+	},
+	"1F6D6": Emoji{
+		Key:        "1F6D6",
+		Value:      "🛖",
+		Descriptor: "Hut",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "13.0",
+	},
+	"1F6D7": Emoji{
+		Key:        "1F6D7",
+		Value:      "🛗",
+		Descriptor: "Elevator",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "13.0",
+	},
+	"1F6DC": Emoji{
+		Key:        "1F6DC",
+		Value:      "🛜",
+		Descriptor: "Wireless",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "15.0",
+	},
+	"1F6DD": Emoji{
+		Key:        "1F6DD",
+		Value:      "🛝",
+		Descriptor: "Playground Slide",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-other",
+		Version:    "14.0",
+	},
+	"1F6DE": Emoji{
+		Key:        "1F6DE",
+		Value:      "🛞",
+		Descriptor: "Wheel",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "14.0",
+	},
+	"1F6DF": Emoji{
+		Key:        "1F6DF",
+		Value:      "🛟",
+		Descriptor: "Ring Buoy",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-water",
+		Version:    "14.0",
 	},
 	"1F6E0-FE0F": Emoji{
 		Key:        "1F6E0-FE0F",
 		Value:      "🛠️",
 		Descriptor: "Hammer and Wrench",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "0.7",
 	},
 	"1F6E1-FE0F": Emoji{
 		Key:        "1F6E1-FE0F",
 		Value:      "🛡️",
 		Descriptor: "Shield",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "0.7",
 	},
 	"1F6E2-FE0F": Emoji{
 		Key:        "1F6E2-FE0F",
 		Value:      "🛢️",
 		Descriptor: "Oil Drum",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.7",
 	},
 	"1F6E3-FE0F": Emoji{
 		Key:        "1F6E3-FE0F",
 		Value:      "🛣️",
 		Descriptor: "Motorway",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.7",
 	},
 	"1F6E4-FE0F": Emoji{
 		Key:        "1F6E4-FE0F",
 		Value:      "🛤️",
 		Descriptor: "Railway Track",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.7",
 	},
 	"1F6E5-FE0F": Emoji{
 		Key:        "1F6E5-FE0F",
 		Value:      "🛥️",
 		Descriptor: "Motor Boat",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-water",
+		Version:    "0.7",
 	},
 	"1F6E9-FE0F": Emoji{
 		Key:        "1F6E9-FE0F",
 		Value:      "🛩️",
 		Descriptor: "Small Airplane",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-air",
+		Version:    "0.7",
 	},
 	"1F6EB": Emoji{
 		Key:        "1F6EB",
 		Value:      "🛫",
 		Descriptor: "Airplane Departure",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-air",
+		Version:    "1.0",
 	},
 	"1F6EC": Emoji{
 		Key:        "1F6EC",
 		Value:      "🛬",
 		Descriptor: "Airplane Arrival",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-air",
+		Version:    "1.0",
 	},
 	"1F6F0-FE0F": Emoji{
 		Key:        "1F6F0-FE0F",
 		Value:      "🛰️",
 		Descriptor: "Satellite",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-air",
+		Version:    "0.7",
 	},
 	"1F6F3-FE0F": Emoji{
 		Key:        "1F6F3-FE0F",
 		Value:      "🛳️",
 		Descriptor: "Passenger Ship",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-water",
+		Version:    "0.7",
 	},
 	"1F6F4": Emoji{
 		Key:        "1F6F4",
 		Value:      "🛴",
 		Descriptor: "Kick Scooter",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "3.0",
 	},
 	"1F6F5": Emoji{
 		Key:        "1F6F5",
 		Value:      "🛵",
 		Descriptor: "Motor Scooter",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "3.0",
 	},
 	"1F6F6": Emoji{
 		Key:        "1F6F6",
 		Value:      "🛶",
 		Descriptor: "Canoe",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-water",
+		Version:    "3.0",
 	},
 	"1F6F7": Emoji{
 		Key:        "1F6F7",
 		Value:      "🛷",
 		Descriptor: "Sled",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "5.0",
 	},
 	"1F6F8": Emoji{
 		Key:        "1F6F8",
 		Value:      "🛸",
 		Descriptor: "Flying Saucer",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-air",
+		Version:    "5.0",
 	},
 	"1F6F9": Emoji{
 		Key:        "1F6F9",
 		Value:      "🛹",
 		Descriptor: "Skateboard",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "11.0",
+	},
+	"1F6FA": Emoji{
+		Key:        "1F6FA",
+		Value:      "🛺",
+		Descriptor: "Auto Rickshaw",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "12.0",
+	},
+	"1F6FB": Emoji{
+		Key:        "1F6FB",
+		Value:      "🛻",
+		Descriptor: "Pickup Truck",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "13.0",
+	},
+	"1F6FC": Emoji{
+		Key:        "1F6FC",
+		Value:      "🛼",
+		Descriptor: "Roller Skate",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "13.0",
+	},
+	"1F7E0": Emoji{
+		Key:        "1F7E0",
+		Value:      "🟠",
+		Descriptor: "Orange Circle",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "12.0",
+	},
+	"1F7E1": Emoji{
+		Key:        "1F7E1",
+		Value:      "🟡",
+		Descriptor: "Yellow Circle",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "12.0",
+	},
+	"1F7E2": Emoji{
+		Key:        "1F7E2",
+		Value:      "🟢",
+		Descriptor: "Green Circle",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "12.0",
+	},
+	"1F7E3": Emoji{
+		Key:        "1F7E3",
+		Value:      "🟣",
+		Descriptor: "Purple Circle",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "12.0",
+	},
+	"1F7E4": Emoji{
+		Key:        "1F7E4",
+		Value:      "🟤",
+		Descriptor: "Brown Circle",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "12.0",
+	},
+	"1F7E5": Emoji{
+		Key:        "1F7E5",
+		Value:      "🟥",
+		Descriptor: "Red Square",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "12.0",
+	},
+	"1F7E6": Emoji{
+		Key:        "1F7E6",
+		Value:      "🟦",
+		Descriptor: "Blue Square",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "12.0",
+	},
+	"1F7E7": Emoji{
+		Key:        "1F7E7",
+		Value:      "🟧",
+		Descriptor: "Orange Square",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "12.0",
+	},
+	"1F7E8": Emoji{
+		Key:        "1F7E8",
+		Value:      "🟨",
+		Descriptor: "Yellow Square",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "12.0",
+	},
+	"1F7E9": Emoji{
+		Key:        "1F7E9",
+		Value:      "🟩",
+		Descriptor: "Green Square",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "12.0",
+	},
+	"1F7EA": Emoji{
+		Key:        "1F7EA",
+		Value:      "🟪",
+		Descriptor: "Purple Square",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "12.0",
+	},
+	"1F7EB": Emoji{
+		Key:        "1F7EB",
+		Value:      "🟫",
+		Descriptor: "Brown Square",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "12.0",
+	},
+	"1F7F0": Emoji{
+		Key:        "1F7F0",
+		Value:      "🟰",
+		Descriptor: "Heavy Equals Sign",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "math",
+		Version:    "14.0",
+	},
+	"1F90C": Emoji{
+		Key:        "1F90C",
+		Value:      "🤌",
+		Descriptor: "Pinched Fingers",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "13.0",
+	},
+	"1F90C-1F3FB": Emoji{
+		Key:        "1F90C-1F3FB",
+		Value:      "🤌🏻",
+		Descriptor: "Pinched Fingers: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "13.0",
+	},
+	"1F90C-1F3FC": Emoji{
+		Key:        "1F90C-1F3FC",
+		Value:      "🤌🏼",
+		Descriptor: "Pinched Fingers: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "13.0",
+	},
+	"1F90C-1F3FD": Emoji{
+		Key:        "1F90C-1F3FD",
+		Value:      "🤌🏽",
+		Descriptor: "Pinched Fingers: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "13.0",
+	},
+	"1F90C-1F3FE": Emoji{
+		Key:        "1F90C-1F3FE",
+		Value:      "🤌🏾",
+		Descriptor: "Pinched Fingers: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "13.0",
+	},
+	"1F90C-1F3FF": Emoji{
+		Key:        "1F90C-1F3FF",
+		Value:      "🤌🏿",
+		Descriptor: "Pinched Fingers: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "13.0",
+	},
+	"1F90D": Emoji{
+		Key:        "1F90D",
+		Value:      "🤍",
+		Descriptor: "White Heart",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "12.0",
+	},
+	"1F90E": Emoji{
+		Key:        "1F90E",
+		Value:      "🤎",
+		Descriptor: "Brown Heart",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "12.0",
+	},
+	"1F90F": Emoji{
+		Key:        "1F90F",
+		Value:      "🤏",
+		Descriptor: "Pinching Hand",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "12.0",
+	},
+	"1F90F-1F3FB": Emoji{
+		Key:        "1F90F-1F3FB",
+		Value:      "🤏🏻",
+		Descriptor: "Pinching Hand: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "12.0",
+	},
+	"1F90F-1F3FC": Emoji{
+		Key:        "1F90F-1F3FC",
+		Value:      "🤏🏼",
+		Descriptor: "Pinching Hand: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "12.0",
+	},
+	"1F90F-1F3FD": Emoji{
+		Key:        "1F90F-1F3FD",
+		Value:      "🤏🏽",
+		Descriptor: "Pinching Hand: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "12.0",
+	},
+	"1F90F-1F3FE": Emoji{
+		Key:        "1F90F-1F3FE",
+		Value:      "🤏🏾",
+		Descriptor: "Pinching Hand: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "12.0",
+	},
+	"1F90F-1F3FF": Emoji{
+		Key:        "1F90F-1F3FF",
+		Value:      "🤏🏿",
+		Descriptor: "Pinching Hand: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "12.0",
 	},
 	"1F910": Emoji{
 		Key:        "1F910",
 		Value:      "🤐",
 		Descriptor: "Zipper-Mouth Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-neutral-skeptical",
+		Version:    "1.0",
 	},
 	"1F911": Emoji{
 		Key:        "1F911",
 		Value:      "🤑",
 		Descriptor: "Money-Mouth Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-tongue",
+		Version:    "1.0",
 	},
 	"1F912": Emoji{
 		Key:        "1F912",
 		Value:      "🤒",
 		Descriptor: "Face With Thermometer",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-unwell",
+		Version:    "1.0",
 	},
 	"1F913": Emoji{
 		Key:        "1F913",
 		Value:      "🤓",
 		Descriptor: "Nerd Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-glasses",
+		Version:    "1.0",
 	},
 	"1F914": Emoji{
 		Key:        "1F914",
 		Value:      "🤔",
 		Descriptor: "Thinking Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-hand",
+		Version:    "1.0",
 	},
 	"1F915": Emoji{
 		Key:        "1F915",
 		Value:      "🤕",
 		Descriptor: "Face With Head-Bandage",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-unwell",
+		Version:    "1.0",
 	},
 	"1F916": Emoji{
 		Key:        "1F916",
 		Value:      "🤖",
-		Descriptor: "Robot Face",
+		Descriptor: "Robot",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-costume",
+		Version:    "1.0",
 	},
 	"1F917": Emoji{
 		Key:        "1F917",
 		Value:      "🤗",
-		Descriptor: "Hugging Face",
+		Descriptor: "Smiling Face With Open Hands",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-hand",
+		Version:    "1.0",
 	},
 	"1F918": Emoji{
 		Key:        "1F918",
 		Value:      "🤘",
 		Descriptor: "Sign of the Horns",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "1.0",
 	},
 	"1F918-1F3FB": Emoji{
 		Key:        "1F918-1F3FB",
 		Value:      "🤘🏻",
 		Descriptor: "Sign of the Horns: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "1.0",
 	},
 	"1F918-1F3FC": Emoji{
 		Key:        "1F918-1F3FC",
 		Value:      "🤘🏼",
 		Descriptor: "Sign of the Horns: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "1.0",
 	},
 	"1F918-1F3FD": Emoji{
 		Key:        "1F918-1F3FD",
 		Value:      "🤘🏽",
 		Descriptor: "Sign of the Horns: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "1.0",
 	},
 	"1F918-1F3FE": Emoji{
 		Key:        "1F918-1F3FE",
 		Value:      "🤘🏾",
 		Descriptor: "Sign of the Horns: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "1.0",
 	},
 	"1F918-1F3FF": Emoji{
 		Key:        "1F918-1F3FF",
 		Value:      "🤘🏿",
 		Descriptor: "Sign of the Horns: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "1.0",
 	},
 	"1F919": Emoji{
 		Key:        "1F919",
 		Value:      "🤙",
 		Descriptor: "Call Me Hand",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "3.0",
 	},
 	"1F919-1F3FB": Emoji{
 		Key:        "1F919-1F3FB",
 		Value:      "🤙🏻",
 		Descriptor: "Call Me Hand: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "3.0",
 	},
 	"1F919-1F3FC": Emoji{
 		Key:        "1F919-1F3FC",
 		Value:      "🤙🏼",
 		Descriptor: "Call Me Hand: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "3.0",
 	},
 	"1F919-1F3FD": Emoji{
 		Key:        "1F919-1F3FD",
 		Value:      "🤙🏽",
 		Descriptor: "Call Me Hand: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "3.0",
 	},
 	"1F919-1F3FE": Emoji{
 		Key:        "1F919-1F3FE",
 		Value:      "🤙🏾",
 		Descriptor: "Call Me Hand: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "3.0",
 	},
 	"1F919-1F3FF": Emoji{
 		Key:        "1F919-1F3FF",
 		Value:      "🤙🏿",
 		Descriptor: "Call Me Hand: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "3.0",
 	},
 	"1F91A": Emoji{
 		Key:        "1F91A",
 		Value:      "🤚",
 		Descriptor: "Raised Back of Hand",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "3.0",
 	},
 	"1F91A-1F3FB": Emoji{
 		Key:        "1F91A-1F3FB",
 		Value:      "🤚🏻",
 		Descriptor: "Raised Back of Hand: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "3.0",
 	},
 	"1F91A-1F3FC": Emoji{
 		Key:        "1F91A-1F3FC",
 		Value:      "🤚🏼",
 		Descriptor: "Raised Back of Hand: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "3.0",
 	},
 	"1F91A-1F3FD": Emoji{
 		Key:        "1F91A-1F3FD",
 		Value:      "🤚🏽",
 		Descriptor: "Raised Back of Hand: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "3.0",
 	},
 	"1F91A-1F3FE": Emoji{
 		Key:        "1F91A-1F3FE",
 		Value:      "🤚🏾",
 		Descriptor: "Raised Back of Hand: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "3.0",
 	},
 	"1F91A-1F3FF": Emoji{
 		Key:        "1F91A-1F3FF",
 		Value:      "🤚🏿",
 		Descriptor: "Raised Back of Hand: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "3.0",
 	},
 	"1F91B": Emoji{
 		Key:        "1F91B",
 		Value:      "🤛",
 		Descriptor: "Left-Facing Fist",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "3.0",
 	},
 	"1F91B-1F3FB": Emoji{
 		Key:        "1F91B-1F3FB",
 		Value:      "🤛🏻",
 		Descriptor: "Left-Facing Fist: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "3.0",
 	},
 	"1F91B-1F3FC": Emoji{
 		Key:        "1F91B-1F3FC",
 		Value:      "🤛🏼",
 		Descriptor: "Left-Facing Fist: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "3.0",
 	},
 	"1F91B-1F3FD": Emoji{
 		Key:        "1F91B-1F3FD",
 		Value:      "🤛🏽",
 		Descriptor: "Left-Facing Fist: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "3.0",
 	},
 	"1F91B-1F3FE": Emoji{
 		Key:        "1F91B-1F3FE",
 		Value:      "🤛🏾",
 		Descriptor: "Left-Facing Fist: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "3.0",
 	},
 	"1F91B-1F3FF": Emoji{
 		Key:        "1F91B-1F3FF",
 		Value:      "🤛🏿",
 		Descriptor: "Left-Facing Fist: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "3.0",
 	},
 	"1F91C": Emoji{
 		Key:        "1F91C",
 		Value:      "🤜",
 		Descriptor: "Right-Facing Fist",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "3.0",
 	},
 	"1F91C-1F3FB": Emoji{
 		Key:        "1F91C-1F3FB",
 		Value:      "🤜🏻",
 		Descriptor: "Right-Facing Fist: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "3.0",
 	},
 	"1F91C-1F3FC": Emoji{
 		Key:        "1F91C-1F3FC",
 		Value:      "🤜🏼",
 		Descriptor: "Right-Facing Fist: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "3.0",
 	},
 	"1F91C-1F3FD": Emoji{
 		Key:        "1F91C-1F3FD",
 		Value:      "🤜🏽",
 		Descriptor: "Right-Facing Fist: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "3.0",
 	},
 	"1F91C-1F3FE": Emoji{
 		Key:        "1F91C-1F3FE",
 		Value:      "🤜🏾",
 		Descriptor: "Right-Facing Fist: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "3.0",
 	},
 	"1F91C-1F3FF": Emoji{
 		Key:        "1F91C-1F3FF",
 		Value:      "🤜🏿",
 		Descriptor: "Right-Facing Fist: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "3.0",
 	},
 	"1F91D": Emoji{
 		Key:        "1F91D",
 		Value:      "🤝",
 		Descriptor: "Handshake",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "3.0",
+	},
+	"1F91D-1F3FB": Emoji{
+		Key:        "1F91D-1F3FB",
+		Value:      "🤝🏻",
+		Descriptor: "Handshake: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1F91D-1F3FC": Emoji{
+		Key:        "1F91D-1F3FC",
+		Value:      "🤝🏼",
+		Descriptor: "Handshake: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1F91D-1F3FD": Emoji{
+		Key:        "1F91D-1F3FD",
+		Value:      "🤝🏽",
+		Descriptor: "Handshake: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1F91D-1F3FE": Emoji{
+		Key:        "1F91D-1F3FE",
+		Value:      "🤝🏾",
+		Descriptor: "Handshake: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1F91D-1F3FF": Emoji{
+		Key:        "1F91D-1F3FF",
+		Value:      "🤝🏿",
+		Descriptor: "Handshake: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
 	},
 	"1F91E": Emoji{
 		Key:        "1F91E",
 		Value:      "🤞",
 		Descriptor: "Crossed Fingers",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "3.0",
 	},
 	"1F91E-1F3FB": Emoji{
 		Key:        "1F91E-1F3FB",
 		Value:      "🤞🏻",
 		Descriptor: "Crossed Fingers: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "3.0",
 	},
 	"1F91E-1F3FC": Emoji{
 		Key:        "1F91E-1F3FC",
 		Value:      "🤞🏼",
 		Descriptor: "Crossed Fingers: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "3.0",
 	},
 	"1F91E-1F3FD": Emoji{
 		Key:        "1F91E-1F3FD",
 		Value:      "🤞🏽",
 		Descriptor: "Crossed Fingers: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "3.0",
 	},
 	"1F91E-1F3FE": Emoji{
 		Key:        "1F91E-1F3FE",
 		Value:      "🤞🏾",
 		Descriptor: "Crossed Fingers: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "3.0",
 	},
 	"1F91E-1F3FF": Emoji{
 		Key:        "1F91E-1F3FF",
 		Value:      "🤞🏿",
 		Descriptor: "Crossed Fingers: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "3.0",
 	},
 	"1F91F": Emoji{
 		Key:        "1F91F",
 		Value:      "🤟",
 		Descriptor: "Love-You Gesture",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "5.0",
 	},
 	"1F91F-1F3FB": Emoji{
 		Key:        "1F91F-1F3FB",
 		Value:      "🤟🏻",
 		Descriptor: "Love-You Gesture: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "5.0",
 	},
 	"1F91F-1F3FC": Emoji{
 		Key:        "1F91F-1F3FC",
 		Value:      "🤟🏼",
 		Descriptor: "Love-You Gesture: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "5.0",
 	},
 	"1F91F-1F3FD": Emoji{
 		Key:        "1F91F-1F3FD",
 		Value:      "🤟🏽",
 		Descriptor: "Love-You Gesture: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "5.0",
 	},
 	"1F91F-1F3FE": Emoji{
 		Key:        "1F91F-1F3FE",
 		Value:      "🤟🏾",
 		Descriptor: "Love-You Gesture: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "5.0",
 	},
 	"1F91F-1F3FF": Emoji{
 		Key:        "1F91F-1F3FF",
 		Value:      "🤟🏿",
 		Descriptor: "Love-You Gesture: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "5.0",
 	},
 	"1F920": Emoji{
 		Key:        "1F920",
 		Value:      "🤠",
 		Descriptor: "Cowboy Hat Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-hat",
+		Version:    "3.0",
 	},
 	"1F921": Emoji{
 		Key:        "1F921",
 		Value:      "🤡",
 		Descriptor: "Clown Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-costume",
+		Version:    "3.0",
 	},
 	"1F922": Emoji{
 		Key:        "1F922",
 		Value:      "🤢",
 		Descriptor: "Nauseated Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-unwell",
+		Version:    "3.0",
 	},
 	"1F923": Emoji{
 		Key:        "1F923",
 		Value:      "🤣",
 		Descriptor: "Rolling on the Floor Laughing",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-smiling",
+		Version:    "3.0",
 	},
 	"1F924": Emoji{
 		Key:        "1F924",
 		Value:      "🤤",
 		Descriptor: "Drooling Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-sleepy",
+		Version:    "3.0",
 	},
 	"1F925": Emoji{
 		Key:        "1F925",
 		Value:      "🤥",
 		Descriptor: "Lying Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-neutral-skeptical",
+		Version:    "3.0",
 	},
 	"1F926": Emoji{
 		Key:        "1F926",
 		Value:      "🤦",
 		Descriptor: "Person Facepalming",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "3.0",
 	},
 	"1F926-1F3FB": Emoji{
 		Key:        "1F926-1F3FB",
 		Value:      "🤦🏻",
 		Descriptor: "Person Facepalming: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "3.0",
 	},
 	"1F926-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F926-1F3FB-200D-2640-FE0F",
 		Value:      "🤦🏻‍♀️",
 		Descriptor: "Woman Facepalming: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F926-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F926-1F3FB-200D-2642-FE0F",
 		Value:      "🤦🏻‍♂️",
 		Descriptor: "Man Facepalming: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F926-1F3FC": Emoji{
 		Key:        "1F926-1F3FC",
 		Value:      "🤦🏼",
 		Descriptor: "Person Facepalming: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "3.0",
 	},
 	"1F926-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F926-1F3FC-200D-2640-FE0F",
 		Value:      "🤦🏼‍♀️",
 		Descriptor: "Woman Facepalming: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F926-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F926-1F3FC-200D-2642-FE0F",
 		Value:      "🤦🏼‍♂️",
 		Descriptor: "Man Facepalming: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F926-1F3FD": Emoji{
 		Key:        "1F926-1F3FD",
 		Value:      "🤦🏽",
 		Descriptor: "Person Facepalming: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "3.0",
 	},
 	"1F926-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F926-1F3FD-200D-2640-FE0F",
 		Value:      "🤦🏽‍♀️",
 		Descriptor: "Woman Facepalming: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F926-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F926-1F3FD-200D-2642-FE0F",
 		Value:      "🤦🏽‍♂️",
 		Descriptor: "Man Facepalming: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F926-1F3FE": Emoji{
 		Key:        "1F926-1F3FE",
 		Value:      "🤦🏾",
 		Descriptor: "Person Facepalming: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "3.0",
 	},
 	"1F926-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F926-1F3FE-200D-2640-FE0F",
 		Value:      "🤦🏾‍♀️",
 		Descriptor: "Woman Facepalming: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F926-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F926-1F3FE-200D-2642-FE0F",
 		Value:      "🤦🏾‍♂️",
 		Descriptor: "Man Facepalming: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F926-1F3FF": Emoji{
 		Key:        "1F926-1F3FF",
 		Value:      "🤦🏿",
 		Descriptor: "Person Facepalming: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "3.0",
 	},
 	"1F926-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F926-1F3FF-200D-2640-FE0F",
 		Value:      "🤦🏿‍♀️",
 		Descriptor: "Woman Facepalming: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F926-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F926-1F3FF-200D-2642-FE0F",
 		Value:      "🤦🏿‍♂️",
 		Descriptor: "Man Facepalming: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F926-200D-2640-FE0F": Emoji{
 		Key:        "1F926-200D-2640-FE0F",
 		Value:      "🤦‍♀️",
 		Descriptor: "Woman Facepalming",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F926-200D-2642-FE0F": Emoji{
 		Key:        "1F926-200D-2642-FE0F",
 		Value:      "🤦‍♂️",
 		Descriptor: "Man Facepalming",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F927": Emoji{
 		Key:        "1F927",
 		Value:      "🤧",
 		Descriptor: "Sneezing Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-unwell",
+		Version:    "3.0",
 	},
 	"1F928": Emoji{
 		Key:        "1F928",
 		Value:      "🤨",
 		Descriptor: "Face With Raised Eyebrow",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-neutral-skeptical",
+		Version:    "5.0",
 	},
 	"1F929": Emoji{
 		Key:        "1F929",
 		Value:      "🤩",
 		Descriptor: "Star-Struck",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-affection",
+		Version:    "5.0",
 	},
 	"1F92A": Emoji{
 		Key:        "1F92A",
 		Value:      "🤪",
 		Descriptor: "Zany Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-tongue",
+		Version:    "5.0",
 	},
 	"1F92B": Emoji{
 		Key:        "1F92B",
 		Value:      "🤫",
 		Descriptor: "Shushing Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-hand",
+		Version:    "5.0",
 	},
 	"1F92C": Emoji{
 		Key:        "1F92C",
 		Value:      "🤬",
 		Descriptor: "Face With Symbols on Mouth",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-negative",
+		Version:    "5.0",
 	},
 	"1F92D": Emoji{
 		Key:        "1F92D",
 		Value:      "🤭",
 		Descriptor: "Face With Hand Over Mouth",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-hand",
+		Version:    "5.0",
 	},
 	"1F92E": Emoji{
 		Key:        "1F92E",
 		Value:      "🤮",
 		Descriptor: "Face Vomiting",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-unwell",
+		Version:    "5.0",
 	},
 	"1F92F": Emoji{
 		Key:        "1F92F",
 		Value:      "🤯",
 		Descriptor: "Exploding Head",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-unwell",
+		Version:    "5.0",
 	},
 	"1F930": Emoji{
 		Key:        "1F930",
 		Value:      "🤰",
 		Descriptor: "Pregnant Woman",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "3.0",
 	},
 	"1F930-1F3FB": Emoji{
 		Key:        "1F930-1F3FB",
 		Value:      "🤰🏻",
 		Descriptor: "Pregnant Woman: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "3.0",
 	},
 	"1F930-1F3FC": Emoji{
 		Key:        "1F930-1F3FC",
 		Value:      "🤰🏼",
 		Descriptor: "Pregnant Woman: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "3.0",
 	},
 	"1F930-1F3FD": Emoji{
 		Key:        "1F930-1F3FD",
 		Value:      "🤰🏽",
 		Descriptor: "Pregnant Woman: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "3.0",
 	},
 	"1F930-1F3FE": Emoji{
 		Key:        "1F930-1F3FE",
 		Value:      "🤰🏾",
 		Descriptor: "Pregnant Woman: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "3.0",
 	},
 	"1F930-1F3FF": Emoji{
 		Key:        "1F930-1F3FF",
 		Value:      "🤰🏿",
 		Descriptor: "Pregnant Woman: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "3.0",
 	},
 	"1F931": Emoji{
 		Key:        "1F931",
 		Value:      "🤱",
 		Descriptor: "Breast-Feeding",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "5.0",
 	},
 	"1F931-1F3FB": Emoji{
 		Key:        "1F931-1F3FB",
 		Value:      "🤱🏻",
 		Descriptor: "Breast-Feeding: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "5.0",
 	},
 	"1F931-1F3FC": Emoji{
 		Key:        "1F931-1F3FC",
 		Value:      "🤱🏼",
 		Descriptor: "Breast-Feeding: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "5.0",
 	},
 	"1F931-1F3FD": Emoji{
 		Key:        "1F931-1F3FD",
 		Value:      "🤱🏽",
 		Descriptor: "Breast-Feeding: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "5.0",
 	},
 	"1F931-1F3FE": Emoji{
 		Key:        "1F931-1F3FE",
 		Value:      "🤱🏾",
 		Descriptor: "Breast-Feeding: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "5.0",
 	},
 	"1F931-1F3FF": Emoji{
 		Key:        "1F931-1F3FF",
 		Value:      "🤱🏿",
 		Descriptor: "Breast-Feeding: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "5.0",
 	},
 	"1F932": Emoji{
 		Key:        "1F932",
 		Value:      "🤲",
 		Descriptor: "Palms Up Together",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "5.0",
 	},
 	"1F932-1F3FB": Emoji{
 		Key:        "1F932-1F3FB",
 		Value:      "🤲🏻",
 		Descriptor: "Palms Up Together: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "5.0",
 	},
 	"1F932-1F3FC": Emoji{
 		Key:        "1F932-1F3FC",
 		Value:      "🤲🏼",
 		Descriptor: "Palms Up Together: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "5.0",
 	},
 	"1F932-1F3FD": Emoji{
 		Key:        "1F932-1F3FD",
 		Value:      "🤲🏽",
 		Descriptor: "Palms Up Together: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "5.0",
 	},
 	"1F932-1F3FE": Emoji{
 		Key:        "1F932-1F3FE",
 		Value:      "🤲🏾",
 		Descriptor: "Palms Up Together: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "5.0",
 	},
 	"1F932-1F3FF": Emoji{
 		Key:        "1F932-1F3FF",
 		Value:      "🤲🏿",
 		Descriptor: "Palms Up Together: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "5.0",
 	},
 	"1F933": Emoji{
 		Key:        "1F933",
 		Value:      "🤳",
 		Descriptor: "Selfie",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-prop",
+		Version:    "3.0",
 	},
 	"1F933-1F3FB": Emoji{
 		Key:        "1F933-1F3FB",
 		Value:      "🤳🏻",
 		Descriptor: "Selfie: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-prop",
+		Version:    "3.0",
 	},
 	"1F933-1F3FC": Emoji{
 		Key:        "1F933-1F3FC",
 		Value:      "🤳🏼",
 		Descriptor: "Selfie: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-prop",
+		Version:    "3.0",
 	},
 	"1F933-1F3FD": Emoji{
 		Key:        "1F933-1F3FD",
 		Value:      "🤳🏽",
 		Descriptor: "Selfie: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-prop",
+		Version:    "3.0",
 	},
 	"1F933-1F3FE": Emoji{
 		Key:        "1F933-1F3FE",
 		Value:      "🤳🏾",
 		Descriptor: "Selfie: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-prop",
+		Version:    "3.0",
 	},
 	"1F933-1F3FF": Emoji{
 		Key:        "1F933-1F3FF",
 		Value:      "🤳🏿",
 		Descriptor: "Selfie: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-prop",
+		Version:    "3.0",
 	},
 	"1F934": Emoji{
 		Key:        "1F934",
 		Value:      "🤴",
 		Descriptor: "Prince",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "3.0",
 	},
 	"1F934-1F3FB": Emoji{
 		Key:        "1F934-1F3FB",
 		Value:      "🤴🏻",
 		Descriptor: "Prince: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "3.0",
 	},
 	"1F934-1F3FC": Emoji{
 		Key:        "1F934-1F3FC",
 		Value:      "🤴🏼",
 		Descriptor: "Prince: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "3.0",
 	},
 	"1F934-1F3FD": Emoji{
 		Key:        "1F934-1F3FD",
 		Value:      "🤴🏽",
 		Descriptor: "Prince: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "3.0",
 	},
 	"1F934-1F3FE": Emoji{
 		Key:        "1F934-1F3FE",
 		Value:      "🤴🏾",
 		Descriptor: "Prince: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "3.0",
 	},
 	"1F934-1F3FF": Emoji{
 		Key:        "1F934-1F3FF",
 		Value:      "🤴🏿",
 		Descriptor: "Prince: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "3.0",
 	},
 	"1F935": Emoji{
 		Key:        "1F935",
 		Value:      "🤵",
-		Descriptor: "Man in Tuxedo",
+		Descriptor: "Person in Tuxedo",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "3.0",
 	},
 	"1F935-1F3FB": Emoji{
 		Key:        "1F935-1F3FB",
 		Value:      "🤵🏻",
+		Descriptor: "Person in Tuxedo: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "3.0",
+	},
+	"1F935-1F3FB-200D-2640-FE0F": Emoji{
+		Key:        "1F935-1F3FB-200D-2640-FE0F",
+		Value:      "🤵🏻‍♀️",
+		Descriptor: "Woman in Tuxedo: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F935-1F3FB-200D-2642-FE0F": Emoji{
+		Key:        "1F935-1F3FB-200D-2642-FE0F",
+		Value:      "🤵🏻‍♂️",
 		Descriptor: "Man in Tuxedo: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
 	},
 	"1F935-1F3FC": Emoji{
 		Key:        "1F935-1F3FC",
 		Value:      "🤵🏼",
+		Descriptor: "Person in Tuxedo: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "3.0",
+	},
+	"1F935-1F3FC-200D-2640-FE0F": Emoji{
+		Key:        "1F935-1F3FC-200D-2640-FE0F",
+		Value:      "🤵🏼‍♀️",
+		Descriptor: "Woman in Tuxedo: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F935-1F3FC-200D-2642-FE0F": Emoji{
+		Key:        "1F935-1F3FC-200D-2642-FE0F",
+		Value:      "🤵🏼‍♂️",
 		Descriptor: "Man in Tuxedo: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
 	},
 	"1F935-1F3FD": Emoji{
 		Key:        "1F935-1F3FD",
 		Value:      "🤵🏽",
+		Descriptor: "Person in Tuxedo: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "3.0",
+	},
+	"1F935-1F3FD-200D-2640-FE0F": Emoji{
+		Key:        "1F935-1F3FD-200D-2640-FE0F",
+		Value:      "🤵🏽‍♀️",
+		Descriptor: "Woman in Tuxedo: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F935-1F3FD-200D-2642-FE0F": Emoji{
+		Key:        "1F935-1F3FD-200D-2642-FE0F",
+		Value:      "🤵🏽‍♂️",
 		Descriptor: "Man in Tuxedo: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
 	},
 	"1F935-1F3FE": Emoji{
 		Key:        "1F935-1F3FE",
 		Value:      "🤵🏾",
+		Descriptor: "Person in Tuxedo: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "3.0",
+	},
+	"1F935-1F3FE-200D-2640-FE0F": Emoji{
+		Key:        "1F935-1F3FE-200D-2640-FE0F",
+		Value:      "🤵🏾‍♀️",
+		Descriptor: "Woman in Tuxedo: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F935-1F3FE-200D-2642-FE0F": Emoji{
+		Key:        "1F935-1F3FE-200D-2642-FE0F",
+		Value:      "🤵🏾‍♂️",
 		Descriptor: "Man in Tuxedo: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
 	},
 	"1F935-1F3FF": Emoji{
 		Key:        "1F935-1F3FF",
 		Value:      "🤵🏿",
+		Descriptor: "Person in Tuxedo: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "3.0",
+	},
+	"1F935-1F3FF-200D-2640-FE0F": Emoji{
+		Key:        "1F935-1F3FF-200D-2640-FE0F",
+		Value:      "🤵🏿‍♀️",
+		Descriptor: "Woman in Tuxedo: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F935-1F3FF-200D-2642-FE0F": Emoji{
+		Key:        "1F935-1F3FF-200D-2642-FE0F",
+		Value:      "🤵🏿‍♂️",
 		Descriptor: "Man in Tuxedo: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F935-200D-2640-FE0F": Emoji{
+		Key:        "1F935-200D-2640-FE0F",
+		Value:      "🤵‍♀️",
+		Descriptor: "Woman in Tuxedo",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F935-200D-2642-FE0F": Emoji{
+		Key:        "1F935-200D-2642-FE0F",
+		Value:      "🤵‍♂️",
+		Descriptor: "Man in Tuxedo",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
 	},
 	"1F936": Emoji{
 		Key:        "1F936",
 		Value:      "🤶",
 		Descriptor: "Mrs. Claus",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "3.0",
 	},
 	"1F936-1F3FB": Emoji{
 		Key:        "1F936-1F3FB",
 		Value:      "🤶🏻",
 		Descriptor: "Mrs. Claus: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "3.0",
 	},
 	"1F936-1F3FC": Emoji{
 		Key:        "1F936-1F3FC",
 		Value:      "🤶🏼",
 		Descriptor: "Mrs. Claus: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "3.0",
 	},
 	"1F936-1F3FD": Emoji{
 		Key:        "1F936-1F3FD",
 		Value:      "🤶🏽",
 		Descriptor: "Mrs. Claus: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "3.0",
 	},
 	"1F936-1F3FE": Emoji{
 		Key:        "1F936-1F3FE",
 		Value:      "🤶🏾",
 		Descriptor: "Mrs. Claus: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "3.0",
 	},
 	"1F936-1F3FF": Emoji{
 		Key:        "1F936-1F3FF",
 		Value:      "🤶🏿",
 		Descriptor: "Mrs. Claus: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "3.0",
 	},
 	"1F937": Emoji{
 		Key:        "1F937",
 		Value:      "🤷",
 		Descriptor: "Person Shrugging",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "3.0",
 	},
 	"1F937-1F3FB": Emoji{
 		Key:        "1F937-1F3FB",
 		Value:      "🤷🏻",
 		Descriptor: "Person Shrugging: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "3.0",
 	},
 	"1F937-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F937-1F3FB-200D-2640-FE0F",
 		Value:      "🤷🏻‍♀️",
 		Descriptor: "Woman Shrugging: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F937-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F937-1F3FB-200D-2642-FE0F",
 		Value:      "🤷🏻‍♂️",
 		Descriptor: "Man Shrugging: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F937-1F3FC": Emoji{
 		Key:        "1F937-1F3FC",
 		Value:      "🤷🏼",
 		Descriptor: "Person Shrugging: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "3.0",
 	},
 	"1F937-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F937-1F3FC-200D-2640-FE0F",
 		Value:      "🤷🏼‍♀️",
 		Descriptor: "Woman Shrugging: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F937-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F937-1F3FC-200D-2642-FE0F",
 		Value:      "🤷🏼‍♂️",
 		Descriptor: "Man Shrugging: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F937-1F3FD": Emoji{
 		Key:        "1F937-1F3FD",
 		Value:      "🤷🏽",
 		Descriptor: "Person Shrugging: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "3.0",
 	},
 	"1F937-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F937-1F3FD-200D-2640-FE0F",
 		Value:      "🤷🏽‍♀️",
 		Descriptor: "Woman Shrugging: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F937-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F937-1F3FD-200D-2642-FE0F",
 		Value:      "🤷🏽‍♂️",
 		Descriptor: "Man Shrugging: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F937-1F3FE": Emoji{
 		Key:        "1F937-1F3FE",
 		Value:      "🤷🏾",
 		Descriptor: "Person Shrugging: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "3.0",
 	},
 	"1F937-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F937-1F3FE-200D-2640-FE0F",
 		Value:      "🤷🏾‍♀️",
 		Descriptor: "Woman Shrugging: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F937-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F937-1F3FE-200D-2642-FE0F",
 		Value:      "🤷🏾‍♂️",
 		Descriptor: "Man Shrugging: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F937-1F3FF": Emoji{
 		Key:        "1F937-1F3FF",
 		Value:      "🤷🏿",
 		Descriptor: "Person Shrugging: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "3.0",
 	},
 	"1F937-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F937-1F3FF-200D-2640-FE0F",
 		Value:      "🤷🏿‍♀️",
 		Descriptor: "Woman Shrugging: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F937-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F937-1F3FF-200D-2642-FE0F",
 		Value:      "🤷🏿‍♂️",
 		Descriptor: "Man Shrugging: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F937-200D-2640-FE0F": Emoji{
 		Key:        "1F937-200D-2640-FE0F",
 		Value:      "🤷‍♀️",
 		Descriptor: "Woman Shrugging",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F937-200D-2642-FE0F": Emoji{
 		Key:        "1F937-200D-2642-FE0F",
 		Value:      "🤷‍♂️",
 		Descriptor: "Man Shrugging",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "4.0",
 	},
 	"1F938": Emoji{
 		Key:        "1F938",
 		Value:      "🤸",
 		Descriptor: "Person Cartwheeling",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F938-1F3FB": Emoji{
 		Key:        "1F938-1F3FB",
 		Value:      "🤸🏻",
 		Descriptor: "Person Cartwheeling: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F938-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F938-1F3FB-200D-2640-FE0F",
 		Value:      "🤸🏻‍♀️",
 		Descriptor: "Woman Cartwheeling: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F938-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F938-1F3FB-200D-2642-FE0F",
 		Value:      "🤸🏻‍♂️",
 		Descriptor: "Man Cartwheeling: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F938-1F3FC": Emoji{
 		Key:        "1F938-1F3FC",
 		Value:      "🤸🏼",
 		Descriptor: "Person Cartwheeling: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F938-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F938-1F3FC-200D-2640-FE0F",
 		Value:      "🤸🏼‍♀️",
 		Descriptor: "Woman Cartwheeling: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F938-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F938-1F3FC-200D-2642-FE0F",
 		Value:      "🤸🏼‍♂️",
 		Descriptor: "Man Cartwheeling: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F938-1F3FD": Emoji{
 		Key:        "1F938-1F3FD",
 		Value:      "🤸🏽",
 		Descriptor: "Person Cartwheeling: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F938-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F938-1F3FD-200D-2640-FE0F",
 		Value:      "🤸🏽‍♀️",
 		Descriptor: "Woman Cartwheeling: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F938-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F938-1F3FD-200D-2642-FE0F",
 		Value:      "🤸🏽‍♂️",
 		Descriptor: "Man Cartwheeling: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F938-1F3FE": Emoji{
 		Key:        "1F938-1F3FE",
 		Value:      "🤸🏾",
 		Descriptor: "Person Cartwheeling: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F938-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F938-1F3FE-200D-2640-FE0F",
 		Value:      "🤸🏾‍♀️",
 		Descriptor: "Woman Cartwheeling: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F938-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F938-1F3FE-200D-2642-FE0F",
 		Value:      "🤸🏾‍♂️",
 		Descriptor: "Man Cartwheeling: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F938-1F3FF": Emoji{
 		Key:        "1F938-1F3FF",
 		Value:      "🤸🏿",
 		Descriptor: "Person Cartwheeling: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F938-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F938-1F3FF-200D-2640-FE0F",
 		Value:      "🤸🏿‍♀️",
 		Descriptor: "Woman Cartwheeling: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F938-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F938-1F3FF-200D-2642-FE0F",
 		Value:      "🤸🏿‍♂️",
 		Descriptor: "Man Cartwheeling: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F938-200D-2640-FE0F": Emoji{
 		Key:        "1F938-200D-2640-FE0F",
 		Value:      "🤸‍♀️",
 		Descriptor: "Woman Cartwheeling",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F938-200D-2642-FE0F": Emoji{
 		Key:        "1F938-200D-2642-FE0F",
 		Value:      "🤸‍♂️",
 		Descriptor: "Man Cartwheeling",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F939": Emoji{
 		Key:        "1F939",
 		Value:      "🤹",
 		Descriptor: "Person Juggling",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F939-1F3FB": Emoji{
 		Key:        "1F939-1F3FB",
 		Value:      "🤹🏻",
 		Descriptor: "Person Juggling: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F939-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F939-1F3FB-200D-2640-FE0F",
 		Value:      "🤹🏻‍♀️",
 		Descriptor: "Woman Juggling: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F939-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F939-1F3FB-200D-2642-FE0F",
 		Value:      "🤹🏻‍♂️",
 		Descriptor: "Man Juggling: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F939-1F3FC": Emoji{
 		Key:        "1F939-1F3FC",
 		Value:      "🤹🏼",
 		Descriptor: "Person Juggling: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F939-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F939-1F3FC-200D-2640-FE0F",
 		Value:      "🤹🏼‍♀️",
 		Descriptor: "Woman Juggling: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F939-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F939-1F3FC-200D-2642-FE0F",
 		Value:      "🤹🏼‍♂️",
 		Descriptor: "Man Juggling: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F939-1F3FD": Emoji{
 		Key:        "1F939-1F3FD",
 		Value:      "🤹🏽",
 		Descriptor: "Person Juggling: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F939-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F939-1F3FD-200D-2640-FE0F",
 		Value:      "🤹🏽‍♀️",
 		Descriptor: "Woman Juggling: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F939-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F939-1F3FD-200D-2642-FE0F",
 		Value:      "🤹🏽‍♂️",
 		Descriptor: "Man Juggling: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F939-1F3FE": Emoji{
 		Key:        "1F939-1F3FE",
 		Value:      "🤹🏾",
 		Descriptor: "Person Juggling: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F939-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F939-1F3FE-200D-2640-FE0F",
 		Value:      "🤹🏾‍♀️",
 		Descriptor: "Woman Juggling: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F939-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F939-1F3FE-200D-2642-FE0F",
 		Value:      "🤹🏾‍♂️",
 		Descriptor: "Man Juggling: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F939-1F3FF": Emoji{
 		Key:        "1F939-1F3FF",
 		Value:      "🤹🏿",
 		Descriptor: "Person Juggling: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F939-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F939-1F3FF-200D-2640-FE0F",
 		Value:      "🤹🏿‍♀️",
 		Descriptor: "Woman Juggling: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F939-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F939-1F3FF-200D-2642-FE0F",
 		Value:      "🤹🏿‍♂️",
 		Descriptor: "Man Juggling: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F939-200D-2640-FE0F": Emoji{
 		Key:        "1F939-200D-2640-FE0F",
 		Value:      "🤹‍♀️",
 		Descriptor: "Woman Juggling",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F939-200D-2642-FE0F": Emoji{
 		Key:        "1F939-200D-2642-FE0F",
 		Value:      "🤹‍♂️",
 		Descriptor: "Man Juggling",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93A": Emoji{
 		Key:        "1F93A",
 		Value:      "🤺",
 		Descriptor: "Person Fencing",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F93C": Emoji{
 		Key:        "1F93C",
 		Value:      "🤼",
 		Descriptor: "People Wrestling",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F93C-200D-2640-FE0F": Emoji{
 		Key:        "1F93C-200D-2640-FE0F",
 		Value:      "🤼‍♀️",
 		Descriptor: "Women Wrestling",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93C-200D-2642-FE0F": Emoji{
 		Key:        "1F93C-200D-2642-FE0F",
 		Value:      "🤼‍♂️",
 		Descriptor: "Men Wrestling",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93D": Emoji{
 		Key:        "1F93D",
 		Value:      "🤽",
 		Descriptor: "Person Playing Water Polo",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F93D-1F3FB": Emoji{
 		Key:        "1F93D-1F3FB",
 		Value:      "🤽🏻",
 		Descriptor: "Person Playing Water Polo: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F93D-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F93D-1F3FB-200D-2640-FE0F",
 		Value:      "🤽🏻‍♀️",
 		Descriptor: "Woman Playing Water Polo: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93D-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F93D-1F3FB-200D-2642-FE0F",
 		Value:      "🤽🏻‍♂️",
 		Descriptor: "Man Playing Water Polo: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93D-1F3FC": Emoji{
 		Key:        "1F93D-1F3FC",
 		Value:      "🤽🏼",
 		Descriptor: "Person Playing Water Polo: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F93D-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F93D-1F3FC-200D-2640-FE0F",
 		Value:      "🤽🏼‍♀️",
 		Descriptor: "Woman Playing Water Polo: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93D-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F93D-1F3FC-200D-2642-FE0F",
 		Value:      "🤽🏼‍♂️",
 		Descriptor: "Man Playing Water Polo: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93D-1F3FD": Emoji{
 		Key:        "1F93D-1F3FD",
 		Value:      "🤽🏽",
 		Descriptor: "Person Playing Water Polo: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F93D-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F93D-1F3FD-200D-2640-FE0F",
 		Value:      "🤽🏽‍♀️",
 		Descriptor: "Woman Playing Water Polo: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93D-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F93D-1F3FD-200D-2642-FE0F",
 		Value:      "🤽🏽‍♂️",
 		Descriptor: "Man Playing Water Polo: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93D-1F3FE": Emoji{
 		Key:        "1F93D-1F3FE",
 		Value:      "🤽🏾",
 		Descriptor: "Person Playing Water Polo: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F93D-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F93D-1F3FE-200D-2640-FE0F",
 		Value:      "🤽🏾‍♀️",
 		Descriptor: "Woman Playing Water Polo: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93D-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F93D-1F3FE-200D-2642-FE0F",
 		Value:      "🤽🏾‍♂️",
 		Descriptor: "Man Playing Water Polo: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93D-1F3FF": Emoji{
 		Key:        "1F93D-1F3FF",
 		Value:      "🤽🏿",
 		Descriptor: "Person Playing Water Polo: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F93D-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F93D-1F3FF-200D-2640-FE0F",
 		Value:      "🤽🏿‍♀️",
 		Descriptor: "Woman Playing Water Polo: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93D-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F93D-1F3FF-200D-2642-FE0F",
 		Value:      "🤽🏿‍♂️",
 		Descriptor: "Man Playing Water Polo: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93D-200D-2640-FE0F": Emoji{
 		Key:        "1F93D-200D-2640-FE0F",
 		Value:      "🤽‍♀️",
 		Descriptor: "Woman Playing Water Polo",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93D-200D-2642-FE0F": Emoji{
 		Key:        "1F93D-200D-2642-FE0F",
 		Value:      "🤽‍♂️",
 		Descriptor: "Man Playing Water Polo",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93E": Emoji{
 		Key:        "1F93E",
 		Value:      "🤾",
 		Descriptor: "Person Playing Handball",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F93E-1F3FB": Emoji{
 		Key:        "1F93E-1F3FB",
 		Value:      "🤾🏻",
 		Descriptor: "Person Playing Handball: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F93E-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F93E-1F3FB-200D-2640-FE0F",
 		Value:      "🤾🏻‍♀️",
 		Descriptor: "Woman Playing Handball: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93E-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F93E-1F3FB-200D-2642-FE0F",
 		Value:      "🤾🏻‍♂️",
 		Descriptor: "Man Playing Handball: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93E-1F3FC": Emoji{
 		Key:        "1F93E-1F3FC",
 		Value:      "🤾🏼",
 		Descriptor: "Person Playing Handball: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F93E-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F93E-1F3FC-200D-2640-FE0F",
 		Value:      "🤾🏼‍♀️",
 		Descriptor: "Woman Playing Handball: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93E-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F93E-1F3FC-200D-2642-FE0F",
 		Value:      "🤾🏼‍♂️",
 		Descriptor: "Man Playing Handball: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93E-1F3FD": Emoji{
 		Key:        "1F93E-1F3FD",
 		Value:      "🤾🏽",
 		Descriptor: "Person Playing Handball: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F93E-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F93E-1F3FD-200D-2640-FE0F",
 		Value:      "🤾🏽‍♀️",
 		Descriptor: "Woman Playing Handball: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93E-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F93E-1F3FD-200D-2642-FE0F",
 		Value:      "🤾🏽‍♂️",
 		Descriptor: "Man Playing Handball: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93E-1F3FE": Emoji{
 		Key:        "1F93E-1F3FE",
 		Value:      "🤾🏾",
 		Descriptor: "Person Playing Handball: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F93E-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F93E-1F3FE-200D-2640-FE0F",
 		Value:      "🤾🏾‍♀️",
 		Descriptor: "Woman Playing Handball: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93E-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F93E-1F3FE-200D-2642-FE0F",
 		Value:      "🤾🏾‍♂️",
 		Descriptor: "Man Playing Handball: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93E-1F3FF": Emoji{
 		Key:        "1F93E-1F3FF",
 		Value:      "🤾🏿",
 		Descriptor: "Person Playing Handball: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "3.0",
 	},
 	"1F93E-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F93E-1F3FF-200D-2640-FE0F",
 		Value:      "🤾🏿‍♀️",
 		Descriptor: "Woman Playing Handball: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93E-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F93E-1F3FF-200D-2642-FE0F",
 		Value:      "🤾🏿‍♂️",
 		Descriptor: "Man Playing Handball: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93E-200D-2640-FE0F": Emoji{
 		Key:        "1F93E-200D-2640-FE0F",
 		Value:      "🤾‍♀️",
 		Descriptor: "Woman Playing Handball",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"1F93E-200D-2642-FE0F": Emoji{
 		Key:        "1F93E-200D-2642-FE0F",
 		Value:      "🤾‍♂️",
 		Descriptor: "Man Playing Handball",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
+	},
+	"1F93F": Emoji{
+		Key:        "1F93F",
+		Value:      "🤿",
+		Descriptor: "Diving Mask",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "12.0",
 	},
 	"1F940": Emoji{
 		Key:        "1F940",
 		Value:      "🥀",
 		Descriptor: "Wilted Flower",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-flower",
+		Version:    "3.0",
 	},
 	"1F941": Emoji{
 		Key:        "1F941",
 		Value:      "🥁",
 		Descriptor: "Drum",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "musical-instrument",
+		Version:    "3.0",
 	},
 	"1F942": Emoji{
 		Key:        "1F942",
 		Value:      "🥂",
 		Descriptor: "Clinking Glasses",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "drink",
+		Version:    "3.0",
 	},
 	"1F943": Emoji{
 		Key:        "1F943",
 		Value:      "🥃",
 		Descriptor: "Tumbler Glass",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "drink",
+		Version:    "3.0",
 	},
 	"1F944": Emoji{
 		Key:        "1F944",
 		Value:      "🥄",
 		Descriptor: "Spoon",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "dishware",
+		Version:    "3.0",
 	},
 	"1F945": Emoji{
 		Key:        "1F945",
 		Value:      "🥅",
 		Descriptor: "Goal Net",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "3.0",
 	},
 	"1F947": Emoji{
 		Key:        "1F947",
 		Value:      "🥇",
-		Descriptor: "1st Place Medal",
+		Descriptor: "1St Place Medal",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "award-medal",
+		Version:    "3.0",
 	},
 	"1F948": Emoji{
 		Key:        "1F948",
 		Value:      "🥈",
-		Descriptor: "2nd Place Medal",
+		Descriptor: "2Nd Place Medal",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "award-medal",
+		Version:    "3.0",
 	},
 	"1F949": Emoji{
 		Key:        "1F949",
 		Value:      "🥉",
-		Descriptor: "3rd Place Medal",
+		Descriptor: "3Rd Place Medal",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "award-medal",
+		Version:    "3.0",
 	},
 	"1F94A": Emoji{
 		Key:        "1F94A",
 		Value:      "🥊",
 		Descriptor: "Boxing Glove",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "3.0",
 	},
 	"1F94B": Emoji{
 		Key:        "1F94B",
 		Value:      "🥋",
 		Descriptor: "Martial Arts Uniform",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "3.0",
 	},
 	"1F94C": Emoji{
 		Key:        "1F94C",
 		Value:      "🥌",
 		Descriptor: "Curling Stone",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "5.0",
 	},
 	"1F94D": Emoji{
 		Key:        "1F94D",
 		Value:      "🥍",
 		Descriptor: "Lacrosse",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "11.0",
 	},
 	"1F94E": Emoji{
 		Key:        "1F94E",
 		Value:      "🥎",
 		Descriptor: "Softball",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "11.0",
 	},
 	"1F94F": Emoji{
 		Key:        "1F94F",
 		Value:      "🥏",
 		Descriptor: "Flying Disc",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "11.0",
 	},
 	"1F950": Emoji{
 		Key:        "1F950",
 		Value:      "🥐",
 		Descriptor: "Croissant",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "3.0",
 	},
 	"1F951": Emoji{
 		Key:        "1F951",
 		Value:      "🥑",
 		Descriptor: "Avocado",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-vegetable",
+		Version:    "3.0",
 	},
 	"1F952": Emoji{
 		Key:        "1F952",
 		Value:      "🥒",
 		Descriptor: "Cucumber",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-vegetable",
+		Version:    "3.0",
 	},
 	"1F953": Emoji{
 		Key:        "1F953",
 		Value:      "🥓",
 		Descriptor: "Bacon",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "3.0",
 	},
 	"1F954": Emoji{
 		Key:        "1F954",
 		Value:      "🥔",
 		Descriptor: "Potato",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-vegetable",
+		Version:    "3.0",
 	},
 	"1F955": Emoji{
 		Key:        "1F955",
 		Value:      "🥕",
 		Descriptor: "Carrot",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-vegetable",
+		Version:    "3.0",
 	},
 	"1F956": Emoji{
 		Key:        "1F956",
 		Value:      "🥖",
 		Descriptor: "Baguette Bread",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "3.0",
 	},
 	"1F957": Emoji{
 		Key:        "1F957",
 		Value:      "🥗",
 		Descriptor: "Green Salad",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "3.0",
 	},
 	"1F958": Emoji{
 		Key:        "1F958",
 		Value:      "🥘",
 		Descriptor: "Shallow Pan of Food",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "3.0",
 	},
 	"1F959": Emoji{
 		Key:        "1F959",
 		Value:      "🥙",
 		Descriptor: "Stuffed Flatbread",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "3.0",
 	},
 	"1F95A": Emoji{
 		Key:        "1F95A",
 		Value:      "🥚",
 		Descriptor: "Egg",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "3.0",
 	},
 	"1F95B": Emoji{
 		Key:        "1F95B",
 		Value:      "🥛",
 		Descriptor: "Glass of Milk",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "drink",
+		Version:    "3.0",
 	},
 	"1F95C": Emoji{
 		Key:        "1F95C",
 		Value:      "🥜",
 		Descriptor: "Peanuts",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-vegetable",
+		Version:    "3.0",
 	},
 	"1F95D": Emoji{
 		Key:        "1F95D",
 		Value:      "🥝",
 		Descriptor: "Kiwi Fruit",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-fruit",
+		Version:    "3.0",
 	},
 	"1F95E": Emoji{
 		Key:        "1F95E",
 		Value:      "🥞",
 		Descriptor: "Pancakes",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "3.0",
 	},
 	"1F95F": Emoji{
 		Key:        "1F95F",
 		Value:      "🥟",
 		Descriptor: "Dumpling",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-asian",
+		Version:    "5.0",
 	},
 	"1F960": Emoji{
 		Key:        "1F960",
 		Value:      "🥠",
 		Descriptor: "Fortune Cookie",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-asian",
+		Version:    "5.0",
 	},
 	"1F961": Emoji{
 		Key:        "1F961",
 		Value:      "🥡",
 		Descriptor: "Takeout Box",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-asian",
+		Version:    "5.0",
 	},
 	"1F962": Emoji{
 		Key:        "1F962",
 		Value:      "🥢",
 		Descriptor: "Chopsticks",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "dishware",
+		Version:    "5.0",
 	},
 	"1F963": Emoji{
 		Key:        "1F963",
 		Value:      "🥣",
 		Descriptor: "Bowl With Spoon",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "5.0",
 	},
 	"1F964": Emoji{
 		Key:        "1F964",
 		Value:      "🥤",
 		Descriptor: "Cup With Straw",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "drink",
+		Version:    "5.0",
 	},
 	"1F965": Emoji{
 		Key:        "1F965",
 		Value:      "🥥",
 		Descriptor: "Coconut",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-fruit",
+		Version:    "5.0",
 	},
 	"1F966": Emoji{
 		Key:        "1F966",
 		Value:      "🥦",
 		Descriptor: "Broccoli",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-vegetable",
+		Version:    "5.0",
 	},
 	"1F967": Emoji{
 		Key:        "1F967",
 		Value:      "🥧",
 		Descriptor: "Pie",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-sweet",
+		Version:    "5.0",
 	},
 	"1F968": Emoji{
 		Key:        "1F968",
 		Value:      "🥨",
 		Descriptor: "Pretzel",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "5.0",
 	},
 	"1F969": Emoji{
 		Key:        "1F969",
 		Value:      "🥩",
 		Descriptor: "Cut of Meat",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "5.0",
 	},
 	"1F96A": Emoji{
 		Key:        "1F96A",
 		Value:      "🥪",
 		Descriptor: "Sandwich",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "5.0",
 	},
 	"1F96B": Emoji{
 		Key:        "1F96B",
 		Value:      "🥫",
 		Descriptor: "Canned Food",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "5.0",
 	},
 	"1F96C": Emoji{
 		Key:        "1F96C",
 		Value:      "🥬",
 		Descriptor: "Leafy Green",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-vegetable",
+		Version:    "11.0",
 	},
 	"1F96D": Emoji{
 		Key:        "1F96D",
 		Value:      "🥭",
 		Descriptor: "Mango",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-fruit",
+		Version:    "11.0",
 	},
 	"1F96E": Emoji{
 		Key:        "1F96E",
 		Value:      "🥮",
 		Descriptor: "Moon Cake",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-asian",
+		Version:    "11.0",
 	},
 	"1F96F": Emoji{
 		Key:        "1F96F",
 		Value:      "🥯",
 		Descriptor: "Bagel",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "11.0",
 	},
 	"1F970": Emoji{
 		Key:        "1F970",
 		Value:      "🥰",
 		Descriptor: "Smiling Face With Hearts",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-affection",
+		Version:    "11.0",
+	},
+	"1F971": Emoji{
+		Key:        "1F971",
+		Value:      "🥱",
+		Descriptor: "Yawning Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "12.0",
+	},
+	"1F972": Emoji{
+		Key:        "1F972",
+		Value:      "🥲",
+		Descriptor: "Smiling Face With Tear",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-affection",
+		Version:    "13.0",
 	},
 	"1F973": Emoji{
 		Key:        "1F973",
 		Value:      "🥳",
 		Descriptor: "Partying Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-hat",
+		Version:    "11.0",
 	},
 	"1F974": Emoji{
 		Key:        "1F974",
 		Value:      "🥴",
 		Descriptor: "Woozy Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-unwell",
+		Version:    "11.0",
 	},
 	"1F975": Emoji{
 		Key:        "1F975",
 		Value:      "🥵",
 		Descriptor: "Hot Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-unwell",
+		Version:    "11.0",
 	},
 	"1F976": Emoji{
 		Key:        "1F976",
 		Value:      "🥶",
 		Descriptor: "Cold Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-unwell",
+		Version:    "11.0",
+	},
+	"1F977": Emoji{
+		Key:        "1F977",
+		Value:      "🥷",
+		Descriptor: "Ninja",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F977-1F3FB": Emoji{
+		Key:        "1F977-1F3FB",
+		Value:      "🥷🏻",
+		Descriptor: "Ninja: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F977-1F3FC": Emoji{
+		Key:        "1F977-1F3FC",
+		Value:      "🥷🏼",
+		Descriptor: "Ninja: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F977-1F3FD": Emoji{
+		Key:        "1F977-1F3FD",
+		Value:      "🥷🏽",
+		Descriptor: "Ninja: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F977-1F3FE": Emoji{
+		Key:        "1F977-1F3FE",
+		Value:      "🥷🏾",
+		Descriptor: "Ninja: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F977-1F3FF": Emoji{
+		Key:        "1F977-1F3FF",
+		Value:      "🥷🏿",
+		Descriptor: "Ninja: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F978": Emoji{
+		Key:        "1F978",
+		Value:      "🥸",
+		Descriptor: "Disguised Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-hat",
+		Version:    "13.0",
+	},
+	"1F979": Emoji{
+		Key:        "1F979",
+		Value:      "🥹",
+		Descriptor: "Face Holding Back Tears",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "14.0",
 	},
 	"1F97A": Emoji{
 		Key:        "1F97A",
 		Value:      "🥺",
 		Descriptor: "Pleading Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "11.0",
+	},
+	"1F97B": Emoji{
+		Key:        "1F97B",
+		Value:      "🥻",
+		Descriptor: "Sari",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "12.0",
 	},
 	"1F97C": Emoji{
 		Key:        "1F97C",
 		Value:      "🥼",
 		Descriptor: "Lab Coat",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "11.0",
 	},
 	"1F97D": Emoji{
 		Key:        "1F97D",
 		Value:      "🥽",
 		Descriptor: "Goggles",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "11.0",
 	},
 	"1F97E": Emoji{
 		Key:        "1F97E",
 		Value:      "🥾",
 		Descriptor: "Hiking Boot",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "11.0",
 	},
 	"1F97F": Emoji{
 		Key:        "1F97F",
 		Value:      "🥿",
 		Descriptor: "Flat Shoe",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "11.0",
 	},
 	"1F980": Emoji{
 		Key:        "1F980",
 		Value:      "🦀",
 		Descriptor: "Crab",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-marine",
+		Version:    "1.0",
 	},
 	"1F981": Emoji{
 		Key:        "1F981",
 		Value:      "🦁",
-		Descriptor: "Lion Face",
+		Descriptor: "Lion",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "1.0",
 	},
 	"1F982": Emoji{
 		Key:        "1F982",
 		Value:      "🦂",
 		Descriptor: "Scorpion",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bug",
+		Version:    "1.0",
 	},
 	"1F983": Emoji{
 		Key:        "1F983",
 		Value:      "🦃",
 		Descriptor: "Turkey",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bird",
+		Version:    "1.0",
 	},
 	"1F984": Emoji{
 		Key:        "1F984",
 		Value:      "🦄",
-		Descriptor: "Unicorn Face",
+		Descriptor: "Unicorn",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "1.0",
 	},
 	"1F985": Emoji{
 		Key:        "1F985",
 		Value:      "🦅",
 		Descriptor: "Eagle",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bird",
+		Version:    "3.0",
 	},
 	"1F986": Emoji{
 		Key:        "1F986",
 		Value:      "🦆",
 		Descriptor: "Duck",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bird",
+		Version:    "3.0",
 	},
 	"1F987": Emoji{
 		Key:        "1F987",
 		Value:      "🦇",
 		Descriptor: "Bat",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "3.0",
 	},
 	"1F988": Emoji{
 		Key:        "1F988",
 		Value:      "🦈",
 		Descriptor: "Shark",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-marine",
+		Version:    "3.0",
 	},
 	"1F989": Emoji{
 		Key:        "1F989",
 		Value:      "🦉",
 		Descriptor: "Owl",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bird",
+		Version:    "3.0",
 	},
 	"1F98A": Emoji{
 		Key:        "1F98A",
 		Value:      "🦊",
-		Descriptor: "Fox Face",
+		Descriptor: "Fox",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "3.0",
 	},
 	"1F98B": Emoji{
 		Key:        "1F98B",
 		Value:      "🦋",
 		Descriptor: "Butterfly",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bug",
+		Version:    "3.0",
 	},
 	"1F98C": Emoji{
 		Key:        "1F98C",
 		Value:      "🦌",
 		Descriptor: "Deer",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "3.0",
 	},
 	"1F98D": Emoji{
 		Key:        "1F98D",
 		Value:      "🦍",
 		Descriptor: "Gorilla",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "3.0",
 	},
 	"1F98E": Emoji{
 		Key:        "1F98E",
 		Value:      "🦎",
 		Descriptor: "Lizard",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-reptile",
+		Version:    "3.0",
 	},
 	"1F98F": Emoji{
 		Key:        "1F98F",
 		Value:      "🦏",
 		Descriptor: "Rhinoceros",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "3.0",
 	},
 	"1F990": Emoji{
 		Key:        "1F990",
 		Value:      "🦐",
 		Descriptor: "Shrimp",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-marine",
+		Version:    "3.0",
 	},
 	"1F991": Emoji{
 		Key:        "1F991",
 		Value:      "🦑",
 		Descriptor: "Squid",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-marine",
+		Version:    "3.0",
 	},
 	"1F992": Emoji{
 		Key:        "1F992",
 		Value:      "🦒",
 		Descriptor: "Giraffe",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "5.0",
 	},
 	"1F993": Emoji{
 		Key:        "1F993",
 		Value:      "🦓",
 		Descriptor: "Zebra",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "5.0",
 	},
 	"1F994": Emoji{
 		Key:        "1F994",
 		Value:      "🦔",
 		Descriptor: "Hedgehog",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "5.0",
 	},
 	"1F995": Emoji{
 		Key:        "1F995",
 		Value:      "🦕",
 		Descriptor: "Sauropod",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-reptile",
+		Version:    "5.0",
 	},
 	"1F996": Emoji{
 		Key:        "1F996",
 		Value:      "🦖",
 		Descriptor: "T-Rex",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-reptile",
+		Version:    "5.0",
 	},
 	"1F997": Emoji{
 		Key:        "1F997",
 		Value:      "🦗",
 		Descriptor: "Cricket",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bug",
+		Version:    "5.0",
 	},
 	"1F998": Emoji{
 		Key:        "1F998",
 		Value:      "🦘",
 		Descriptor: "Kangaroo",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "11.0",
 	},
 	"1F999": Emoji{
 		Key:        "1F999",
 		Value:      "🦙",
 		Descriptor: "Llama",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "11.0",
 	},
 	"1F99A": Emoji{
 		Key:        "1F99A",
 		Value:      "🦚",
 		Descriptor: "Peacock",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bird",
+		Version:    "11.0",
 	},
 	"1F99B": Emoji{
 		Key:        "1F99B",
 		Value:      "🦛",
 		Descriptor: "Hippopotamus",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "11.0",
 	},
 	"1F99C": Emoji{
 		Key:        "1F99C",
 		Value:      "🦜",
 		Descriptor: "Parrot",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bird",
+		Version:    "11.0",
 	},
 	"1F99D": Emoji{
 		Key:        "1F99D",
 		Value:      "🦝",
 		Descriptor: "Raccoon",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "11.0",
 	},
 	"1F99E": Emoji{
 		Key:        "1F99E",
 		Value:      "🦞",
 		Descriptor: "Lobster",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-marine",
+		Version:    "11.0",
 	},
 	"1F99F": Emoji{
 		Key:        "1F99F",
 		Value:      "🦟",
 		Descriptor: "Mosquito",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bug",
+		Version:    "11.0",
 	},
 	"1F9A0": Emoji{
 		Key:        "1F9A0",
 		Value:      "🦠",
 		Descriptor: "Microbe",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bug",
+		Version:    "11.0",
 	},
 	"1F9A1": Emoji{
 		Key:        "1F9A1",
 		Value:      "🦡",
 		Descriptor: "Badger",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "11.0",
 	},
 	"1F9A2": Emoji{
 		Key:        "1F9A2",
 		Value:      "🦢",
 		Descriptor: "Swan",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bird",
+		Version:    "11.0",
+	},
+	"1F9A3": Emoji{
+		Key:        "1F9A3",
+		Value:      "🦣",
+		Descriptor: "Mammoth",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "13.0",
+	},
+	"1F9A4": Emoji{
+		Key:        "1F9A4",
+		Value:      "🦤",
+		Descriptor: "Dodo",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bird",
+		Version:    "13.0",
+	},
+	"1F9A5": Emoji{
+		Key:        "1F9A5",
+		Value:      "🦥",
+		Descriptor: "Sloth",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "12.0",
+	},
+	"1F9A6": Emoji{
+		Key:        "1F9A6",
+		Value:      "🦦",
+		Descriptor: "Otter",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "12.0",
+	},
+	"1F9A7": Emoji{
+		Key:        "1F9A7",
+		Value:      "🦧",
+		Descriptor: "Orangutan",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "12.0",
+	},
+	"1F9A8": Emoji{
+		Key:        "1F9A8",
+		Value:      "🦨",
+		Descriptor: "Skunk",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "12.0",
+	},
+	"1F9A9": Emoji{
+		Key:        "1F9A9",
+		Value:      "🦩",
+		Descriptor: "Flamingo",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bird",
+		Version:    "12.0",
+	},
+	"1F9AA": Emoji{
+		Key:        "1F9AA",
+		Value:      "🦪",
+		Descriptor: "Oyster",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-marine",
+		Version:    "12.0",
+	},
+	"1F9AB": Emoji{
+		Key:        "1F9AB",
+		Value:      "🦫",
+		Descriptor: "Beaver",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "13.0",
+	},
+	"1F9AC": Emoji{
+		Key:        "1F9AC",
+		Value:      "🦬",
+		Descriptor: "Bison",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "13.0",
+	},
+	"1F9AD": Emoji{
+		Key:        "1F9AD",
+		Value:      "🦭",
+		Descriptor: "Seal",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-marine",
+		Version:    "13.0",
+	},
+	"1F9AE": Emoji{
+		Key:        "1F9AE",
+		Value:      "🦮",
+		Descriptor: "Guide Dog",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "12.0",
+	},
+	"1F9AF": Emoji{
+		Key:        "1F9AF",
+		Value:      "🦯",
+		Descriptor: "White Cane",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "12.0",
 	},
 	"1F9B0": Emoji{
 		Key:        "1F9B0",
 		Value:      "🦰",
 		Descriptor: "Red Hair",
+		Status:     "component",
+		Group:      "Component",
+		Subgroup:   "hair-style",
+		Version:    "11.0",
 	},
 	"1F9B1": Emoji{
 		Key:        "1F9B1",
 		Value:      "🦱",
 		Descriptor: "Curly Hair",
+		Status:     "component",
+		Group:      "Component",
+		Subgroup:   "hair-style",
+		Version:    "11.0",
 	},
 	"1F9B2": Emoji{
 		Key:        "1F9B2",
 		Value:      "🦲",
 		Descriptor: "Bald",
+		Status:     "component",
+		Group:      "Component",
+		Subgroup:   "hair-style",
+		Version:    "11.0",
 	},
 	"1F9B3": Emoji{
 		Key:        "1F9B3",
 		Value:      "🦳",
 		Descriptor: "White Hair",
+		Status:     "component",
+		Group:      "Component",
+		Subgroup:   "hair-style",
+		Version:    "11.0",
 	},
 	"1F9B4": Emoji{
 		Key:        "1F9B4",
 		Value:      "🦴",
 		Descriptor: "Bone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "11.0",
 	},
 	"1F9B5": Emoji{
 		Key:        "1F9B5",
 		Value:      "🦵",
 		Descriptor: "Leg",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "11.0",
 	},
 	"1F9B5-1F3FB": Emoji{
 		Key:        "1F9B5-1F3FB",
 		Value:      "🦵🏻",
 		Descriptor: "Leg: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "11.0",
 	},
 	"1F9B5-1F3FC": Emoji{
 		Key:        "1F9B5-1F3FC",
 		Value:      "🦵🏼",
 		Descriptor: "Leg: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "11.0",
 	},
 	"1F9B5-1F3FD": Emoji{
 		Key:        "1F9B5-1F3FD",
 		Value:      "🦵🏽",
 		Descriptor: "Leg: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "11.0",
 	},
 	"1F9B5-1F3FE": Emoji{
 		Key:        "1F9B5-1F3FE",
 		Value:      "🦵🏾",
 		Descriptor: "Leg: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "11.0",
 	},
 	"1F9B5-1F3FF": Emoji{
 		Key:        "1F9B5-1F3FF",
 		Value:      "🦵🏿",
 		Descriptor: "Leg: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "11.0",
 	},
 	"1F9B6": Emoji{
 		Key:        "1F9B6",
 		Value:      "🦶",
 		Descriptor: "Foot",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "11.0",
 	},
 	"1F9B6-1F3FB": Emoji{
 		Key:        "1F9B6-1F3FB",
 		Value:      "🦶🏻",
 		Descriptor: "Foot: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "11.0",
 	},
 	"1F9B6-1F3FC": Emoji{
 		Key:        "1F9B6-1F3FC",
 		Value:      "🦶🏼",
 		Descriptor: "Foot: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "11.0",
 	},
 	"1F9B6-1F3FD": Emoji{
 		Key:        "1F9B6-1F3FD",
 		Value:      "🦶🏽",
 		Descriptor: "Foot: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "11.0",
 	},
 	"1F9B6-1F3FE": Emoji{
 		Key:        "1F9B6-1F3FE",
 		Value:      "🦶🏾",
 		Descriptor: "Foot: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "11.0",
 	},
 	"1F9B6-1F3FF": Emoji{
 		Key:        "1F9B6-1F3FF",
 		Value:      "🦶🏿",
 		Descriptor: "Foot: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "11.0",
 	},
 	"1F9B7": Emoji{
 		Key:        "1F9B7",
 		Value:      "🦷",
 		Descriptor: "Tooth",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "11.0",
 	},
 	"1F9B8": Emoji{
 		Key:        "1F9B8",
 		Value:      "🦸",
 		Descriptor: "Superhero",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B8-1F3FB": Emoji{
 		Key:        "1F9B8-1F3FB",
 		Value:      "🦸🏻",
 		Descriptor: "Superhero: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B8-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F9B8-1F3FB-200D-2640-FE0F",
 		Value:      "🦸🏻‍♀️",
 		Descriptor: "Woman Superhero: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B8-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F9B8-1F3FB-200D-2642-FE0F",
 		Value:      "🦸🏻‍♂️",
 		Descriptor: "Man Superhero: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B8-1F3FC": Emoji{
 		Key:        "1F9B8-1F3FC",
 		Value:      "🦸🏼",
 		Descriptor: "Superhero: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B8-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F9B8-1F3FC-200D-2640-FE0F",
 		Value:      "🦸🏼‍♀️",
 		Descriptor: "Woman Superhero: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B8-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F9B8-1F3FC-200D-2642-FE0F",
 		Value:      "🦸🏼‍♂️",
 		Descriptor: "Man Superhero: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B8-1F3FD": Emoji{
 		Key:        "1F9B8-1F3FD",
 		Value:      "🦸🏽",
 		Descriptor: "Superhero: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B8-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F9B8-1F3FD-200D-2640-FE0F",
 		Value:      "🦸🏽‍♀️",
 		Descriptor: "Woman Superhero: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B8-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F9B8-1F3FD-200D-2642-FE0F",
 		Value:      "🦸🏽‍♂️",
 		Descriptor: "Man Superhero: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B8-1F3FE": Emoji{
 		Key:        "1F9B8-1F3FE",
 		Value:      "🦸🏾",
 		Descriptor: "Superhero: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B8-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F9B8-1F3FE-200D-2640-FE0F",
 		Value:      "🦸🏾‍♀️",
 		Descriptor: "Woman Superhero: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B8-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F9B8-1F3FE-200D-2642-FE0F",
 		Value:      "🦸🏾‍♂️",
 		Descriptor: "Man Superhero: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B8-1F3FF": Emoji{
 		Key:        "1F9B8-1F3FF",
 		Value:      "🦸🏿",
 		Descriptor: "Superhero: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B8-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F9B8-1F3FF-200D-2640-FE0F",
 		Value:      "🦸🏿‍♀️",
 		Descriptor: "Woman Superhero: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B8-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F9B8-1F3FF-200D-2642-FE0F",
 		Value:      "🦸🏿‍♂️",
 		Descriptor: "Man Superhero: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B8-200D-2640-FE0F": Emoji{
 		Key:        "1F9B8-200D-2640-FE0F",
 		Value:      "🦸‍♀️",
 		Descriptor: "Woman Superhero",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B8-200D-2642-FE0F": Emoji{
 		Key:        "1F9B8-200D-2642-FE0F",
 		Value:      "🦸‍♂️",
 		Descriptor: "Man Superhero",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B9": Emoji{
 		Key:        "1F9B9",
 		Value:      "🦹",
 		Descriptor: "Supervillain",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B9-1F3FB": Emoji{
 		Key:        "1F9B9-1F3FB",
 		Value:      "🦹🏻",
 		Descriptor: "Supervillain: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B9-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F9B9-1F3FB-200D-2640-FE0F",
 		Value:      "🦹🏻‍♀️",
 		Descriptor: "Woman Supervillain: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B9-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F9B9-1F3FB-200D-2642-FE0F",
 		Value:      "🦹🏻‍♂️",
 		Descriptor: "Man Supervillain: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B9-1F3FC": Emoji{
 		Key:        "1F9B9-1F3FC",
 		Value:      "🦹🏼",
 		Descriptor: "Supervillain: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B9-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F9B9-1F3FC-200D-2640-FE0F",
 		Value:      "🦹🏼‍♀️",
 		Descriptor: "Woman Supervillain: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B9-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F9B9-1F3FC-200D-2642-FE0F",
 		Value:      "🦹🏼‍♂️",
 		Descriptor: "Man Supervillain: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B9-1F3FD": Emoji{
 		Key:        "1F9B9-1F3FD",
 		Value:      "🦹🏽",
 		Descriptor: "Supervillain: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B9-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F9B9-1F3FD-200D-2640-FE0F",
 		Value:      "🦹🏽‍♀️",
 		Descriptor: "Woman Supervillain: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B9-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F9B9-1F3FD-200D-2642-FE0F",
 		Value:      "🦹🏽‍♂️",
 		Descriptor: "Man Supervillain: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B9-1F3FE": Emoji{
 		Key:        "1F9B9-1F3FE",
 		Value:      "🦹🏾",
 		Descriptor: "Supervillain: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B9-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F9B9-1F3FE-200D-2640-FE0F",
 		Value:      "🦹🏾‍♀️",
 		Descriptor: "Woman Supervillain: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B9-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F9B9-1F3FE-200D-2642-FE0F",
 		Value:      "🦹🏾‍♂️",
 		Descriptor: "Man Supervillain: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B9-1F3FF": Emoji{
 		Key:        "1F9B9-1F3FF",
 		Value:      "🦹🏿",
 		Descriptor: "Supervillain: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B9-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F9B9-1F3FF-200D-2640-FE0F",
 		Value:      "🦹🏿‍♀️",
 		Descriptor: "Woman Supervillain: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B9-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F9B9-1F3FF-200D-2642-FE0F",
 		Value:      "🦹🏿‍♂️",
 		Descriptor: "Man Supervillain: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B9-200D-2640-FE0F": Emoji{
 		Key:        "1F9B9-200D-2640-FE0F",
 		Value:      "🦹‍♀️",
 		Descriptor: "Woman Supervillain",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
 	},
 	"1F9B9-200D-2642-FE0F": Emoji{
 		Key:        "1F9B9-200D-2642-FE0F",
 		Value:      "🦹‍♂️",
 		Descriptor: "Man Supervillain",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "11.0",
+	},
+	"1F9BA": Emoji{
+		Key:        "1F9BA",
+		Value:      "🦺",
+		Descriptor: "Safety Vest",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "12.0",
+	},
+	"1F9BB": Emoji{
+		Key:        "1F9BB",
+		Value:      "🦻",
+		Descriptor: "Ear With Hearing Aid",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "12.0",
+	},
+	"1F9BB-1F3FB": Emoji{
+		Key:        "1F9BB-1F3FB",
+		Value:      "🦻🏻",
+		Descriptor: "Ear With Hearing Aid: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "12.0",
+	},
+	"1F9BB-1F3FC": Emoji{
+		Key:        "1F9BB-1F3FC",
+		Value:      "🦻🏼",
+		Descriptor: "Ear With Hearing Aid: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "12.0",
+	},
+	"1F9BB-1F3FD": Emoji{
+		Key:        "1F9BB-1F3FD",
+		Value:      "🦻🏽",
+		Descriptor: "Ear With Hearing Aid: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "12.0",
+	},
+	"1F9BB-1F3FE": Emoji{
+		Key:        "1F9BB-1F3FE",
+		Value:      "🦻🏾",
+		Descriptor: "Ear With Hearing Aid: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "12.0",
+	},
+	"1F9BB-1F3FF": Emoji{
+		Key:        "1F9BB-1F3FF",
+		Value:      "🦻🏿",
+		Descriptor: "Ear With Hearing Aid: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "12.0",
+	},
+	"1F9BC": Emoji{
+		Key:        "1F9BC",
+		Value:      "🦼",
+		Descriptor: "Motorized Wheelchair",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "12.0",
+	},
+	"1F9BD": Emoji{
+		Key:        "1F9BD",
+		Value:      "🦽",
+		Descriptor: "Manual Wheelchair",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "12.0",
+	},
+	"1F9BE": Emoji{
+		Key:        "1F9BE",
+		Value:      "🦾",
+		Descriptor: "Mechanical Arm",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "12.0",
+	},
+	"1F9BF": Emoji{
+		Key:        "1F9BF",
+		Value:      "🦿",
+		Descriptor: "Mechanical Leg",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "12.0",
 	},
 	"1F9C0": Emoji{
 		Key:        "1F9C0",
 		Value:      "🧀",
 		Descriptor: "Cheese Wedge",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "1.0",
 	},
 	"1F9C1": Emoji{
 		Key:        "1F9C1",
 		Value:      "🧁",
 		Descriptor: "Cupcake",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-sweet",
+		Version:    "11.0",
 	},
 	"1F9C2": Emoji{
 		Key:        "1F9C2",
 		Value:      "🧂",
 		Descriptor: "Salt",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "11.0",
+	},
+	"1F9C3": Emoji{
+		Key:        "1F9C3",
+		Value:      "🧃",
+		Descriptor: "Beverage Box",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "drink",
+		Version:    "12.0",
+	},
+	"1F9C4": Emoji{
+		Key:        "1F9C4",
+		Value:      "🧄",
+		Descriptor: "Garlic",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-vegetable",
+		Version:    "12.0",
+	},
+	"1F9C5": Emoji{
+		Key:        "1F9C5",
+		Value:      "🧅",
+		Descriptor: "Onion",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-vegetable",
+		Version:    "12.0",
+	},
+	"1F9C6": Emoji{
+		Key:        "1F9C6",
+		Value:      "🧆",
+		Descriptor: "Falafel",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "12.0",
+	},
+	"1F9C7": Emoji{
+		Key:        "1F9C7",
+		Value:      "🧇",
+		Descriptor: "Waffle",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "12.0",
+	},
+	"1F9C8": Emoji{
+		Key:        "1F9C8",
+		Value:      "🧈",
+		Descriptor: "Butter",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "12.0",
+	},
+	"1F9C9": Emoji{
+		Key:        "1F9C9",
+		Value:      "🧉",
+		Descriptor: "Mate",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "drink",
+		Version:    "12.0",
+	},
+	"1F9CA": Emoji{
+		Key:        "1F9CA",
+		Value:      "🧊",
+		Descriptor: "Ice",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "drink",
+		Version:    "12.0",
+	},
+	"1F9CB": Emoji{
+		Key:        "1F9CB",
+		Value:      "🧋",
+		Descriptor: "Bubble Tea",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "drink",
+		Version:    "13.0",
+	},
+	"1F9CC": Emoji{
+		Key:        "1F9CC",
+		Value:      "🧌",
+		Descriptor: "Troll",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "14.0",
+	},
+	"1F9CD": Emoji{
+		Key:        "1F9CD",
+		Value:      "🧍",
+		Descriptor: "Person Standing",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CD-1F3FB": Emoji{
+		Key:        "1F9CD-1F3FB",
+		Value:      "🧍🏻",
+		Descriptor: "Person Standing: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CD-1F3FB-200D-2640-FE0F": Emoji{
+		Key:        "1F9CD-1F3FB-200D-2640-FE0F",
+		Value:      "🧍🏻‍♀️",
+		Descriptor: "Woman Standing: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CD-1F3FB-200D-2642-FE0F": Emoji{
+		Key:        "1F9CD-1F3FB-200D-2642-FE0F",
+		Value:      "🧍🏻‍♂️",
+		Descriptor: "Man Standing: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CD-1F3FC": Emoji{
+		Key:        "1F9CD-1F3FC",
+		Value:      "🧍🏼",
+		Descriptor: "Person Standing: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CD-1F3FC-200D-2640-FE0F": Emoji{
+		Key:        "1F9CD-1F3FC-200D-2640-FE0F",
+		Value:      "🧍🏼‍♀️",
+		Descriptor: "Woman Standing: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CD-1F3FC-200D-2642-FE0F": Emoji{
+		Key:        "1F9CD-1F3FC-200D-2642-FE0F",
+		Value:      "🧍🏼‍♂️",
+		Descriptor: "Man Standing: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CD-1F3FD": Emoji{
+		Key:        "1F9CD-1F3FD",
+		Value:      "🧍🏽",
+		Descriptor: "Person Standing: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CD-1F3FD-200D-2640-FE0F": Emoji{
+		Key:        "1F9CD-1F3FD-200D-2640-FE0F",
+		Value:      "🧍🏽‍♀️",
+		Descriptor: "Woman Standing: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CD-1F3FD-200D-2642-FE0F": Emoji{
+		Key:        "1F9CD-1F3FD-200D-2642-FE0F",
+		Value:      "🧍🏽‍♂️",
+		Descriptor: "Man Standing: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CD-1F3FE": Emoji{
+		Key:        "1F9CD-1F3FE",
+		Value:      "🧍🏾",
+		Descriptor: "Person Standing: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CD-1F3FE-200D-2640-FE0F": Emoji{
+		Key:        "1F9CD-1F3FE-200D-2640-FE0F",
+		Value:      "🧍🏾‍♀️",
+		Descriptor: "Woman Standing: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CD-1F3FE-200D-2642-FE0F": Emoji{
+		Key:        "1F9CD-1F3FE-200D-2642-FE0F",
+		Value:      "🧍🏾‍♂️",
+		Descriptor: "Man Standing: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CD-1F3FF": Emoji{
+		Key:        "1F9CD-1F3FF",
+		Value:      "🧍🏿",
+		Descriptor: "Person Standing: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CD-1F3FF-200D-2640-FE0F": Emoji{
+		Key:        "1F9CD-1F3FF-200D-2640-FE0F",
+		Value:      "🧍🏿‍♀️",
+		Descriptor: "Woman Standing: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CD-1F3FF-200D-2642-FE0F": Emoji{
+		Key:        "1F9CD-1F3FF-200D-2642-FE0F",
+		Value:      "🧍🏿‍♂️",
+		Descriptor: "Man Standing: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CD-200D-2640-FE0F": Emoji{
+		Key:        "1F9CD-200D-2640-FE0F",
+		Value:      "🧍‍♀️",
+		Descriptor: "Woman Standing",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CD-200D-2642-FE0F": Emoji{
+		Key:        "1F9CD-200D-2642-FE0F",
+		Value:      "🧍‍♂️",
+		Descriptor: "Man Standing",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CE": Emoji{
+		Key:        "1F9CE",
+		Value:      "🧎",
+		Descriptor: "Person Kneeling",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CE-1F3FB": Emoji{
+		Key:        "1F9CE-1F3FB",
+		Value:      "🧎🏻",
+		Descriptor: "Person Kneeling: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CE-1F3FB-200D-2640-FE0F": Emoji{
+		Key:        "1F9CE-1F3FB-200D-2640-FE0F",
+		Value:      "🧎🏻‍♀️",
+		Descriptor: "Woman Kneeling: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CE-1F3FB-200D-2640-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F9CE-1F3FB-200D-2640-FE0F-200D-27A1-FE0F",
+		Value:      "🧎🏻‍♀️‍➡️",
+		Descriptor: "Woman Kneeling Facing Right: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9CE-1F3FB-200D-2642-FE0F": Emoji{
+		Key:        "1F9CE-1F3FB-200D-2642-FE0F",
+		Value:      "🧎🏻‍♂️",
+		Descriptor: "Man Kneeling: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CE-1F3FB-200D-2642-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F9CE-1F3FB-200D-2642-FE0F-200D-27A1-FE0F",
+		Value:      "🧎🏻‍♂️‍➡️",
+		Descriptor: "Man Kneeling Facing Right: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9CE-1F3FB-200D-27A1-FE0F": Emoji{
+		Key:        "1F9CE-1F3FB-200D-27A1-FE0F",
+		Value:      "🧎🏻‍➡️",
+		Descriptor: "Person Kneeling Facing Right: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9CE-1F3FC": Emoji{
+		Key:        "1F9CE-1F3FC",
+		Value:      "🧎🏼",
+		Descriptor: "Person Kneeling: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CE-1F3FC-200D-2640-FE0F": Emoji{
+		Key:        "1F9CE-1F3FC-200D-2640-FE0F",
+		Value:      "🧎🏼‍♀️",
+		Descriptor: "Woman Kneeling: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CE-1F3FC-200D-2640-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F9CE-1F3FC-200D-2640-FE0F-200D-27A1-FE0F",
+		Value:      "🧎🏼‍♀️‍➡️",
+		Descriptor: "Woman Kneeling Facing Right: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9CE-1F3FC-200D-2642-FE0F": Emoji{
+		Key:        "1F9CE-1F3FC-200D-2642-FE0F",
+		Value:      "🧎🏼‍♂️",
+		Descriptor: "Man Kneeling: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CE-1F3FC-200D-2642-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F9CE-1F3FC-200D-2642-FE0F-200D-27A1-FE0F",
+		Value:      "🧎🏼‍♂️‍➡️",
+		Descriptor: "Man Kneeling Facing Right: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9CE-1F3FC-200D-27A1-FE0F": Emoji{
+		Key:        "1F9CE-1F3FC-200D-27A1-FE0F",
+		Value:      "🧎🏼‍➡️",
+		Descriptor: "Person Kneeling Facing Right: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9CE-1F3FD": Emoji{
+		Key:        "1F9CE-1F3FD",
+		Value:      "🧎🏽",
+		Descriptor: "Person Kneeling: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CE-1F3FD-200D-2640-FE0F": Emoji{
+		Key:        "1F9CE-1F3FD-200D-2640-FE0F",
+		Value:      "🧎🏽‍♀️",
+		Descriptor: "Woman Kneeling: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CE-1F3FD-200D-2640-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F9CE-1F3FD-200D-2640-FE0F-200D-27A1-FE0F",
+		Value:      "🧎🏽‍♀️‍➡️",
+		Descriptor: "Woman Kneeling Facing Right: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9CE-1F3FD-200D-2642-FE0F": Emoji{
+		Key:        "1F9CE-1F3FD-200D-2642-FE0F",
+		Value:      "🧎🏽‍♂️",
+		Descriptor: "Man Kneeling: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CE-1F3FD-200D-2642-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F9CE-1F3FD-200D-2642-FE0F-200D-27A1-FE0F",
+		Value:      "🧎🏽‍♂️‍➡️",
+		Descriptor: "Man Kneeling Facing Right: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9CE-1F3FD-200D-27A1-FE0F": Emoji{
+		Key:        "1F9CE-1F3FD-200D-27A1-FE0F",
+		Value:      "🧎🏽‍➡️",
+		Descriptor: "Person Kneeling Facing Right: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9CE-1F3FE": Emoji{
+		Key:        "1F9CE-1F3FE",
+		Value:      "🧎🏾",
+		Descriptor: "Person Kneeling: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CE-1F3FE-200D-2640-FE0F": Emoji{
+		Key:        "1F9CE-1F3FE-200D-2640-FE0F",
+		Value:      "🧎🏾‍♀️",
+		Descriptor: "Woman Kneeling: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CE-1F3FE-200D-2640-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F9CE-1F3FE-200D-2640-FE0F-200D-27A1-FE0F",
+		Value:      "🧎🏾‍♀️‍➡️",
+		Descriptor: "Woman Kneeling Facing Right: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9CE-1F3FE-200D-2642-FE0F": Emoji{
+		Key:        "1F9CE-1F3FE-200D-2642-FE0F",
+		Value:      "🧎🏾‍♂️",
+		Descriptor: "Man Kneeling: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CE-1F3FE-200D-2642-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F9CE-1F3FE-200D-2642-FE0F-200D-27A1-FE0F",
+		Value:      "🧎🏾‍♂️‍➡️",
+		Descriptor: "Man Kneeling Facing Right: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9CE-1F3FE-200D-27A1-FE0F": Emoji{
+		Key:        "1F9CE-1F3FE-200D-27A1-FE0F",
+		Value:      "🧎🏾‍➡️",
+		Descriptor: "Person Kneeling Facing Right: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9CE-1F3FF": Emoji{
+		Key:        "1F9CE-1F3FF",
+		Value:      "🧎🏿",
+		Descriptor: "Person Kneeling: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CE-1F3FF-200D-2640-FE0F": Emoji{
+		Key:        "1F9CE-1F3FF-200D-2640-FE0F",
+		Value:      "🧎🏿‍♀️",
+		Descriptor: "Woman Kneeling: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CE-1F3FF-200D-2640-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F9CE-1F3FF-200D-2640-FE0F-200D-27A1-FE0F",
+		Value:      "🧎🏿‍♀️‍➡️",
+		Descriptor: "Woman Kneeling Facing Right: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9CE-1F3FF-200D-2642-FE0F": Emoji{
+		Key:        "1F9CE-1F3FF-200D-2642-FE0F",
+		Value:      "🧎🏿‍♂️",
+		Descriptor: "Man Kneeling: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CE-1F3FF-200D-2642-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F9CE-1F3FF-200D-2642-FE0F-200D-27A1-FE0F",
+		Value:      "🧎🏿‍♂️‍➡️",
+		Descriptor: "Man Kneeling Facing Right: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9CE-1F3FF-200D-27A1-FE0F": Emoji{
+		Key:        "1F9CE-1F3FF-200D-27A1-FE0F",
+		Value:      "🧎🏿‍➡️",
+		Descriptor: "Person Kneeling Facing Right: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9CE-200D-2640-FE0F": Emoji{
+		Key:        "1F9CE-200D-2640-FE0F",
+		Value:      "🧎‍♀️",
+		Descriptor: "Woman Kneeling",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CE-200D-2640-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F9CE-200D-2640-FE0F-200D-27A1-FE0F",
+		Value:      "🧎‍♀️‍➡️",
+		Descriptor: "Woman Kneeling Facing Right",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9CE-200D-2642-FE0F": Emoji{
+		Key:        "1F9CE-200D-2642-FE0F",
+		Value:      "🧎‍♂️",
+		Descriptor: "Man Kneeling",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.0",
+	},
+	"1F9CE-200D-2642-FE0F-200D-27A1-FE0F": Emoji{
+		Key:        "1F9CE-200D-2642-FE0F-200D-27A1-FE0F",
+		Value:      "🧎‍♂️‍➡️",
+		Descriptor: "Man Kneeling Facing Right",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9CE-200D-27A1-FE0F": Emoji{
+		Key:        "1F9CE-200D-27A1-FE0F",
+		Value:      "🧎‍➡️",
+		Descriptor: "Person Kneeling Facing Right",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9CF": Emoji{
+		Key:        "1F9CF",
+		Value:      "🧏",
+		Descriptor: "Deaf Person",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "12.0",
+	},
+	"1F9CF-1F3FB": Emoji{
+		Key:        "1F9CF-1F3FB",
+		Value:      "🧏🏻",
+		Descriptor: "Deaf Person: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "12.0",
+	},
+	"1F9CF-1F3FB-200D-2640-FE0F": Emoji{
+		Key:        "1F9CF-1F3FB-200D-2640-FE0F",
+		Value:      "🧏🏻‍♀️",
+		Descriptor: "Deaf Woman: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "12.0",
+	},
+	"1F9CF-1F3FB-200D-2642-FE0F": Emoji{
+		Key:        "1F9CF-1F3FB-200D-2642-FE0F",
+		Value:      "🧏🏻‍♂️",
+		Descriptor: "Deaf Man: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "12.0",
+	},
+	"1F9CF-1F3FC": Emoji{
+		Key:        "1F9CF-1F3FC",
+		Value:      "🧏🏼",
+		Descriptor: "Deaf Person: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "12.0",
+	},
+	"1F9CF-1F3FC-200D-2640-FE0F": Emoji{
+		Key:        "1F9CF-1F3FC-200D-2640-FE0F",
+		Value:      "🧏🏼‍♀️",
+		Descriptor: "Deaf Woman: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "12.0",
+	},
+	"1F9CF-1F3FC-200D-2642-FE0F": Emoji{
+		Key:        "1F9CF-1F3FC-200D-2642-FE0F",
+		Value:      "🧏🏼‍♂️",
+		Descriptor: "Deaf Man: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "12.0",
+	},
+	"1F9CF-1F3FD": Emoji{
+		Key:        "1F9CF-1F3FD",
+		Value:      "🧏🏽",
+		Descriptor: "Deaf Person: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "12.0",
+	},
+	"1F9CF-1F3FD-200D-2640-FE0F": Emoji{
+		Key:        "1F9CF-1F3FD-200D-2640-FE0F",
+		Value:      "🧏🏽‍♀️",
+		Descriptor: "Deaf Woman: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "12.0",
+	},
+	"1F9CF-1F3FD-200D-2642-FE0F": Emoji{
+		Key:        "1F9CF-1F3FD-200D-2642-FE0F",
+		Value:      "🧏🏽‍♂️",
+		Descriptor: "Deaf Man: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "12.0",
+	},
+	"1F9CF-1F3FE": Emoji{
+		Key:        "1F9CF-1F3FE",
+		Value:      "🧏🏾",
+		Descriptor: "Deaf Person: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "12.0",
+	},
+	"1F9CF-1F3FE-200D-2640-FE0F": Emoji{
+		Key:        "1F9CF-1F3FE-200D-2640-FE0F",
+		Value:      "🧏🏾‍♀️",
+		Descriptor: "Deaf Woman: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "12.0",
+	},
+	"1F9CF-1F3FE-200D-2642-FE0F": Emoji{
+		Key:        "1F9CF-1F3FE-200D-2642-FE0F",
+		Value:      "🧏🏾‍♂️",
+		Descriptor: "Deaf Man: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "12.0",
+	},
+	"1F9CF-1F3FF": Emoji{
+		Key:        "1F9CF-1F3FF",
+		Value:      "🧏🏿",
+		Descriptor: "Deaf Person: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "12.0",
+	},
+	"1F9CF-1F3FF-200D-2640-FE0F": Emoji{
+		Key:        "1F9CF-1F3FF-200D-2640-FE0F",
+		Value:      "🧏🏿‍♀️",
+		Descriptor: "Deaf Woman: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "12.0",
+	},
+	"1F9CF-1F3FF-200D-2642-FE0F": Emoji{
+		Key:        "1F9CF-1F3FF-200D-2642-FE0F",
+		Value:      "🧏🏿‍♂️",
+		Descriptor: "Deaf Man: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "12.0",
+	},
+	"1F9CF-200D-2640-FE0F": Emoji{
+		Key:        "1F9CF-200D-2640-FE0F",
+		Value:      "🧏‍♀️",
+		Descriptor: "Deaf Woman",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "12.0",
+	},
+	"1F9CF-200D-2642-FE0F": Emoji{
+		Key:        "1F9CF-200D-2642-FE0F",
+		Value:      "🧏‍♂️",
+		Descriptor: "Deaf Man",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-gesture",
+		Version:    "12.0",
 	},
 	"1F9D0": Emoji{
 		Key:        "1F9D0",
 		Value:      "🧐",
 		Descriptor: "Face With Monocle",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-glasses",
+		Version:    "5.0",
 	},
 	"1F9D1": Emoji{
 		Key:        "1F9D1",
 		Value:      "🧑",
 		Descriptor: "Person",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
 	},
 	"1F9D1-1F3FB": Emoji{
 		Key:        "1F9D1-1F3FB",
 		Value:      "🧑🏻",
 		Descriptor: "Person: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
+	},
+	"1F9D1-1F3FB-200D-1F33E": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F33E",
+		Value:      "🧑🏻‍🌾",
+		Descriptor: "Farmer: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F373": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F373",
+		Value:      "🧑🏻‍🍳",
+		Descriptor: "Cook: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F37C": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F37C",
+		Value:      "🧑🏻‍🍼",
+		Descriptor: "Person Feeding Baby: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F9D1-1F3FB-200D-1F384": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F384",
+		Value:      "🧑🏻‍🎄",
+		Descriptor: "Mx Claus: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "13.0",
+	},
+	"1F9D1-1F3FB-200D-1F393": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F393",
+		Value:      "🧑🏻‍🎓",
+		Descriptor: "Student: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F3A4": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F3A4",
+		Value:      "🧑🏻‍🎤",
+		Descriptor: "Singer: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F3A8": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F3A8",
+		Value:      "🧑🏻‍🎨",
+		Descriptor: "Artist: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F3EB": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F3EB",
+		Value:      "🧑🏻‍🏫",
+		Descriptor: "Teacher: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F3ED": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F3ED",
+		Value:      "🧑🏻‍🏭",
+		Descriptor: "Factory Worker: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F4BB": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F4BB",
+		Value:      "🧑🏻‍💻",
+		Descriptor: "Technologist: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F4BC": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F4BC",
+		Value:      "🧑🏻‍💼",
+		Descriptor: "Office Worker: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F527": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F527",
+		Value:      "🧑🏻‍🔧",
+		Descriptor: "Mechanic: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F52C": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F52C",
+		Value:      "🧑🏻‍🔬",
+		Descriptor: "Scientist: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F680": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F680",
+		Value:      "🧑🏻‍🚀",
+		Descriptor: "Astronaut: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F692": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F692",
+		Value:      "🧑🏻‍🚒",
+		Descriptor: "Firefighter: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FB": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FB",
+		Value:      "🧑🏻‍🤝‍🧑🏻",
+		Descriptor: "People Holding Hands: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FC": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FC",
+		Value:      "🧑🏻‍🤝‍🧑🏼",
+		Descriptor: "People Holding Hands: Light Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FD": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FD",
+		Value:      "🧑🏻‍🤝‍🧑🏽",
+		Descriptor: "People Holding Hands: Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FE": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FE",
+		Value:      "🧑🏻‍🤝‍🧑🏾",
+		Descriptor: "People Holding Hands: Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FF": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FF",
+		Value:      "🧑🏻‍🤝‍🧑🏿",
+		Descriptor: "People Holding Hands: Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F9AF": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F9AF",
+		Value:      "🧑🏻‍🦯",
+		Descriptor: "Person With White Cane: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F9AF-200D-27A1-FE0F": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F9AF-200D-27A1-FE0F",
+		Value:      "🧑🏻‍🦯‍➡️",
+		Descriptor: "Person With White Cane Facing Right: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9D1-1F3FB-200D-1F9B0": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F9B0",
+		Value:      "🧑🏻‍🦰",
+		Descriptor: "Person: Light Skin Tone, Red Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F9B1": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F9B1",
+		Value:      "🧑🏻‍🦱",
+		Descriptor: "Person: Light Skin Tone, Curly Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F9B2": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F9B2",
+		Value:      "🧑🏻‍🦲",
+		Descriptor: "Person: Light Skin Tone, Bald",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F9B3": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F9B3",
+		Value:      "🧑🏻‍🦳",
+		Descriptor: "Person: Light Skin Tone, White Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F9BC": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F9BC",
+		Value:      "🧑🏻‍🦼",
+		Descriptor: "Person in Motorized Wheelchair: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F9BC-200D-27A1-FE0F": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F9BC-200D-27A1-FE0F",
+		Value:      "🧑🏻‍🦼‍➡️",
+		Descriptor: "Person in Motorized Wheelchair Facing Right: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9D1-1F3FB-200D-1F9BD": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F9BD",
+		Value:      "🧑🏻‍🦽",
+		Descriptor: "Person in Manual Wheelchair: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-1F9BD-200D-27A1-FE0F": Emoji{
+		Key:        "1F9D1-1F3FB-200D-1F9BD-200D-27A1-FE0F",
+		Value:      "🧑🏻‍🦽‍➡️",
+		Descriptor: "Person in Manual Wheelchair Facing Right: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9D1-1F3FB-200D-2695-FE0F": Emoji{
+		Key:        "1F9D1-1F3FB-200D-2695-FE0F",
+		Value:      "🧑🏻‍⚕️",
+		Descriptor: "Health Worker: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-2696-FE0F": Emoji{
+		Key:        "1F9D1-1F3FB-200D-2696-FE0F",
+		Value:      "🧑🏻‍⚖️",
+		Descriptor: "Judge: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-2708-FE0F": Emoji{
+		Key:        "1F9D1-1F3FB-200D-2708-FE0F",
+		Value:      "🧑🏻‍✈️",
+		Descriptor: "Pilot: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FC": Emoji{
+		Key:        "1F9D1-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FC",
+		Value:      "🧑🏻‍❤️‍💋‍🧑🏼",
+		Descriptor: "Kiss: Person, Person, Light Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FD": Emoji{
+		Key:        "1F9D1-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FD",
+		Value:      "🧑🏻‍❤️‍💋‍🧑🏽",
+		Descriptor: "Kiss: Person, Person, Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FE": Emoji{
+		Key:        "1F9D1-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FE",
+		Value:      "🧑🏻‍❤️‍💋‍🧑🏾",
+		Descriptor: "Kiss: Person, Person, Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FF": Emoji{
+		Key:        "1F9D1-1F3FB-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FF",
+		Value:      "🧑🏻‍❤️‍💋‍🧑🏿",
+		Descriptor: "Kiss: Person, Person, Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FB-200D-2764-FE0F-200D-1F9D1-1F3FC": Emoji{
+		Key:        "1F9D1-1F3FB-200D-2764-FE0F-200D-1F9D1-1F3FC",
+		Value:      "🧑🏻‍❤️‍🧑🏼",
+		Descriptor: "Couple With Heart: Person, Person, Light Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FB-200D-2764-FE0F-200D-1F9D1-1F3FD": Emoji{
+		Key:        "1F9D1-1F3FB-200D-2764-FE0F-200D-1F9D1-1F3FD",
+		Value:      "🧑🏻‍❤️‍🧑🏽",
+		Descriptor: "Couple With Heart: Person, Person, Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FB-200D-2764-FE0F-200D-1F9D1-1F3FE": Emoji{
+		Key:        "1F9D1-1F3FB-200D-2764-FE0F-200D-1F9D1-1F3FE",
+		Value:      "🧑🏻‍❤️‍🧑🏾",
+		Descriptor: "Couple With Heart: Person, Person, Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FB-200D-2764-FE0F-200D-1F9D1-1F3FF": Emoji{
+		Key:        "1F9D1-1F3FB-200D-2764-FE0F-200D-1F9D1-1F3FF",
+		Value:      "🧑🏻‍❤️‍🧑🏿",
+		Descriptor: "Couple With Heart: Person, Person, Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
 	},
 	"1F9D1-1F3FC": Emoji{
 		Key:        "1F9D1-1F3FC",
 		Value:      "🧑🏼",
 		Descriptor: "Person: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
+	},
+	"1F9D1-1F3FC-200D-1F33E": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F33E",
+		Value:      "🧑🏼‍🌾",
+		Descriptor: "Farmer: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-1F373": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F373",
+		Value:      "🧑🏼‍🍳",
+		Descriptor: "Cook: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-1F37C": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F37C",
+		Value:      "🧑🏼‍🍼",
+		Descriptor: "Person Feeding Baby: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F9D1-1F3FC-200D-1F384": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F384",
+		Value:      "🧑🏼‍🎄",
+		Descriptor: "Mx Claus: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "13.0",
+	},
+	"1F9D1-1F3FC-200D-1F393": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F393",
+		Value:      "🧑🏼‍🎓",
+		Descriptor: "Student: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-1F3A4": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F3A4",
+		Value:      "🧑🏼‍🎤",
+		Descriptor: "Singer: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-1F3A8": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F3A8",
+		Value:      "🧑🏼‍🎨",
+		Descriptor: "Artist: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-1F3EB": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F3EB",
+		Value:      "🧑🏼‍🏫",
+		Descriptor: "Teacher: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-1F3ED": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F3ED",
+		Value:      "🧑🏼‍🏭",
+		Descriptor: "Factory Worker: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-1F4BB": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F4BB",
+		Value:      "🧑🏼‍💻",
+		Descriptor: "Technologist: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-1F4BC": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F4BC",
+		Value:      "🧑🏼‍💼",
+		Descriptor: "Office Worker: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-1F527": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F527",
+		Value:      "🧑🏼‍🔧",
+		Descriptor: "Mechanic: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-1F52C": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F52C",
+		Value:      "🧑🏼‍🔬",
+		Descriptor: "Scientist: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-1F680": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F680",
+		Value:      "🧑🏼‍🚀",
+		Descriptor: "Astronaut: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-1F692": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F692",
+		Value:      "🧑🏼‍🚒",
+		Descriptor: "Firefighter: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-1F91D-200D-1F9D1-1F3FB": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F91D-200D-1F9D1-1F3FB",
+		Value:      "🧑🏼‍🤝‍🧑🏻",
+		Descriptor: "People Holding Hands: Medium-Light Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F9D1-1F3FC-200D-1F91D-200D-1F9D1-1F3FC": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F91D-200D-1F9D1-1F3FC",
+		Value:      "🧑🏼‍🤝‍🧑🏼",
+		Descriptor: "People Holding Hands: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F9D1-1F3FC-200D-1F91D-200D-1F9D1-1F3FD": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F91D-200D-1F9D1-1F3FD",
+		Value:      "🧑🏼‍🤝‍🧑🏽",
+		Descriptor: "People Holding Hands: Medium-Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-1F91D-200D-1F9D1-1F3FE": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F91D-200D-1F9D1-1F3FE",
+		Value:      "🧑🏼‍🤝‍🧑🏾",
+		Descriptor: "People Holding Hands: Medium-Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-1F91D-200D-1F9D1-1F3FF": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F91D-200D-1F9D1-1F3FF",
+		Value:      "🧑🏼‍🤝‍🧑🏿",
+		Descriptor: "People Holding Hands: Medium-Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-1F9AF": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F9AF",
+		Value:      "🧑🏼‍🦯",
+		Descriptor: "Person With White Cane: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-1F9AF-200D-27A1-FE0F": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F9AF-200D-27A1-FE0F",
+		Value:      "🧑🏼‍🦯‍➡️",
+		Descriptor: "Person With White Cane Facing Right: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9D1-1F3FC-200D-1F9B0": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F9B0",
+		Value:      "🧑🏼‍🦰",
+		Descriptor: "Person: Medium-Light Skin Tone, Red Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-1F9B1": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F9B1",
+		Value:      "🧑🏼‍🦱",
+		Descriptor: "Person: Medium-Light Skin Tone, Curly Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-1F9B2": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F9B2",
+		Value:      "🧑🏼‍🦲",
+		Descriptor: "Person: Medium-Light Skin Tone, Bald",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-1F9B3": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F9B3",
+		Value:      "🧑🏼‍🦳",
+		Descriptor: "Person: Medium-Light Skin Tone, White Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-1F9BC": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F9BC",
+		Value:      "🧑🏼‍🦼",
+		Descriptor: "Person in Motorized Wheelchair: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-1F9BC-200D-27A1-FE0F": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F9BC-200D-27A1-FE0F",
+		Value:      "🧑🏼‍🦼‍➡️",
+		Descriptor: "Person in Motorized Wheelchair Facing Right: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9D1-1F3FC-200D-1F9BD": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F9BD",
+		Value:      "🧑🏼‍🦽",
+		Descriptor: "Person in Manual Wheelchair: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-1F9BD-200D-27A1-FE0F": Emoji{
+		Key:        "1F9D1-1F3FC-200D-1F9BD-200D-27A1-FE0F",
+		Value:      "🧑🏼‍🦽‍➡️",
+		Descriptor: "Person in Manual Wheelchair Facing Right: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9D1-1F3FC-200D-2695-FE0F": Emoji{
+		Key:        "1F9D1-1F3FC-200D-2695-FE0F",
+		Value:      "🧑🏼‍⚕️",
+		Descriptor: "Health Worker: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-2696-FE0F": Emoji{
+		Key:        "1F9D1-1F3FC-200D-2696-FE0F",
+		Value:      "🧑🏼‍⚖️",
+		Descriptor: "Judge: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-2708-FE0F": Emoji{
+		Key:        "1F9D1-1F3FC-200D-2708-FE0F",
+		Value:      "🧑🏼‍✈️",
+		Descriptor: "Pilot: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FB": Emoji{
+		Key:        "1F9D1-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FB",
+		Value:      "🧑🏼‍❤️‍💋‍🧑🏻",
+		Descriptor: "Kiss: Person, Person, Medium-Light Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FD": Emoji{
+		Key:        "1F9D1-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FD",
+		Value:      "🧑🏼‍❤️‍💋‍🧑🏽",
+		Descriptor: "Kiss: Person, Person, Medium-Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FE": Emoji{
+		Key:        "1F9D1-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FE",
+		Value:      "🧑🏼‍❤️‍💋‍🧑🏾",
+		Descriptor: "Kiss: Person, Person, Medium-Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FF": Emoji{
+		Key:        "1F9D1-1F3FC-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FF",
+		Value:      "🧑🏼‍❤️‍💋‍🧑🏿",
+		Descriptor: "Kiss: Person, Person, Medium-Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FC-200D-2764-FE0F-200D-1F9D1-1F3FB": Emoji{
+		Key:        "1F9D1-1F3FC-200D-2764-FE0F-200D-1F9D1-1F3FB",
+		Value:      "🧑🏼‍❤️‍🧑🏻",
+		Descriptor: "Couple With Heart: Person, Person, Medium-Light Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FC-200D-2764-FE0F-200D-1F9D1-1F3FD": Emoji{
+		Key:        "1F9D1-1F3FC-200D-2764-FE0F-200D-1F9D1-1F3FD",
+		Value:      "🧑🏼‍❤️‍🧑🏽",
+		Descriptor: "Couple With Heart: Person, Person, Medium-Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FC-200D-2764-FE0F-200D-1F9D1-1F3FE": Emoji{
+		Key:        "1F9D1-1F3FC-200D-2764-FE0F-200D-1F9D1-1F3FE",
+		Value:      "🧑🏼‍❤️‍🧑🏾",
+		Descriptor: "Couple With Heart: Person, Person, Medium-Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FC-200D-2764-FE0F-200D-1F9D1-1F3FF": Emoji{
+		Key:        "1F9D1-1F3FC-200D-2764-FE0F-200D-1F9D1-1F3FF",
+		Value:      "🧑🏼‍❤️‍🧑🏿",
+		Descriptor: "Couple With Heart: Person, Person, Medium-Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
 	},
 	"1F9D1-1F3FD": Emoji{
 		Key:        "1F9D1-1F3FD",
 		Value:      "🧑🏽",
 		Descriptor: "Person: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
+	},
+	"1F9D1-1F3FD-200D-1F33E": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F33E",
+		Value:      "🧑🏽‍🌾",
+		Descriptor: "Farmer: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-1F373": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F373",
+		Value:      "🧑🏽‍🍳",
+		Descriptor: "Cook: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-1F37C": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F37C",
+		Value:      "🧑🏽‍🍼",
+		Descriptor: "Person Feeding Baby: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F9D1-1F3FD-200D-1F384": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F384",
+		Value:      "🧑🏽‍🎄",
+		Descriptor: "Mx Claus: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "13.0",
+	},
+	"1F9D1-1F3FD-200D-1F393": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F393",
+		Value:      "🧑🏽‍🎓",
+		Descriptor: "Student: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-1F3A4": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F3A4",
+		Value:      "🧑🏽‍🎤",
+		Descriptor: "Singer: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-1F3A8": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F3A8",
+		Value:      "🧑🏽‍🎨",
+		Descriptor: "Artist: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-1F3EB": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F3EB",
+		Value:      "🧑🏽‍🏫",
+		Descriptor: "Teacher: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-1F3ED": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F3ED",
+		Value:      "🧑🏽‍🏭",
+		Descriptor: "Factory Worker: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-1F4BB": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F4BB",
+		Value:      "🧑🏽‍💻",
+		Descriptor: "Technologist: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-1F4BC": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F4BC",
+		Value:      "🧑🏽‍💼",
+		Descriptor: "Office Worker: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-1F527": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F527",
+		Value:      "🧑🏽‍🔧",
+		Descriptor: "Mechanic: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-1F52C": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F52C",
+		Value:      "🧑🏽‍🔬",
+		Descriptor: "Scientist: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-1F680": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F680",
+		Value:      "🧑🏽‍🚀",
+		Descriptor: "Astronaut: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-1F692": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F692",
+		Value:      "🧑🏽‍🚒",
+		Descriptor: "Firefighter: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FB": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FB",
+		Value:      "🧑🏽‍🤝‍🧑🏻",
+		Descriptor: "People Holding Hands: Medium Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FC": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FC",
+		Value:      "🧑🏽‍🤝‍🧑🏼",
+		Descriptor: "People Holding Hands: Medium Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FD": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FD",
+		Value:      "🧑🏽‍🤝‍🧑🏽",
+		Descriptor: "People Holding Hands: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FE": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FE",
+		Value:      "🧑🏽‍🤝‍🧑🏾",
+		Descriptor: "People Holding Hands: Medium Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FF": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FF",
+		Value:      "🧑🏽‍🤝‍🧑🏿",
+		Descriptor: "People Holding Hands: Medium Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-1F9AF": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F9AF",
+		Value:      "🧑🏽‍🦯",
+		Descriptor: "Person With White Cane: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-1F9AF-200D-27A1-FE0F": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F9AF-200D-27A1-FE0F",
+		Value:      "🧑🏽‍🦯‍➡️",
+		Descriptor: "Person With White Cane Facing Right: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9D1-1F3FD-200D-1F9B0": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F9B0",
+		Value:      "🧑🏽‍🦰",
+		Descriptor: "Person: Medium Skin Tone, Red Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-1F9B1": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F9B1",
+		Value:      "🧑🏽‍🦱",
+		Descriptor: "Person: Medium Skin Tone, Curly Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-1F9B2": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F9B2",
+		Value:      "🧑🏽‍🦲",
+		Descriptor: "Person: Medium Skin Tone, Bald",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-1F9B3": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F9B3",
+		Value:      "🧑🏽‍🦳",
+		Descriptor: "Person: Medium Skin Tone, White Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-1F9BC": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F9BC",
+		Value:      "🧑🏽‍🦼",
+		Descriptor: "Person in Motorized Wheelchair: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-1F9BC-200D-27A1-FE0F": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F9BC-200D-27A1-FE0F",
+		Value:      "🧑🏽‍🦼‍➡️",
+		Descriptor: "Person in Motorized Wheelchair Facing Right: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9D1-1F3FD-200D-1F9BD": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F9BD",
+		Value:      "🧑🏽‍🦽",
+		Descriptor: "Person in Manual Wheelchair: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-1F9BD-200D-27A1-FE0F": Emoji{
+		Key:        "1F9D1-1F3FD-200D-1F9BD-200D-27A1-FE0F",
+		Value:      "🧑🏽‍🦽‍➡️",
+		Descriptor: "Person in Manual Wheelchair Facing Right: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9D1-1F3FD-200D-2695-FE0F": Emoji{
+		Key:        "1F9D1-1F3FD-200D-2695-FE0F",
+		Value:      "🧑🏽‍⚕️",
+		Descriptor: "Health Worker: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-2696-FE0F": Emoji{
+		Key:        "1F9D1-1F3FD-200D-2696-FE0F",
+		Value:      "🧑🏽‍⚖️",
+		Descriptor: "Judge: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-2708-FE0F": Emoji{
+		Key:        "1F9D1-1F3FD-200D-2708-FE0F",
+		Value:      "🧑🏽‍✈️",
+		Descriptor: "Pilot: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FB": Emoji{
+		Key:        "1F9D1-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FB",
+		Value:      "🧑🏽‍❤️‍💋‍🧑🏻",
+		Descriptor: "Kiss: Person, Person, Medium Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FC": Emoji{
+		Key:        "1F9D1-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FC",
+		Value:      "🧑🏽‍❤️‍💋‍🧑🏼",
+		Descriptor: "Kiss: Person, Person, Medium Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FE": Emoji{
+		Key:        "1F9D1-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FE",
+		Value:      "🧑🏽‍❤️‍💋‍🧑🏾",
+		Descriptor: "Kiss: Person, Person, Medium Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FF": Emoji{
+		Key:        "1F9D1-1F3FD-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FF",
+		Value:      "🧑🏽‍❤️‍💋‍🧑🏿",
+		Descriptor: "Kiss: Person, Person, Medium Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FD-200D-2764-FE0F-200D-1F9D1-1F3FB": Emoji{
+		Key:        "1F9D1-1F3FD-200D-2764-FE0F-200D-1F9D1-1F3FB",
+		Value:      "🧑🏽‍❤️‍🧑🏻",
+		Descriptor: "Couple With Heart: Person, Person, Medium Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FD-200D-2764-FE0F-200D-1F9D1-1F3FC": Emoji{
+		Key:        "1F9D1-1F3FD-200D-2764-FE0F-200D-1F9D1-1F3FC",
+		Value:      "🧑🏽‍❤️‍🧑🏼",
+		Descriptor: "Couple With Heart: Person, Person, Medium Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FD-200D-2764-FE0F-200D-1F9D1-1F3FE": Emoji{
+		Key:        "1F9D1-1F3FD-200D-2764-FE0F-200D-1F9D1-1F3FE",
+		Value:      "🧑🏽‍❤️‍🧑🏾",
+		Descriptor: "Couple With Heart: Person, Person, Medium Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FD-200D-2764-FE0F-200D-1F9D1-1F3FF": Emoji{
+		Key:        "1F9D1-1F3FD-200D-2764-FE0F-200D-1F9D1-1F3FF",
+		Value:      "🧑🏽‍❤️‍🧑🏿",
+		Descriptor: "Couple With Heart: Person, Person, Medium Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
 	},
 	"1F9D1-1F3FE": Emoji{
 		Key:        "1F9D1-1F3FE",
 		Value:      "🧑🏾",
 		Descriptor: "Person: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
+	},
+	"1F9D1-1F3FE-200D-1F33E": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F33E",
+		Value:      "🧑🏾‍🌾",
+		Descriptor: "Farmer: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-1F373": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F373",
+		Value:      "🧑🏾‍🍳",
+		Descriptor: "Cook: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-1F37C": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F37C",
+		Value:      "🧑🏾‍🍼",
+		Descriptor: "Person Feeding Baby: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F9D1-1F3FE-200D-1F384": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F384",
+		Value:      "🧑🏾‍🎄",
+		Descriptor: "Mx Claus: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "13.0",
+	},
+	"1F9D1-1F3FE-200D-1F393": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F393",
+		Value:      "🧑🏾‍🎓",
+		Descriptor: "Student: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-1F3A4": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F3A4",
+		Value:      "🧑🏾‍🎤",
+		Descriptor: "Singer: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-1F3A8": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F3A8",
+		Value:      "🧑🏾‍🎨",
+		Descriptor: "Artist: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-1F3EB": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F3EB",
+		Value:      "🧑🏾‍🏫",
+		Descriptor: "Teacher: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-1F3ED": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F3ED",
+		Value:      "🧑🏾‍🏭",
+		Descriptor: "Factory Worker: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-1F4BB": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F4BB",
+		Value:      "🧑🏾‍💻",
+		Descriptor: "Technologist: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-1F4BC": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F4BC",
+		Value:      "🧑🏾‍💼",
+		Descriptor: "Office Worker: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-1F527": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F527",
+		Value:      "🧑🏾‍🔧",
+		Descriptor: "Mechanic: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-1F52C": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F52C",
+		Value:      "🧑🏾‍🔬",
+		Descriptor: "Scientist: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-1F680": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F680",
+		Value:      "🧑🏾‍🚀",
+		Descriptor: "Astronaut: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-1F692": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F692",
+		Value:      "🧑🏾‍🚒",
+		Descriptor: "Firefighter: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-1F91D-200D-1F9D1-1F3FB": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F91D-200D-1F9D1-1F3FB",
+		Value:      "🧑🏾‍🤝‍🧑🏻",
+		Descriptor: "People Holding Hands: Medium-Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F9D1-1F3FE-200D-1F91D-200D-1F9D1-1F3FC": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F91D-200D-1F9D1-1F3FC",
+		Value:      "🧑🏾‍🤝‍🧑🏼",
+		Descriptor: "People Holding Hands: Medium-Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F9D1-1F3FE-200D-1F91D-200D-1F9D1-1F3FD": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F91D-200D-1F9D1-1F3FD",
+		Value:      "🧑🏾‍🤝‍🧑🏽",
+		Descriptor: "People Holding Hands: Medium-Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F9D1-1F3FE-200D-1F91D-200D-1F9D1-1F3FE": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F91D-200D-1F9D1-1F3FE",
+		Value:      "🧑🏾‍🤝‍🧑🏾",
+		Descriptor: "People Holding Hands: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F9D1-1F3FE-200D-1F91D-200D-1F9D1-1F3FF": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F91D-200D-1F9D1-1F3FF",
+		Value:      "🧑🏾‍🤝‍🧑🏿",
+		Descriptor: "People Holding Hands: Medium-Dark Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-1F9AF": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F9AF",
+		Value:      "🧑🏾‍🦯",
+		Descriptor: "Person With White Cane: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-1F9AF-200D-27A1-FE0F": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F9AF-200D-27A1-FE0F",
+		Value:      "🧑🏾‍🦯‍➡️",
+		Descriptor: "Person With White Cane Facing Right: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9D1-1F3FE-200D-1F9B0": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F9B0",
+		Value:      "🧑🏾‍🦰",
+		Descriptor: "Person: Medium-Dark Skin Tone, Red Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-1F9B1": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F9B1",
+		Value:      "🧑🏾‍🦱",
+		Descriptor: "Person: Medium-Dark Skin Tone, Curly Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-1F9B2": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F9B2",
+		Value:      "🧑🏾‍🦲",
+		Descriptor: "Person: Medium-Dark Skin Tone, Bald",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-1F9B3": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F9B3",
+		Value:      "🧑🏾‍🦳",
+		Descriptor: "Person: Medium-Dark Skin Tone, White Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-1F9BC": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F9BC",
+		Value:      "🧑🏾‍🦼",
+		Descriptor: "Person in Motorized Wheelchair: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-1F9BC-200D-27A1-FE0F": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F9BC-200D-27A1-FE0F",
+		Value:      "🧑🏾‍🦼‍➡️",
+		Descriptor: "Person in Motorized Wheelchair Facing Right: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9D1-1F3FE-200D-1F9BD": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F9BD",
+		Value:      "🧑🏾‍🦽",
+		Descriptor: "Person in Manual Wheelchair: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-1F9BD-200D-27A1-FE0F": Emoji{
+		Key:        "1F9D1-1F3FE-200D-1F9BD-200D-27A1-FE0F",
+		Value:      "🧑🏾‍🦽‍➡️",
+		Descriptor: "Person in Manual Wheelchair Facing Right: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9D1-1F3FE-200D-2695-FE0F": Emoji{
+		Key:        "1F9D1-1F3FE-200D-2695-FE0F",
+		Value:      "🧑🏾‍⚕️",
+		Descriptor: "Health Worker: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-2696-FE0F": Emoji{
+		Key:        "1F9D1-1F3FE-200D-2696-FE0F",
+		Value:      "🧑🏾‍⚖️",
+		Descriptor: "Judge: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-2708-FE0F": Emoji{
+		Key:        "1F9D1-1F3FE-200D-2708-FE0F",
+		Value:      "🧑🏾‍✈️",
+		Descriptor: "Pilot: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FB": Emoji{
+		Key:        "1F9D1-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FB",
+		Value:      "🧑🏾‍❤️‍💋‍🧑🏻",
+		Descriptor: "Kiss: Person, Person, Medium-Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FC": Emoji{
+		Key:        "1F9D1-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FC",
+		Value:      "🧑🏾‍❤️‍💋‍🧑🏼",
+		Descriptor: "Kiss: Person, Person, Medium-Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FD": Emoji{
+		Key:        "1F9D1-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FD",
+		Value:      "🧑🏾‍❤️‍💋‍🧑🏽",
+		Descriptor: "Kiss: Person, Person, Medium-Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FF": Emoji{
+		Key:        "1F9D1-1F3FE-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FF",
+		Value:      "🧑🏾‍❤️‍💋‍🧑🏿",
+		Descriptor: "Kiss: Person, Person, Medium-Dark Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FE-200D-2764-FE0F-200D-1F9D1-1F3FB": Emoji{
+		Key:        "1F9D1-1F3FE-200D-2764-FE0F-200D-1F9D1-1F3FB",
+		Value:      "🧑🏾‍❤️‍🧑🏻",
+		Descriptor: "Couple With Heart: Person, Person, Medium-Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FE-200D-2764-FE0F-200D-1F9D1-1F3FC": Emoji{
+		Key:        "1F9D1-1F3FE-200D-2764-FE0F-200D-1F9D1-1F3FC",
+		Value:      "🧑🏾‍❤️‍🧑🏼",
+		Descriptor: "Couple With Heart: Person, Person, Medium-Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FE-200D-2764-FE0F-200D-1F9D1-1F3FD": Emoji{
+		Key:        "1F9D1-1F3FE-200D-2764-FE0F-200D-1F9D1-1F3FD",
+		Value:      "🧑🏾‍❤️‍🧑🏽",
+		Descriptor: "Couple With Heart: Person, Person, Medium-Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FE-200D-2764-FE0F-200D-1F9D1-1F3FF": Emoji{
+		Key:        "1F9D1-1F3FE-200D-2764-FE0F-200D-1F9D1-1F3FF",
+		Value:      "🧑🏾‍❤️‍🧑🏿",
+		Descriptor: "Couple With Heart: Person, Person, Medium-Dark Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
 	},
 	"1F9D1-1F3FF": Emoji{
 		Key:        "1F9D1-1F3FF",
 		Value:      "🧑🏿",
 		Descriptor: "Person: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
+	},
+	"1F9D1-1F3FF-200D-1F33E": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F33E",
+		Value:      "🧑🏿‍🌾",
+		Descriptor: "Farmer: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FF-200D-1F373": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F373",
+		Value:      "🧑🏿‍🍳",
+		Descriptor: "Cook: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FF-200D-1F37C": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F37C",
+		Value:      "🧑🏿‍🍼",
+		Descriptor: "Person Feeding Baby: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F9D1-1F3FF-200D-1F384": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F384",
+		Value:      "🧑🏿‍🎄",
+		Descriptor: "Mx Claus: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "13.0",
+	},
+	"1F9D1-1F3FF-200D-1F393": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F393",
+		Value:      "🧑🏿‍🎓",
+		Descriptor: "Student: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FF-200D-1F3A4": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F3A4",
+		Value:      "🧑🏿‍🎤",
+		Descriptor: "Singer: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FF-200D-1F3A8": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F3A8",
+		Value:      "🧑🏿‍🎨",
+		Descriptor: "Artist: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FF-200D-1F3EB": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F3EB",
+		Value:      "🧑🏿‍🏫",
+		Descriptor: "Teacher: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FF-200D-1F3ED": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F3ED",
+		Value:      "🧑🏿‍🏭",
+		Descriptor: "Factory Worker: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FF-200D-1F4BB": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F4BB",
+		Value:      "🧑🏿‍💻",
+		Descriptor: "Technologist: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FF-200D-1F4BC": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F4BC",
+		Value:      "🧑🏿‍💼",
+		Descriptor: "Office Worker: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FF-200D-1F527": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F527",
+		Value:      "🧑🏿‍🔧",
+		Descriptor: "Mechanic: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FF-200D-1F52C": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F52C",
+		Value:      "🧑🏿‍🔬",
+		Descriptor: "Scientist: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FF-200D-1F680": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F680",
+		Value:      "🧑🏿‍🚀",
+		Descriptor: "Astronaut: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FF-200D-1F692": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F692",
+		Value:      "🧑🏿‍🚒",
+		Descriptor: "Firefighter: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FF-200D-1F91D-200D-1F9D1-1F3FB": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F91D-200D-1F9D1-1F3FB",
+		Value:      "🧑🏿‍🤝‍🧑🏻",
+		Descriptor: "People Holding Hands: Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F9D1-1F3FF-200D-1F91D-200D-1F9D1-1F3FC": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F91D-200D-1F9D1-1F3FC",
+		Value:      "🧑🏿‍🤝‍🧑🏼",
+		Descriptor: "People Holding Hands: Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F9D1-1F3FF-200D-1F91D-200D-1F9D1-1F3FD": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F91D-200D-1F9D1-1F3FD",
+		Value:      "🧑🏿‍🤝‍🧑🏽",
+		Descriptor: "People Holding Hands: Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F9D1-1F3FF-200D-1F91D-200D-1F9D1-1F3FE": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F91D-200D-1F9D1-1F3FE",
+		Value:      "🧑🏿‍🤝‍🧑🏾",
+		Descriptor: "People Holding Hands: Dark Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F9D1-1F3FF-200D-1F91D-200D-1F9D1-1F3FF": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F91D-200D-1F9D1-1F3FF",
+		Value:      "🧑🏿‍🤝‍🧑🏿",
+		Descriptor: "People Holding Hands: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F9D1-1F3FF-200D-1F9AF": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F9AF",
+		Value:      "🧑🏿‍🦯",
+		Descriptor: "Person With White Cane: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FF-200D-1F9AF-200D-27A1-FE0F": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F9AF-200D-27A1-FE0F",
+		Value:      "🧑🏿‍🦯‍➡️",
+		Descriptor: "Person With White Cane Facing Right: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9D1-1F3FF-200D-1F9B0": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F9B0",
+		Value:      "🧑🏿‍🦰",
+		Descriptor: "Person: Dark Skin Tone, Red Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FF-200D-1F9B1": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F9B1",
+		Value:      "🧑🏿‍🦱",
+		Descriptor: "Person: Dark Skin Tone, Curly Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FF-200D-1F9B2": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F9B2",
+		Value:      "🧑🏿‍🦲",
+		Descriptor: "Person: Dark Skin Tone, Bald",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FF-200D-1F9B3": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F9B3",
+		Value:      "🧑🏿‍🦳",
+		Descriptor: "Person: Dark Skin Tone, White Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FF-200D-1F9BC": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F9BC",
+		Value:      "🧑🏿‍🦼",
+		Descriptor: "Person in Motorized Wheelchair: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FF-200D-1F9BC-200D-27A1-FE0F": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F9BC-200D-27A1-FE0F",
+		Value:      "🧑🏿‍🦼‍➡️",
+		Descriptor: "Person in Motorized Wheelchair Facing Right: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9D1-1F3FF-200D-1F9BD": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F9BD",
+		Value:      "🧑🏿‍🦽",
+		Descriptor: "Person in Manual Wheelchair: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FF-200D-1F9BD-200D-27A1-FE0F": Emoji{
+		Key:        "1F9D1-1F3FF-200D-1F9BD-200D-27A1-FE0F",
+		Value:      "🧑🏿‍🦽‍➡️",
+		Descriptor: "Person in Manual Wheelchair Facing Right: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9D1-1F3FF-200D-2695-FE0F": Emoji{
+		Key:        "1F9D1-1F3FF-200D-2695-FE0F",
+		Value:      "🧑🏿‍⚕️",
+		Descriptor: "Health Worker: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FF-200D-2696-FE0F": Emoji{
+		Key:        "1F9D1-1F3FF-200D-2696-FE0F",
+		Value:      "🧑🏿‍⚖️",
+		Descriptor: "Judge: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FF-200D-2708-FE0F": Emoji{
+		Key:        "1F9D1-1F3FF-200D-2708-FE0F",
+		Value:      "🧑🏿‍✈️",
+		Descriptor: "Pilot: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FB": Emoji{
+		Key:        "1F9D1-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FB",
+		Value:      "🧑🏿‍❤️‍💋‍🧑🏻",
+		Descriptor: "Kiss: Person, Person, Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FC": Emoji{
+		Key:        "1F9D1-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FC",
+		Value:      "🧑🏿‍❤️‍💋‍🧑🏼",
+		Descriptor: "Kiss: Person, Person, Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FD": Emoji{
+		Key:        "1F9D1-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FD",
+		Value:      "🧑🏿‍❤️‍💋‍🧑🏽",
+		Descriptor: "Kiss: Person, Person, Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FE": Emoji{
+		Key:        "1F9D1-1F3FF-200D-2764-FE0F-200D-1F48B-200D-1F9D1-1F3FE",
+		Value:      "🧑🏿‍❤️‍💋‍🧑🏾",
+		Descriptor: "Kiss: Person, Person, Dark Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FF-200D-2764-FE0F-200D-1F9D1-1F3FB": Emoji{
+		Key:        "1F9D1-1F3FF-200D-2764-FE0F-200D-1F9D1-1F3FB",
+		Value:      "🧑🏿‍❤️‍🧑🏻",
+		Descriptor: "Couple With Heart: Person, Person, Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FF-200D-2764-FE0F-200D-1F9D1-1F3FC": Emoji{
+		Key:        "1F9D1-1F3FF-200D-2764-FE0F-200D-1F9D1-1F3FC",
+		Value:      "🧑🏿‍❤️‍🧑🏼",
+		Descriptor: "Couple With Heart: Person, Person, Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FF-200D-2764-FE0F-200D-1F9D1-1F3FD": Emoji{
+		Key:        "1F9D1-1F3FF-200D-2764-FE0F-200D-1F9D1-1F3FD",
+		Value:      "🧑🏿‍❤️‍🧑🏽",
+		Descriptor: "Couple With Heart: Person, Person, Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-1F3FF-200D-2764-FE0F-200D-1F9D1-1F3FE": Emoji{
+		Key:        "1F9D1-1F3FF-200D-2764-FE0F-200D-1F9D1-1F3FE",
+		Value:      "🧑🏿‍❤️‍🧑🏾",
+		Descriptor: "Couple With Heart: Person, Person, Dark Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "13.1",
+	},
+	"1F9D1-200D-1F33E": Emoji{
+		Key:        "1F9D1-200D-1F33E",
+		Value:      "🧑‍🌾",
+		Descriptor: "Farmer",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-200D-1F373": Emoji{
+		Key:        "1F9D1-200D-1F373",
+		Value:      "🧑‍🍳",
+		Descriptor: "Cook",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-200D-1F37C": Emoji{
+		Key:        "1F9D1-200D-1F37C",
+		Value:      "🧑‍🍼",
+		Descriptor: "Person Feeding Baby",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "13.0",
+	},
+	"1F9D1-200D-1F384": Emoji{
+		Key:        "1F9D1-200D-1F384",
+		Value:      "🧑‍🎄",
+		Descriptor: "Mx Claus",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "13.0",
+	},
+	"1F9D1-200D-1F393": Emoji{
+		Key:        "1F9D1-200D-1F393",
+		Value:      "🧑‍🎓",
+		Descriptor: "Student",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-200D-1F3A4": Emoji{
+		Key:        "1F9D1-200D-1F3A4",
+		Value:      "🧑‍🎤",
+		Descriptor: "Singer",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-200D-1F3A8": Emoji{
+		Key:        "1F9D1-200D-1F3A8",
+		Value:      "🧑‍🎨",
+		Descriptor: "Artist",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-200D-1F3EB": Emoji{
+		Key:        "1F9D1-200D-1F3EB",
+		Value:      "🧑‍🏫",
+		Descriptor: "Teacher",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-200D-1F3ED": Emoji{
+		Key:        "1F9D1-200D-1F3ED",
+		Value:      "🧑‍🏭",
+		Descriptor: "Factory Worker",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-200D-1F4BB": Emoji{
+		Key:        "1F9D1-200D-1F4BB",
+		Value:      "🧑‍💻",
+		Descriptor: "Technologist",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-200D-1F4BC": Emoji{
+		Key:        "1F9D1-200D-1F4BC",
+		Value:      "🧑‍💼",
+		Descriptor: "Office Worker",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-200D-1F527": Emoji{
+		Key:        "1F9D1-200D-1F527",
+		Value:      "🧑‍🔧",
+		Descriptor: "Mechanic",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-200D-1F52C": Emoji{
+		Key:        "1F9D1-200D-1F52C",
+		Value:      "🧑‍🔬",
+		Descriptor: "Scientist",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-200D-1F680": Emoji{
+		Key:        "1F9D1-200D-1F680",
+		Value:      "🧑‍🚀",
+		Descriptor: "Astronaut",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-200D-1F692": Emoji{
+		Key:        "1F9D1-200D-1F692",
+		Value:      "🧑‍🚒",
+		Descriptor: "Firefighter",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-200D-1F91D-200D-1F9D1": Emoji{
+		Key:        "1F9D1-200D-1F91D-200D-1F9D1",
+		Value:      "🧑‍🤝‍🧑",
+		Descriptor: "People Holding Hands",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "family",
+		Version:    "12.0",
+	},
+	"1F9D1-200D-1F9AF": Emoji{
+		Key:        "1F9D1-200D-1F9AF",
+		Value:      "🧑‍🦯",
+		Descriptor: "Person With White Cane",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.1",
+	},
+	"1F9D1-200D-1F9AF-200D-27A1-FE0F": Emoji{
+		Key:        "1F9D1-200D-1F9AF-200D-27A1-FE0F",
+		Value:      "🧑‍🦯‍➡️",
+		Descriptor: "Person With White Cane Facing Right",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9D1-200D-1F9B0": Emoji{
+		Key:        "1F9D1-200D-1F9B0",
+		Value:      "🧑‍🦰",
+		Descriptor: "Person: Red Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-200D-1F9B1": Emoji{
+		Key:        "1F9D1-200D-1F9B1",
+		Value:      "🧑‍🦱",
+		Descriptor: "Person: Curly Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-200D-1F9B2": Emoji{
+		Key:        "1F9D1-200D-1F9B2",
+		Value:      "🧑‍🦲",
+		Descriptor: "Person: Bald",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-200D-1F9B3": Emoji{
+		Key:        "1F9D1-200D-1F9B3",
+		Value:      "🧑‍🦳",
+		Descriptor: "Person: White Hair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "12.1",
+	},
+	"1F9D1-200D-1F9BC": Emoji{
+		Key:        "1F9D1-200D-1F9BC",
+		Value:      "🧑‍🦼",
+		Descriptor: "Person in Motorized Wheelchair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.1",
+	},
+	"1F9D1-200D-1F9BC-200D-27A1-FE0F": Emoji{
+		Key:        "1F9D1-200D-1F9BC-200D-27A1-FE0F",
+		Value:      "🧑‍🦼‍➡️",
+		Descriptor: "Person in Motorized Wheelchair Facing Right",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9D1-200D-1F9BD": Emoji{
+		Key:        "1F9D1-200D-1F9BD",
+		Value:      "🧑‍🦽",
+		Descriptor: "Person in Manual Wheelchair",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "12.1",
+	},
+	"1F9D1-200D-1F9BD-200D-27A1-FE0F": Emoji{
+		Key:        "1F9D1-200D-1F9BD-200D-27A1-FE0F",
+		Value:      "🧑‍🦽‍➡️",
+		Descriptor: "Person in Manual Wheelchair Facing Right",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "15.1",
+	},
+	"1F9D1-200D-1F9D1-200D-1F9D2": Emoji{
+		Key:        "1F9D1-200D-1F9D1-200D-1F9D2",
+		Value:      "🧑‍🧑‍🧒",
+		Descriptor: "Family: Adult, Adult, Child",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-symbol",
+		Version:    "15.1",
+	},
+	"1F9D1-200D-1F9D1-200D-1F9D2-200D-1F9D2": Emoji{
+		Key:        "1F9D1-200D-1F9D1-200D-1F9D2-200D-1F9D2",
+		Value:      "🧑‍🧑‍🧒‍🧒",
+		Descriptor: "Family: Adult, Adult, Child, Child",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-symbol",
+		Version:    "15.1",
+	},
+	"1F9D1-200D-1F9D2": Emoji{
+		Key:        "1F9D1-200D-1F9D2",
+		Value:      "🧑‍🧒",
+		Descriptor: "Family: Adult, Child",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-symbol",
+		Version:    "15.1",
+	},
+	"1F9D1-200D-1F9D2-200D-1F9D2": Emoji{
+		Key:        "1F9D1-200D-1F9D2-200D-1F9D2",
+		Value:      "🧑‍🧒‍🧒",
+		Descriptor: "Family: Adult, Child, Child",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-symbol",
+		Version:    "15.1",
+	},
+	"1F9D1-200D-2695-FE0F": Emoji{
+		Key:        "1F9D1-200D-2695-FE0F",
+		Value:      "🧑‍⚕️",
+		Descriptor: "Health Worker",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-200D-2696-FE0F": Emoji{
+		Key:        "1F9D1-200D-2696-FE0F",
+		Value:      "🧑‍⚖️",
+		Descriptor: "Judge",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
+	},
+	"1F9D1-200D-2708-FE0F": Emoji{
+		Key:        "1F9D1-200D-2708-FE0F",
+		Value:      "🧑‍✈️",
+		Descriptor: "Pilot",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "12.1",
 	},
 	"1F9D2": Emoji{
 		Key:        "1F9D2",
 		Value:      "🧒",
 		Descriptor: "Child",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
 	},
 	"1F9D2-1F3FB": Emoji{
 		Key:        "1F9D2-1F3FB",
 		Value:      "🧒🏻",
 		Descriptor: "Child: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
 	},
 	"1F9D2-1F3FC": Emoji{
 		Key:        "1F9D2-1F3FC",
 		Value:      "🧒🏼",
 		Descriptor: "Child: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
 	},
 	"1F9D2-1F3FD": Emoji{
 		Key:        "1F9D2-1F3FD",
 		Value:      "🧒🏽",
 		Descriptor: "Child: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
 	},
 	"1F9D2-1F3FE": Emoji{
 		Key:        "1F9D2-1F3FE",
 		Value:      "🧒🏾",
 		Descriptor: "Child: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
 	},
 	"1F9D2-1F3FF": Emoji{
 		Key:        "1F9D2-1F3FF",
 		Value:      "🧒🏿",
 		Descriptor: "Child: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
 	},
 	"1F9D3": Emoji{
 		Key:        "1F9D3",
 		Value:      "🧓",
 		Descriptor: "Older Person",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
 	},
 	"1F9D3-1F3FB": Emoji{
 		Key:        "1F9D3-1F3FB",
 		Value:      "🧓🏻",
 		Descriptor: "Older Person: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
 	},
 	"1F9D3-1F3FC": Emoji{
 		Key:        "1F9D3-1F3FC",
 		Value:      "🧓🏼",
 		Descriptor: "Older Person: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
 	},
 	"1F9D3-1F3FD": Emoji{
 		Key:        "1F9D3-1F3FD",
 		Value:      "🧓🏽",
 		Descriptor: "Older Person: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
 	},
 	"1F9D3-1F3FE": Emoji{
 		Key:        "1F9D3-1F3FE",
 		Value:      "🧓🏾",
 		Descriptor: "Older Person: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
 	},
 	"1F9D3-1F3FF": Emoji{
 		Key:        "1F9D3-1F3FF",
 		Value:      "🧓🏿",
 		Descriptor: "Older Person: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
 	},
 	"1F9D4": Emoji{
 		Key:        "1F9D4",
 		Value:      "🧔",
-		Descriptor: "Man: Beard",
+		Descriptor: "Person: Beard",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
 	},
 	"1F9D4-1F3FB": Emoji{
 		Key:        "1F9D4-1F3FB",
 		Value:      "🧔🏻",
+		Descriptor: "Person: Light Skin Tone, Beard",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
+	},
+	"1F9D4-1F3FB-200D-2640-FE0F": Emoji{
+		Key:        "1F9D4-1F3FB-200D-2640-FE0F",
+		Value:      "🧔🏻‍♀️",
+		Descriptor: "Woman: Light Skin Tone, Beard",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "13.1",
+	},
+	"1F9D4-1F3FB-200D-2642-FE0F": Emoji{
+		Key:        "1F9D4-1F3FB-200D-2642-FE0F",
+		Value:      "🧔🏻‍♂️",
 		Descriptor: "Man: Light Skin Tone, Beard",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "13.1",
 	},
 	"1F9D4-1F3FC": Emoji{
 		Key:        "1F9D4-1F3FC",
 		Value:      "🧔🏼",
+		Descriptor: "Person: Medium-Light Skin Tone, Beard",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
+	},
+	"1F9D4-1F3FC-200D-2640-FE0F": Emoji{
+		Key:        "1F9D4-1F3FC-200D-2640-FE0F",
+		Value:      "🧔🏼‍♀️",
+		Descriptor: "Woman: Medium-Light Skin Tone, Beard",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "13.1",
+	},
+	"1F9D4-1F3FC-200D-2642-FE0F": Emoji{
+		Key:        "1F9D4-1F3FC-200D-2642-FE0F",
+		Value:      "🧔🏼‍♂️",
 		Descriptor: "Man: Medium-Light Skin Tone, Beard",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "13.1",
 	},
 	"1F9D4-1F3FD": Emoji{
 		Key:        "1F9D4-1F3FD",
 		Value:      "🧔🏽",
+		Descriptor: "Person: Medium Skin Tone, Beard",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
+	},
+	"1F9D4-1F3FD-200D-2640-FE0F": Emoji{
+		Key:        "1F9D4-1F3FD-200D-2640-FE0F",
+		Value:      "🧔🏽‍♀️",
+		Descriptor: "Woman: Medium Skin Tone, Beard",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "13.1",
+	},
+	"1F9D4-1F3FD-200D-2642-FE0F": Emoji{
+		Key:        "1F9D4-1F3FD-200D-2642-FE0F",
+		Value:      "🧔🏽‍♂️",
 		Descriptor: "Man: Medium Skin Tone, Beard",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "13.1",
 	},
 	"1F9D4-1F3FE": Emoji{
 		Key:        "1F9D4-1F3FE",
 		Value:      "🧔🏾",
+		Descriptor: "Person: Medium-Dark Skin Tone, Beard",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
+	},
+	"1F9D4-1F3FE-200D-2640-FE0F": Emoji{
+		Key:        "1F9D4-1F3FE-200D-2640-FE0F",
+		Value:      "🧔🏾‍♀️",
+		Descriptor: "Woman: Medium-Dark Skin Tone, Beard",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "13.1",
+	},
+	"1F9D4-1F3FE-200D-2642-FE0F": Emoji{
+		Key:        "1F9D4-1F3FE-200D-2642-FE0F",
+		Value:      "🧔🏾‍♂️",
 		Descriptor: "Man: Medium-Dark Skin Tone, Beard",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "13.1",
 	},
 	"1F9D4-1F3FF": Emoji{
 		Key:        "1F9D4-1F3FF",
 		Value:      "🧔🏿",
+		Descriptor: "Person: Dark Skin Tone, Beard",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "5.0",
+	},
+	"1F9D4-1F3FF-200D-2640-FE0F": Emoji{
+		Key:        "1F9D4-1F3FF-200D-2640-FE0F",
+		Value:      "🧔🏿‍♀️",
+		Descriptor: "Woman: Dark Skin Tone, Beard",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "13.1",
+	},
+	"1F9D4-1F3FF-200D-2642-FE0F": Emoji{
+		Key:        "1F9D4-1F3FF-200D-2642-FE0F",
+		Value:      "🧔🏿‍♂️",
 		Descriptor: "Man: Dark Skin Tone, Beard",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "13.1",
+	},
+	"1F9D4-200D-2640-FE0F": Emoji{
+		Key:        "1F9D4-200D-2640-FE0F",
+		Value:      "🧔‍♀️",
+		Descriptor: "Woman: Beard",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "13.1",
+	},
+	"1F9D4-200D-2642-FE0F": Emoji{
+		Key:        "1F9D4-200D-2642-FE0F",
+		Value:      "🧔‍♂️",
+		Descriptor: "Man: Beard",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person",
+		Version:    "13.1",
 	},
 	"1F9D5": Emoji{
 		Key:        "1F9D5",
 		Value:      "🧕",
 		Descriptor: "Woman With Headscarf",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "5.0",
 	},
 	"1F9D5-1F3FB": Emoji{
 		Key:        "1F9D5-1F3FB",
 		Value:      "🧕🏻",
 		Descriptor: "Woman With Headscarf: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "5.0",
 	},
 	"1F9D5-1F3FC": Emoji{
 		Key:        "1F9D5-1F3FC",
 		Value:      "🧕🏼",
 		Descriptor: "Woman With Headscarf: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "5.0",
 	},
 	"1F9D5-1F3FD": Emoji{
 		Key:        "1F9D5-1F3FD",
 		Value:      "🧕🏽",
 		Descriptor: "Woman With Headscarf: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "5.0",
 	},
 	"1F9D5-1F3FE": Emoji{
 		Key:        "1F9D5-1F3FE",
 		Value:      "🧕🏾",
 		Descriptor: "Woman With Headscarf: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "5.0",
 	},
 	"1F9D5-1F3FF": Emoji{
 		Key:        "1F9D5-1F3FF",
 		Value:      "🧕🏿",
 		Descriptor: "Woman With Headscarf: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "5.0",
 	},
 	"1F9D6": Emoji{
 		Key:        "1F9D6",
 		Value:      "🧖",
 		Descriptor: "Person in Steamy Room",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D6-1F3FB": Emoji{
 		Key:        "1F9D6-1F3FB",
 		Value:      "🧖🏻",
 		Descriptor: "Person in Steamy Room: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D6-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F9D6-1F3FB-200D-2640-FE0F",
 		Value:      "🧖🏻‍♀️",
 		Descriptor: "Woman in Steamy Room: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D6-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F9D6-1F3FB-200D-2642-FE0F",
 		Value:      "🧖🏻‍♂️",
 		Descriptor: "Man in Steamy Room: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D6-1F3FC": Emoji{
 		Key:        "1F9D6-1F3FC",
 		Value:      "🧖🏼",
 		Descriptor: "Person in Steamy Room: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D6-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F9D6-1F3FC-200D-2640-FE0F",
 		Value:      "🧖🏼‍♀️",
 		Descriptor: "Woman in Steamy Room: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D6-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F9D6-1F3FC-200D-2642-FE0F",
 		Value:      "🧖🏼‍♂️",
 		Descriptor: "Man in Steamy Room: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D6-1F3FD": Emoji{
 		Key:        "1F9D6-1F3FD",
 		Value:      "🧖🏽",
 		Descriptor: "Person in Steamy Room: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D6-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F9D6-1F3FD-200D-2640-FE0F",
 		Value:      "🧖🏽‍♀️",
 		Descriptor: "Woman in Steamy Room: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D6-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F9D6-1F3FD-200D-2642-FE0F",
 		Value:      "🧖🏽‍♂️",
 		Descriptor: "Man in Steamy Room: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D6-1F3FE": Emoji{
 		Key:        "1F9D6-1F3FE",
 		Value:      "🧖🏾",
 		Descriptor: "Person in Steamy Room: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D6-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F9D6-1F3FE-200D-2640-FE0F",
 		Value:      "🧖🏾‍♀️",
 		Descriptor: "Woman in Steamy Room: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D6-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F9D6-1F3FE-200D-2642-FE0F",
 		Value:      "🧖🏾‍♂️",
 		Descriptor: "Man in Steamy Room: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D6-1F3FF": Emoji{
 		Key:        "1F9D6-1F3FF",
 		Value:      "🧖🏿",
 		Descriptor: "Person in Steamy Room: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D6-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F9D6-1F3FF-200D-2640-FE0F",
 		Value:      "🧖🏿‍♀️",
 		Descriptor: "Woman in Steamy Room: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D6-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F9D6-1F3FF-200D-2642-FE0F",
 		Value:      "🧖🏿‍♂️",
 		Descriptor: "Man in Steamy Room: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D6-200D-2640-FE0F": Emoji{
 		Key:        "1F9D6-200D-2640-FE0F",
 		Value:      "🧖‍♀️",
 		Descriptor: "Woman in Steamy Room",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D6-200D-2642-FE0F": Emoji{
 		Key:        "1F9D6-200D-2642-FE0F",
 		Value:      "🧖‍♂️",
 		Descriptor: "Man in Steamy Room",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D7": Emoji{
 		Key:        "1F9D7",
 		Value:      "🧗",
 		Descriptor: "Person Climbing",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D7-1F3FB": Emoji{
 		Key:        "1F9D7-1F3FB",
 		Value:      "🧗🏻",
 		Descriptor: "Person Climbing: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D7-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F9D7-1F3FB-200D-2640-FE0F",
 		Value:      "🧗🏻‍♀️",
 		Descriptor: "Woman Climbing: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D7-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F9D7-1F3FB-200D-2642-FE0F",
 		Value:      "🧗🏻‍♂️",
 		Descriptor: "Man Climbing: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D7-1F3FC": Emoji{
 		Key:        "1F9D7-1F3FC",
 		Value:      "🧗🏼",
 		Descriptor: "Person Climbing: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D7-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F9D7-1F3FC-200D-2640-FE0F",
 		Value:      "🧗🏼‍♀️",
 		Descriptor: "Woman Climbing: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D7-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F9D7-1F3FC-200D-2642-FE0F",
 		Value:      "🧗🏼‍♂️",
 		Descriptor: "Man Climbing: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D7-1F3FD": Emoji{
 		Key:        "1F9D7-1F3FD",
 		Value:      "🧗🏽",
 		Descriptor: "Person Climbing: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D7-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F9D7-1F3FD-200D-2640-FE0F",
 		Value:      "🧗🏽‍♀️",
 		Descriptor: "Woman Climbing: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D7-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F9D7-1F3FD-200D-2642-FE0F",
 		Value:      "🧗🏽‍♂️",
 		Descriptor: "Man Climbing: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D7-1F3FE": Emoji{
 		Key:        "1F9D7-1F3FE",
 		Value:      "🧗🏾",
 		Descriptor: "Person Climbing: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D7-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F9D7-1F3FE-200D-2640-FE0F",
 		Value:      "🧗🏾‍♀️",
 		Descriptor: "Woman Climbing: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D7-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F9D7-1F3FE-200D-2642-FE0F",
 		Value:      "🧗🏾‍♂️",
 		Descriptor: "Man Climbing: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D7-1F3FF": Emoji{
 		Key:        "1F9D7-1F3FF",
 		Value:      "🧗🏿",
 		Descriptor: "Person Climbing: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D7-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F9D7-1F3FF-200D-2640-FE0F",
 		Value:      "🧗🏿‍♀️",
 		Descriptor: "Woman Climbing: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D7-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F9D7-1F3FF-200D-2642-FE0F",
 		Value:      "🧗🏿‍♂️",
 		Descriptor: "Man Climbing: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D7-200D-2640-FE0F": Emoji{
 		Key:        "1F9D7-200D-2640-FE0F",
 		Value:      "🧗‍♀️",
 		Descriptor: "Woman Climbing",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D7-200D-2642-FE0F": Emoji{
 		Key:        "1F9D7-200D-2642-FE0F",
 		Value:      "🧗‍♂️",
 		Descriptor: "Man Climbing",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-activity",
+		Version:    "5.0",
 	},
 	"1F9D8": Emoji{
 		Key:        "1F9D8",
 		Value:      "🧘",
 		Descriptor: "Person in Lotus Position",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "5.0",
 	},
 	"1F9D8-1F3FB": Emoji{
 		Key:        "1F9D8-1F3FB",
 		Value:      "🧘🏻",
 		Descriptor: "Person in Lotus Position: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "5.0",
 	},
 	"1F9D8-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F9D8-1F3FB-200D-2640-FE0F",
 		Value:      "🧘🏻‍♀️",
 		Descriptor: "Woman in Lotus Position: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "5.0",
 	},
 	"1F9D8-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F9D8-1F3FB-200D-2642-FE0F",
 		Value:      "🧘🏻‍♂️",
 		Descriptor: "Man in Lotus Position: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "5.0",
 	},
 	"1F9D8-1F3FC": Emoji{
 		Key:        "1F9D8-1F3FC",
 		Value:      "🧘🏼",
 		Descriptor: "Person in Lotus Position: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "5.0",
 	},
 	"1F9D8-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F9D8-1F3FC-200D-2640-FE0F",
 		Value:      "🧘🏼‍♀️",
 		Descriptor: "Woman in Lotus Position: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "5.0",
 	},
 	"1F9D8-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F9D8-1F3FC-200D-2642-FE0F",
 		Value:      "🧘🏼‍♂️",
 		Descriptor: "Man in Lotus Position: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "5.0",
 	},
 	"1F9D8-1F3FD": Emoji{
 		Key:        "1F9D8-1F3FD",
 		Value:      "🧘🏽",
 		Descriptor: "Person in Lotus Position: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "5.0",
 	},
 	"1F9D8-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F9D8-1F3FD-200D-2640-FE0F",
 		Value:      "🧘🏽‍♀️",
 		Descriptor: "Woman in Lotus Position: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "5.0",
 	},
 	"1F9D8-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F9D8-1F3FD-200D-2642-FE0F",
 		Value:      "🧘🏽‍♂️",
 		Descriptor: "Man in Lotus Position: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "5.0",
 	},
 	"1F9D8-1F3FE": Emoji{
 		Key:        "1F9D8-1F3FE",
 		Value:      "🧘🏾",
 		Descriptor: "Person in Lotus Position: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "5.0",
 	},
 	"1F9D8-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F9D8-1F3FE-200D-2640-FE0F",
 		Value:      "🧘🏾‍♀️",
 		Descriptor: "Woman in Lotus Position: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "5.0",
 	},
 	"1F9D8-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F9D8-1F3FE-200D-2642-FE0F",
 		Value:      "🧘🏾‍♂️",
 		Descriptor: "Man in Lotus Position: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "5.0",
 	},
 	"1F9D8-1F3FF": Emoji{
 		Key:        "1F9D8-1F3FF",
 		Value:      "🧘🏿",
 		Descriptor: "Person in Lotus Position: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "5.0",
 	},
 	"1F9D8-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F9D8-1F3FF-200D-2640-FE0F",
 		Value:      "🧘🏿‍♀️",
 		Descriptor: "Woman in Lotus Position: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "5.0",
 	},
 	"1F9D8-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F9D8-1F3FF-200D-2642-FE0F",
 		Value:      "🧘🏿‍♂️",
 		Descriptor: "Man in Lotus Position: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "5.0",
 	},
 	"1F9D8-200D-2640-FE0F": Emoji{
 		Key:        "1F9D8-200D-2640-FE0F",
 		Value:      "🧘‍♀️",
 		Descriptor: "Woman in Lotus Position",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "5.0",
 	},
 	"1F9D8-200D-2642-FE0F": Emoji{
 		Key:        "1F9D8-200D-2642-FE0F",
 		Value:      "🧘‍♂️",
 		Descriptor: "Man in Lotus Position",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-resting",
+		Version:    "5.0",
 	},
 	"1F9D9": Emoji{
 		Key:        "1F9D9",
 		Value:      "🧙",
 		Descriptor: "Mage",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9D9-1F3FB": Emoji{
 		Key:        "1F9D9-1F3FB",
 		Value:      "🧙🏻",
 		Descriptor: "Mage: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9D9-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F9D9-1F3FB-200D-2640-FE0F",
 		Value:      "🧙🏻‍♀️",
 		Descriptor: "Woman Mage: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9D9-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F9D9-1F3FB-200D-2642-FE0F",
 		Value:      "🧙🏻‍♂️",
 		Descriptor: "Man Mage: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9D9-1F3FC": Emoji{
 		Key:        "1F9D9-1F3FC",
 		Value:      "🧙🏼",
 		Descriptor: "Mage: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9D9-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F9D9-1F3FC-200D-2640-FE0F",
 		Value:      "🧙🏼‍♀️",
 		Descriptor: "Woman Mage: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9D9-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F9D9-1F3FC-200D-2642-FE0F",
 		Value:      "🧙🏼‍♂️",
 		Descriptor: "Man Mage: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9D9-1F3FD": Emoji{
 		Key:        "1F9D9-1F3FD",
 		Value:      "🧙🏽",
 		Descriptor: "Mage: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9D9-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F9D9-1F3FD-200D-2640-FE0F",
 		Value:      "🧙🏽‍♀️",
 		Descriptor: "Woman Mage: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9D9-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F9D9-1F3FD-200D-2642-FE0F",
 		Value:      "🧙🏽‍♂️",
 		Descriptor: "Man Mage: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9D9-1F3FE": Emoji{
 		Key:        "1F9D9-1F3FE",
 		Value:      "🧙🏾",
 		Descriptor: "Mage: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9D9-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F9D9-1F3FE-200D-2640-FE0F",
 		Value:      "🧙🏾‍♀️",
 		Descriptor: "Woman Mage: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9D9-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F9D9-1F3FE-200D-2642-FE0F",
 		Value:      "🧙🏾‍♂️",
 		Descriptor: "Man Mage: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9D9-1F3FF": Emoji{
 		Key:        "1F9D9-1F3FF",
 		Value:      "🧙🏿",
 		Descriptor: "Mage: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9D9-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F9D9-1F3FF-200D-2640-FE0F",
 		Value:      "🧙🏿‍♀️",
 		Descriptor: "Woman Mage: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9D9-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F9D9-1F3FF-200D-2642-FE0F",
 		Value:      "🧙🏿‍♂️",
 		Descriptor: "Man Mage: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9D9-200D-2640-FE0F": Emoji{
 		Key:        "1F9D9-200D-2640-FE0F",
 		Value:      "🧙‍♀️",
 		Descriptor: "Woman Mage",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9D9-200D-2642-FE0F": Emoji{
 		Key:        "1F9D9-200D-2642-FE0F",
 		Value:      "🧙‍♂️",
 		Descriptor: "Man Mage",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DA": Emoji{
 		Key:        "1F9DA",
 		Value:      "🧚",
 		Descriptor: "Fairy",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DA-1F3FB": Emoji{
 		Key:        "1F9DA-1F3FB",
 		Value:      "🧚🏻",
 		Descriptor: "Fairy: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DA-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F9DA-1F3FB-200D-2640-FE0F",
 		Value:      "🧚🏻‍♀️",
 		Descriptor: "Woman Fairy: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DA-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F9DA-1F3FB-200D-2642-FE0F",
 		Value:      "🧚🏻‍♂️",
 		Descriptor: "Man Fairy: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DA-1F3FC": Emoji{
 		Key:        "1F9DA-1F3FC",
 		Value:      "🧚🏼",
 		Descriptor: "Fairy: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DA-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F9DA-1F3FC-200D-2640-FE0F",
 		Value:      "🧚🏼‍♀️",
 		Descriptor: "Woman Fairy: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DA-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F9DA-1F3FC-200D-2642-FE0F",
 		Value:      "🧚🏼‍♂️",
 		Descriptor: "Man Fairy: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DA-1F3FD": Emoji{
 		Key:        "1F9DA-1F3FD",
 		Value:      "🧚🏽",
 		Descriptor: "Fairy: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DA-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F9DA-1F3FD-200D-2640-FE0F",
 		Value:      "🧚🏽‍♀️",
 		Descriptor: "Woman Fairy: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DA-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F9DA-1F3FD-200D-2642-FE0F",
 		Value:      "🧚🏽‍♂️",
 		Descriptor: "Man Fairy: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DA-1F3FE": Emoji{
 		Key:        "1F9DA-1F3FE",
 		Value:      "🧚🏾",
 		Descriptor: "Fairy: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DA-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F9DA-1F3FE-200D-2640-FE0F",
 		Value:      "🧚🏾‍♀️",
 		Descriptor: "Woman Fairy: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DA-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F9DA-1F3FE-200D-2642-FE0F",
 		Value:      "🧚🏾‍♂️",
 		Descriptor: "Man Fairy: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DA-1F3FF": Emoji{
 		Key:        "1F9DA-1F3FF",
 		Value:      "🧚🏿",
 		Descriptor: "Fairy: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DA-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F9DA-1F3FF-200D-2640-FE0F",
 		Value:      "🧚🏿‍♀️",
 		Descriptor: "Woman Fairy: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DA-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F9DA-1F3FF-200D-2642-FE0F",
 		Value:      "🧚🏿‍♂️",
 		Descriptor: "Man Fairy: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DA-200D-2640-FE0F": Emoji{
 		Key:        "1F9DA-200D-2640-FE0F",
 		Value:      "🧚‍♀️",
 		Descriptor: "Woman Fairy",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DA-200D-2642-FE0F": Emoji{
 		Key:        "1F9DA-200D-2642-FE0F",
 		Value:      "🧚‍♂️",
 		Descriptor: "Man Fairy",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DB": Emoji{
 		Key:        "1F9DB",
 		Value:      "🧛",
 		Descriptor: "Vampire",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DB-1F3FB": Emoji{
 		Key:        "1F9DB-1F3FB",
 		Value:      "🧛🏻",
 		Descriptor: "Vampire: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DB-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F9DB-1F3FB-200D-2640-FE0F",
 		Value:      "🧛🏻‍♀️",
 		Descriptor: "Woman Vampire: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DB-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F9DB-1F3FB-200D-2642-FE0F",
 		Value:      "🧛🏻‍♂️",
 		Descriptor: "Man Vampire: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DB-1F3FC": Emoji{
 		Key:        "1F9DB-1F3FC",
 		Value:      "🧛🏼",
 		Descriptor: "Vampire: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DB-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F9DB-1F3FC-200D-2640-FE0F",
 		Value:      "🧛🏼‍♀️",
 		Descriptor: "Woman Vampire: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DB-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F9DB-1F3FC-200D-2642-FE0F",
 		Value:      "🧛🏼‍♂️",
 		Descriptor: "Man Vampire: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DB-1F3FD": Emoji{
 		Key:        "1F9DB-1F3FD",
 		Value:      "🧛🏽",
 		Descriptor: "Vampire: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DB-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F9DB-1F3FD-200D-2640-FE0F",
 		Value:      "🧛🏽‍♀️",
 		Descriptor: "Woman Vampire: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DB-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F9DB-1F3FD-200D-2642-FE0F",
 		Value:      "🧛🏽‍♂️",
 		Descriptor: "Man Vampire: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DB-1F3FE": Emoji{
 		Key:        "1F9DB-1F3FE",
 		Value:      "🧛🏾",
 		Descriptor: "Vampire: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DB-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F9DB-1F3FE-200D-2640-FE0F",
 		Value:      "🧛🏾‍♀️",
 		Descriptor: "Woman Vampire: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DB-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F9DB-1F3FE-200D-2642-FE0F",
 		Value:      "🧛🏾‍♂️",
 		Descriptor: "Man Vampire: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DB-1F3FF": Emoji{
 		Key:        "1F9DB-1F3FF",
 		Value:      "🧛🏿",
 		Descriptor: "Vampire: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DB-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F9DB-1F3FF-200D-2640-FE0F",
 		Value:      "🧛🏿‍♀️",
 		Descriptor: "Woman Vampire: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DB-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F9DB-1F3FF-200D-2642-FE0F",
 		Value:      "🧛🏿‍♂️",
 		Descriptor: "Man Vampire: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DB-200D-2640-FE0F": Emoji{
 		Key:        "1F9DB-200D-2640-FE0F",
 		Value:      "🧛‍♀️",
 		Descriptor: "Woman Vampire",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DB-200D-2642-FE0F": Emoji{
 		Key:        "1F9DB-200D-2642-FE0F",
 		Value:      "🧛‍♂️",
 		Descriptor: "Man Vampire",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DC": Emoji{
 		Key:        "1F9DC",
 		Value:      "🧜",
 		Descriptor: "Merperson",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DC-1F3FB": Emoji{
 		Key:        "1F9DC-1F3FB",
 		Value:      "🧜🏻",
 		Descriptor: "Merperson: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DC-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F9DC-1F3FB-200D-2640-FE0F",
 		Value:      "🧜🏻‍♀️",
 		Descriptor: "Mermaid: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DC-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F9DC-1F3FB-200D-2642-FE0F",
 		Value:      "🧜🏻‍♂️",
 		Descriptor: "Merman: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DC-1F3FC": Emoji{
 		Key:        "1F9DC-1F3FC",
 		Value:      "🧜🏼",
 		Descriptor: "Merperson: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DC-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F9DC-1F3FC-200D-2640-FE0F",
 		Value:      "🧜🏼‍♀️",
 		Descriptor: "Mermaid: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DC-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F9DC-1F3FC-200D-2642-FE0F",
 		Value:      "🧜🏼‍♂️",
 		Descriptor: "Merman: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DC-1F3FD": Emoji{
 		Key:        "1F9DC-1F3FD",
 		Value:      "🧜🏽",
 		Descriptor: "Merperson: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DC-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F9DC-1F3FD-200D-2640-FE0F",
 		Value:      "🧜🏽‍♀️",
 		Descriptor: "Mermaid: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DC-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F9DC-1F3FD-200D-2642-FE0F",
 		Value:      "🧜🏽‍♂️",
 		Descriptor: "Merman: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DC-1F3FE": Emoji{
 		Key:        "1F9DC-1F3FE",
 		Value:      "🧜🏾",
 		Descriptor: "Merperson: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DC-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F9DC-1F3FE-200D-2640-FE0F",
 		Value:      "🧜🏾‍♀️",
 		Descriptor: "Mermaid: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DC-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F9DC-1F3FE-200D-2642-FE0F",
 		Value:      "🧜🏾‍♂️",
 		Descriptor: "Merman: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DC-1F3FF": Emoji{
 		Key:        "1F9DC-1F3FF",
 		Value:      "🧜🏿",
 		Descriptor: "Merperson: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DC-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F9DC-1F3FF-200D-2640-FE0F",
 		Value:      "🧜🏿‍♀️",
 		Descriptor: "Mermaid: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DC-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F9DC-1F3FF-200D-2642-FE0F",
 		Value:      "🧜🏿‍♂️",
 		Descriptor: "Merman: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DC-200D-2640-FE0F": Emoji{
 		Key:        "1F9DC-200D-2640-FE0F",
 		Value:      "🧜‍♀️",
 		Descriptor: "Mermaid",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DC-200D-2642-FE0F": Emoji{
 		Key:        "1F9DC-200D-2642-FE0F",
 		Value:      "🧜‍♂️",
 		Descriptor: "Merman",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DD": Emoji{
 		Key:        "1F9DD",
 		Value:      "🧝",
 		Descriptor: "Elf",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DD-1F3FB": Emoji{
 		Key:        "1F9DD-1F3FB",
 		Value:      "🧝🏻",
 		Descriptor: "Elf: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DD-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "1F9DD-1F3FB-200D-2640-FE0F",
 		Value:      "🧝🏻‍♀️",
 		Descriptor: "Woman Elf: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DD-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "1F9DD-1F3FB-200D-2642-FE0F",
 		Value:      "🧝🏻‍♂️",
 		Descriptor: "Man Elf: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DD-1F3FC": Emoji{
 		Key:        "1F9DD-1F3FC",
 		Value:      "🧝🏼",
 		Descriptor: "Elf: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DD-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "1F9DD-1F3FC-200D-2640-FE0F",
 		Value:      "🧝🏼‍♀️",
 		Descriptor: "Woman Elf: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DD-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "1F9DD-1F3FC-200D-2642-FE0F",
 		Value:      "🧝🏼‍♂️",
 		Descriptor: "Man Elf: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DD-1F3FD": Emoji{
 		Key:        "1F9DD-1F3FD",
 		Value:      "🧝🏽",
 		Descriptor: "Elf: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DD-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "1F9DD-1F3FD-200D-2640-FE0F",
 		Value:      "🧝🏽‍♀️",
 		Descriptor: "Woman Elf: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DD-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "1F9DD-1F3FD-200D-2642-FE0F",
 		Value:      "🧝🏽‍♂️",
 		Descriptor: "Man Elf: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DD-1F3FE": Emoji{
 		Key:        "1F9DD-1F3FE",
 		Value:      "🧝🏾",
 		Descriptor: "Elf: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DD-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "1F9DD-1F3FE-200D-2640-FE0F",
 		Value:      "🧝🏾‍♀️",
 		Descriptor: "Woman Elf: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DD-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "1F9DD-1F3FE-200D-2642-FE0F",
 		Value:      "🧝🏾‍♂️",
 		Descriptor: "Man Elf: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DD-1F3FF": Emoji{
 		Key:        "1F9DD-1F3FF",
 		Value:      "🧝🏿",
 		Descriptor: "Elf: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DD-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "1F9DD-1F3FF-200D-2640-FE0F",
 		Value:      "🧝🏿‍♀️",
 		Descriptor: "Woman Elf: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DD-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "1F9DD-1F3FF-200D-2642-FE0F",
 		Value:      "🧝🏿‍♂️",
 		Descriptor: "Man Elf: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DD-200D-2640-FE0F": Emoji{
 		Key:        "1F9DD-200D-2640-FE0F",
 		Value:      "🧝‍♀️",
 		Descriptor: "Woman Elf",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DD-200D-2642-FE0F": Emoji{
 		Key:        "1F9DD-200D-2642-FE0F",
 		Value:      "🧝‍♂️",
 		Descriptor: "Man Elf",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DE": Emoji{
 		Key:        "1F9DE",
 		Value:      "🧞",
 		Descriptor: "Genie",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DE-200D-2640-FE0F": Emoji{
 		Key:        "1F9DE-200D-2640-FE0F",
 		Value:      "🧞‍♀️",
 		Descriptor: "Woman Genie",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DE-200D-2642-FE0F": Emoji{
 		Key:        "1F9DE-200D-2642-FE0F",
 		Value:      "🧞‍♂️",
 		Descriptor: "Man Genie",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DF": Emoji{
 		Key:        "1F9DF",
 		Value:      "🧟",
 		Descriptor: "Zombie",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DF-200D-2640-FE0F": Emoji{
 		Key:        "1F9DF-200D-2640-FE0F",
 		Value:      "🧟‍♀️",
 		Descriptor: "Woman Zombie",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9DF-200D-2642-FE0F": Emoji{
 		Key:        "1F9DF-200D-2642-FE0F",
 		Value:      "🧟‍♂️",
 		Descriptor: "Man Zombie",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-fantasy",
+		Version:    "5.0",
 	},
 	"1F9E0": Emoji{
 		Key:        "1F9E0",
 		Value:      "🧠",
 		Descriptor: "Brain",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "5.0",
 	},
 	"1F9E1": Emoji{
 		Key:        "1F9E1",
 		Value:      "🧡",
 		Descriptor: "Orange Heart",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "5.0",
 	},
 	"1F9E2": Emoji{
 		Key:        "1F9E2",
 		Value:      "🧢",
 		Descriptor: "Billed Cap",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "5.0",
 	},
 	"1F9E3": Emoji{
 		Key:        "1F9E3",
 		Value:      "🧣",
 		Descriptor: "Scarf",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "5.0",
 	},
 	"1F9E4": Emoji{
 		Key:        "1F9E4",
 		Value:      "🧤",
 		Descriptor: "Gloves",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "5.0",
 	},
 	"1F9E5": Emoji{
 		Key:        "1F9E5",
 		Value:      "🧥",
 		Descriptor: "Coat",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "5.0",
 	},
 	"1F9E6": Emoji{
 		Key:        "1F9E6",
 		Value:      "🧦",
 		Descriptor: "Socks",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "5.0",
 	},
 	"1F9E7": Emoji{
 		Key:        "1F9E7",
 		Value:      "🧧",
 		Descriptor: "Red Envelope",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "event",
+		Version:    "11.0",
 	},
 	"1F9E8": Emoji{
 		Key:        "1F9E8",
 		Value:      "🧨",
 		Descriptor: "Firecracker",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "event",
+		Version:    "11.0",
 	},
 	"1F9E9": Emoji{
 		Key:        "1F9E9",
 		Value:      "🧩",
-		Descriptor: "Jigsaw",
+		Descriptor: "Puzzle Piece",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "11.0",
 	},
 	"1F9EA": Emoji{
 		Key:        "1F9EA",
 		Value:      "🧪",
 		Descriptor: "Test Tube",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "science",
+		Version:    "11.0",
 	},
 	"1F9EB": Emoji{
 		Key:        "1F9EB",
 		Value:      "🧫",
 		Descriptor: "Petri Dish",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "science",
+		Version:    "11.0",
 	},
 	"1F9EC": Emoji{
 		Key:        "1F9EC",
 		Value:      "🧬",
-		Descriptor: "DNA",
+		Descriptor: "Dna",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "science",
+		Version:    "11.0",
 	},
 	"1F9ED": Emoji{
 		Key:        "1F9ED",
 		Value:      "🧭",
 		Descriptor: "Compass",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-map",
+		Version:    "11.0",
 	},
 	"1F9EE": Emoji{
 		Key:        "1F9EE",
 		Value:      "🧮",
 		Descriptor: "Abacus",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "computer",
+		Version:    "11.0",
 	},
 	"1F9EF": Emoji{
 		Key:        "1F9EF",
 		Value:      "🧯",
 		Descriptor: "Fire Extinguisher",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "11.0",
 	},
 	"1F9F0": Emoji{
 		Key:        "1F9F0",
 		Value:      "🧰",
 		Descriptor: "Toolbox",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "11.0",
 	},
 	"1F9F1": Emoji{
 		Key:        "1F9F1",
 		Value:      "🧱",
 		Descriptor: "Brick",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "11.0",
 	},
 	"1F9F2": Emoji{
 		Key:        "1F9F2",
 		Value:      "🧲",
 		Descriptor: "Magnet",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "11.0",
 	},
 	"1F9F3": Emoji{
 		Key:        "1F9F3",
 		Value:      "🧳",
 		Descriptor: "Luggage",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "hotel",
+		Version:    "11.0",
 	},
 	"1F9F4": Emoji{
 		Key:        "1F9F4",
 		Value:      "🧴",
 		Descriptor: "Lotion Bottle",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "11.0",
 	},
 	"1F9F5": Emoji{
 		Key:        "1F9F5",
 		Value:      "🧵",
 		Descriptor: "Thread",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "arts & crafts",
+		Version:    "11.0",
 	},
 	"1F9F6": Emoji{
 		Key:        "1F9F6",
 		Value:      "🧶",
 		Descriptor: "Yarn",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "arts & crafts",
+		Version:    "11.0",
 	},
 	"1F9F7": Emoji{
 		Key:        "1F9F7",
 		Value:      "🧷",
 		Descriptor: "Safety Pin",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "11.0",
 	},
 	"1F9F8": Emoji{
 		Key:        "1F9F8",
 		Value:      "🧸",
 		Descriptor: "Teddy Bear",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "11.0",
 	},
 	"1F9F9": Emoji{
 		Key:        "1F9F9",
 		Value:      "🧹",
 		Descriptor: "Broom",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "11.0",
 	},
 	"1F9FA": Emoji{
 		Key:        "1F9FA",
 		Value:      "🧺",
 		Descriptor: "Basket",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "11.0",
 	},
 	"1F9FB": Emoji{
 		Key:        "1F9FB",
 		Value:      "🧻",
 		Descriptor: "Roll of Paper",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "11.0",
 	},
 	"1F9FC": Emoji{
 		Key:        "1F9FC",
 		Value:      "🧼",
 		Descriptor: "Soap",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "11.0",
 	},
 	"1F9FD": Emoji{
 		Key:        "1F9FD",
 		Value:      "🧽",
 		Descriptor: "Sponge",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "11.0",
 	},
 	"1F9FE": Emoji{
 		Key:        "1F9FE",
 		Value:      "🧾",
 		Descriptor: "Receipt",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "money",
+		Version:    "11.0",
 	},
 	"1F9FF": Emoji{
 		Key:        "1F9FF",
 		Value:      "🧿",
 		Descriptor: "Nazar Amulet",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "other-object",
+		Version:    "11.0",
+	},
+	"1FA70": Emoji{
+		Key:        "1FA70",
+		Value:      "🩰",
+		Descriptor: "Ballet Shoes",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "12.0",
+	},
+	"1FA71": Emoji{
+		Key:        "1FA71",
+		Value:      "🩱",
+		Descriptor: "One-Piece Swimsuit",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "12.0",
+	},
+	"1FA72": Emoji{
+		Key:        "1FA72",
+		Value:      "🩲",
+		Descriptor: "Briefs",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "12.0",
+	},
+	"1FA73": Emoji{
+		Key:        "1FA73",
+		Value:      "🩳",
+		Descriptor: "Shorts",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "12.0",
+	},
+	"1FA74": Emoji{
+		Key:        "1FA74",
+		Value:      "🩴",
+		Descriptor: "Thong Sandal",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "13.0",
+	},
+	"1FA75": Emoji{
+		Key:        "1FA75",
+		Value:      "🩵",
+		Descriptor: "Light Blue Heart",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "15.0",
+	},
+	"1FA76": Emoji{
+		Key:        "1FA76",
+		Value:      "🩶",
+		Descriptor: "Grey Heart",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "15.0",
+	},
+	"1FA77": Emoji{
+		Key:        "1FA77",
+		Value:      "🩷",
+		Descriptor: "Pink Heart",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "15.0",
+	},
+	"1FA78": Emoji{
+		Key:        "1FA78",
+		Value:      "🩸",
+		Descriptor: "Drop of Blood",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "medical",
+		Version:    "12.0",
+	},
+	"1FA79": Emoji{
+		Key:        "1FA79",
+		Value:      "🩹",
+		Descriptor: "Adhesive Bandage",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "medical",
+		Version:    "12.0",
+	},
+	"1FA7A": Emoji{
+		Key:        "1FA7A",
+		Value:      "🩺",
+		Descriptor: "Stethoscope",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "medical",
+		Version:    "12.0",
+	},
+	"1FA7B": Emoji{
+		Key:        "1FA7B",
+		Value:      "🩻",
+		Descriptor: "X-Ray",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "medical",
+		Version:    "14.0",
+	},
+	"1FA7C": Emoji{
+		Key:        "1FA7C",
+		Value:      "🩼",
+		Descriptor: "Crutch",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "medical",
+		Version:    "14.0",
+	},
+	"1FA80": Emoji{
+		Key:        "1FA80",
+		Value:      "🪀",
+		Descriptor: "Yo-Yo",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "12.0",
+	},
+	"1FA81": Emoji{
+		Key:        "1FA81",
+		Value:      "🪁",
+		Descriptor: "Kite",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "12.0",
+	},
+	"1FA82": Emoji{
+		Key:        "1FA82",
+		Value:      "🪂",
+		Descriptor: "Parachute",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-air",
+		Version:    "12.0",
+	},
+	"1FA83": Emoji{
+		Key:        "1FA83",
+		Value:      "🪃",
+		Descriptor: "Boomerang",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "13.0",
+	},
+	"1FA84": Emoji{
+		Key:        "1FA84",
+		Value:      "🪄",
+		Descriptor: "Magic Wand",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "13.0",
+	},
+	"1FA85": Emoji{
+		Key:        "1FA85",
+		Value:      "🪅",
+		Descriptor: "Piñata",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "13.0",
+	},
+	"1FA86": Emoji{
+		Key:        "1FA86",
+		Value:      "🪆",
+		Descriptor: "Nesting Dolls",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "13.0",
+	},
+	"1FA87": Emoji{
+		Key:        "1FA87",
+		Value:      "🪇",
+		Descriptor: "Maracas",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "musical-instrument",
+		Version:    "15.0",
+	},
+	"1FA88": Emoji{
+		Key:        "1FA88",
+		Value:      "🪈",
+		Descriptor: "Flute",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "musical-instrument",
+		Version:    "15.0",
+	},
+	"1FA90": Emoji{
+		Key:        "1FA90",
+		Value:      "🪐",
+		Descriptor: "Ringed Planet",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "12.0",
+	},
+	"1FA91": Emoji{
+		Key:        "1FA91",
+		Value:      "🪑",
+		Descriptor: "Chair",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "12.0",
+	},
+	"1FA92": Emoji{
+		Key:        "1FA92",
+		Value:      "🪒",
+		Descriptor: "Razor",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "12.0",
+	},
+	"1FA93": Emoji{
+		Key:        "1FA93",
+		Value:      "🪓",
+		Descriptor: "Axe",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "12.0",
+	},
+	"1FA94": Emoji{
+		Key:        "1FA94",
+		Value:      "🪔",
+		Descriptor: "Diya Lamp",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "light & video",
+		Version:    "12.0",
+	},
+	"1FA95": Emoji{
+		Key:        "1FA95",
+		Value:      "🪕",
+		Descriptor: "Banjo",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "musical-instrument",
+		Version:    "12.0",
+	},
+	"1FA96": Emoji{
+		Key:        "1FA96",
+		Value:      "🪖",
+		Descriptor: "Military Helmet",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "13.0",
+	},
+	"1FA97": Emoji{
+		Key:        "1FA97",
+		Value:      "🪗",
+		Descriptor: "Accordion",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "musical-instrument",
+		Version:    "13.0",
+	},
+	"1FA98": Emoji{
+		Key:        "1FA98",
+		Value:      "🪘",
+		Descriptor: "Long Drum",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "musical-instrument",
+		Version:    "13.0",
+	},
+	"1FA99": Emoji{
+		Key:        "1FA99",
+		Value:      "🪙",
+		Descriptor: "Coin",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "money",
+		Version:    "13.0",
+	},
+	"1FA9A": Emoji{
+		Key:        "1FA9A",
+		Value:      "🪚",
+		Descriptor: "Carpentry Saw",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "13.0",
+	},
+	"1FA9B": Emoji{
+		Key:        "1FA9B",
+		Value:      "🪛",
+		Descriptor: "Screwdriver",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "13.0",
+	},
+	"1FA9C": Emoji{
+		Key:        "1FA9C",
+		Value:      "🪜",
+		Descriptor: "Ladder",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "13.0",
+	},
+	"1FA9D": Emoji{
+		Key:        "1FA9D",
+		Value:      "🪝",
+		Descriptor: "Hook",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "13.0",
+	},
+	"1FA9E": Emoji{
+		Key:        "1FA9E",
+		Value:      "🪞",
+		Descriptor: "Mirror",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "13.0",
+	},
+	"1FA9F": Emoji{
+		Key:        "1FA9F",
+		Value:      "🪟",
+		Descriptor: "Window",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "13.0",
+	},
+	"1FAA0": Emoji{
+		Key:        "1FAA0",
+		Value:      "🪠",
+		Descriptor: "Plunger",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "13.0",
+	},
+	"1FAA1": Emoji{
+		Key:        "1FAA1",
+		Value:      "🪡",
+		Descriptor: "Sewing Needle",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "arts & crafts",
+		Version:    "13.0",
+	},
+	"1FAA2": Emoji{
+		Key:        "1FAA2",
+		Value:      "🪢",
+		Descriptor: "Knot",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "arts & crafts",
+		Version:    "13.0",
+	},
+	"1FAA3": Emoji{
+		Key:        "1FAA3",
+		Value:      "🪣",
+		Descriptor: "Bucket",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "13.0",
+	},
+	"1FAA4": Emoji{
+		Key:        "1FAA4",
+		Value:      "🪤",
+		Descriptor: "Mouse Trap",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "13.0",
+	},
+	"1FAA5": Emoji{
+		Key:        "1FAA5",
+		Value:      "🪥",
+		Descriptor: "Toothbrush",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "13.0",
+	},
+	"1FAA6": Emoji{
+		Key:        "1FAA6",
+		Value:      "🪦",
+		Descriptor: "Headstone",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "other-object",
+		Version:    "13.0",
+	},
+	"1FAA7": Emoji{
+		Key:        "1FAA7",
+		Value:      "🪧",
+		Descriptor: "Placard",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "other-object",
+		Version:    "13.0",
+	},
+	"1FAA8": Emoji{
+		Key:        "1FAA8",
+		Value:      "🪨",
+		Descriptor: "Rock",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "13.0",
+	},
+	"1FAA9": Emoji{
+		Key:        "1FAA9",
+		Value:      "🪩",
+		Descriptor: "Mirror Ball",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "14.0",
+	},
+	"1FAAA": Emoji{
+		Key:        "1FAAA",
+		Value:      "🪪",
+		Descriptor: "Identification Card",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "other-object",
+		Version:    "14.0",
+	},
+	"1FAAB": Emoji{
+		Key:        "1FAAB",
+		Value:      "🪫",
+		Descriptor: "Low Battery",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "computer",
+		Version:    "14.0",
+	},
+	"1FAAC": Emoji{
+		Key:        "1FAAC",
+		Value:      "🪬",
+		Descriptor: "Hamsa",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "other-object",
+		Version:    "14.0",
+	},
+	"1FAAD": Emoji{
+		Key:        "1FAAD",
+		Value:      "🪭",
+		Descriptor: "Folding Hand Fan",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "15.0",
+	},
+	"1FAAE": Emoji{
+		Key:        "1FAAE",
+		Value:      "🪮",
+		Descriptor: "Hair Pick",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "15.0",
+	},
+	"1FAAF": Emoji{
+		Key:        "1FAAF",
+		Value:      "🪯",
+		Descriptor: "Khanda",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "religion",
+		Version:    "15.0",
+	},
+	"1FAB0": Emoji{
+		Key:        "1FAB0",
+		Value:      "🪰",
+		Descriptor: "Fly",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bug",
+		Version:    "13.0",
+	},
+	"1FAB1": Emoji{
+		Key:        "1FAB1",
+		Value:      "🪱",
+		Descriptor: "Worm",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bug",
+		Version:    "13.0",
+	},
+	"1FAB2": Emoji{
+		Key:        "1FAB2",
+		Value:      "🪲",
+		Descriptor: "Beetle",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bug",
+		Version:    "13.0",
+	},
+	"1FAB3": Emoji{
+		Key:        "1FAB3",
+		Value:      "🪳",
+		Descriptor: "Cockroach",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bug",
+		Version:    "13.0",
+	},
+	"1FAB4": Emoji{
+		Key:        "1FAB4",
+		Value:      "🪴",
+		Descriptor: "Potted Plant",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-other",
+		Version:    "13.0",
+	},
+	"1FAB5": Emoji{
+		Key:        "1FAB5",
+		Value:      "🪵",
+		Descriptor: "Wood",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-building",
+		Version:    "13.0",
+	},
+	"1FAB6": Emoji{
+		Key:        "1FAB6",
+		Value:      "🪶",
+		Descriptor: "Feather",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bird",
+		Version:    "13.0",
+	},
+	"1FAB7": Emoji{
+		Key:        "1FAB7",
+		Value:      "🪷",
+		Descriptor: "Lotus",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-flower",
+		Version:    "14.0",
+	},
+	"1FAB8": Emoji{
+		Key:        "1FAB8",
+		Value:      "🪸",
+		Descriptor: "Coral",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-marine",
+		Version:    "14.0",
+	},
+	"1FAB9": Emoji{
+		Key:        "1FAB9",
+		Value:      "🪹",
+		Descriptor: "Empty Nest",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-other",
+		Version:    "14.0",
+	},
+	"1FABA": Emoji{
+		Key:        "1FABA",
+		Value:      "🪺",
+		Descriptor: "Nest With Eggs",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-other",
+		Version:    "14.0",
+	},
+	"1FABB": Emoji{
+		Key:        "1FABB",
+		Value:      "🪻",
+		Descriptor: "Hyacinth",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-flower",
+		Version:    "15.0",
+	},
+	"1FABC": Emoji{
+		Key:        "1FABC",
+		Value:      "🪼",
+		Descriptor: "Jellyfish",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-marine",
+		Version:    "15.0",
+	},
+	"1FABD": Emoji{
+		Key:        "1FABD",
+		Value:      "🪽",
+		Descriptor: "Wing",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bird",
+		Version:    "15.0",
+	},
+	"1FABF": Emoji{
+		Key:        "1FABF",
+		Value:      "🪿",
+		Descriptor: "Goose",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-bird",
+		Version:    "15.0",
+	},
+	"1FAC0": Emoji{
+		Key:        "1FAC0",
+		Value:      "🫀",
+		Descriptor: "Anatomical Heart",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "13.0",
+	},
+	"1FAC1": Emoji{
+		Key:        "1FAC1",
+		Value:      "🫁",
+		Descriptor: "Lungs",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "13.0",
+	},
+	"1FAC2": Emoji{
+		Key:        "1FAC2",
+		Value:      "🫂",
+		Descriptor: "People Hugging",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-symbol",
+		Version:    "13.0",
+	},
+	"1FAC3": Emoji{
+		Key:        "1FAC3",
+		Value:      "🫃",
+		Descriptor: "Pregnant Man",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "14.0",
+	},
+	"1FAC3-1F3FB": Emoji{
+		Key:        "1FAC3-1F3FB",
+		Value:      "🫃🏻",
+		Descriptor: "Pregnant Man: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "14.0",
+	},
+	"1FAC3-1F3FC": Emoji{
+		Key:        "1FAC3-1F3FC",
+		Value:      "🫃🏼",
+		Descriptor: "Pregnant Man: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "14.0",
+	},
+	"1FAC3-1F3FD": Emoji{
+		Key:        "1FAC3-1F3FD",
+		Value:      "🫃🏽",
+		Descriptor: "Pregnant Man: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "14.0",
+	},
+	"1FAC3-1F3FE": Emoji{
+		Key:        "1FAC3-1F3FE",
+		Value:      "🫃🏾",
+		Descriptor: "Pregnant Man: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "14.0",
+	},
+	"1FAC3-1F3FF": Emoji{
+		Key:        "1FAC3-1F3FF",
+		Value:      "🫃🏿",
+		Descriptor: "Pregnant Man: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "14.0",
+	},
+	"1FAC4": Emoji{
+		Key:        "1FAC4",
+		Value:      "🫄",
+		Descriptor: "Pregnant Person",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "14.0",
+	},
+	"1FAC4-1F3FB": Emoji{
+		Key:        "1FAC4-1F3FB",
+		Value:      "🫄🏻",
+		Descriptor: "Pregnant Person: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "14.0",
+	},
+	"1FAC4-1F3FC": Emoji{
+		Key:        "1FAC4-1F3FC",
+		Value:      "🫄🏼",
+		Descriptor: "Pregnant Person: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "14.0",
+	},
+	"1FAC4-1F3FD": Emoji{
+		Key:        "1FAC4-1F3FD",
+		Value:      "🫄🏽",
+		Descriptor: "Pregnant Person: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "14.0",
+	},
+	"1FAC4-1F3FE": Emoji{
+		Key:        "1FAC4-1F3FE",
+		Value:      "🫄🏾",
+		Descriptor: "Pregnant Person: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "14.0",
+	},
+	"1FAC4-1F3FF": Emoji{
+		Key:        "1FAC4-1F3FF",
+		Value:      "🫄🏿",
+		Descriptor: "Pregnant Person: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "14.0",
+	},
+	"1FAC5": Emoji{
+		Key:        "1FAC5",
+		Value:      "🫅",
+		Descriptor: "Person With Crown",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "14.0",
+	},
+	"1FAC5-1F3FB": Emoji{
+		Key:        "1FAC5-1F3FB",
+		Value:      "🫅🏻",
+		Descriptor: "Person With Crown: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "14.0",
+	},
+	"1FAC5-1F3FC": Emoji{
+		Key:        "1FAC5-1F3FC",
+		Value:      "🫅🏼",
+		Descriptor: "Person With Crown: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "14.0",
+	},
+	"1FAC5-1F3FD": Emoji{
+		Key:        "1FAC5-1F3FD",
+		Value:      "🫅🏽",
+		Descriptor: "Person With Crown: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "14.0",
+	},
+	"1FAC5-1F3FE": Emoji{
+		Key:        "1FAC5-1F3FE",
+		Value:      "🫅🏾",
+		Descriptor: "Person With Crown: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "14.0",
+	},
+	"1FAC5-1F3FF": Emoji{
+		Key:        "1FAC5-1F3FF",
+		Value:      "🫅🏿",
+		Descriptor: "Person With Crown: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-role",
+		Version:    "14.0",
+	},
+	"1FACE": Emoji{
+		Key:        "1FACE",
+		Value:      "🫎",
+		Descriptor: "Moose",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "15.0",
+	},
+	"1FACF": Emoji{
+		Key:        "1FACF",
+		Value:      "🫏",
+		Descriptor: "Donkey",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "animal-mammal",
+		Version:    "15.0",
+	},
+	"1FAD0": Emoji{
+		Key:        "1FAD0",
+		Value:      "🫐",
+		Descriptor: "Blueberries",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-fruit",
+		Version:    "13.0",
+	},
+	"1FAD1": Emoji{
+		Key:        "1FAD1",
+		Value:      "🫑",
+		Descriptor: "Bell Pepper",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-vegetable",
+		Version:    "13.0",
+	},
+	"1FAD2": Emoji{
+		Key:        "1FAD2",
+		Value:      "🫒",
+		Descriptor: "Olive",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-fruit",
+		Version:    "13.0",
+	},
+	"1FAD3": Emoji{
+		Key:        "1FAD3",
+		Value:      "🫓",
+		Descriptor: "Flatbread",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "13.0",
+	},
+	"1FAD4": Emoji{
+		Key:        "1FAD4",
+		Value:      "🫔",
+		Descriptor: "Tamale",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "13.0",
+	},
+	"1FAD5": Emoji{
+		Key:        "1FAD5",
+		Value:      "🫕",
+		Descriptor: "Fondue",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-prepared",
+		Version:    "13.0",
+	},
+	"1FAD6": Emoji{
+		Key:        "1FAD6",
+		Value:      "🫖",
+		Descriptor: "Teapot",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "drink",
+		Version:    "13.0",
+	},
+	"1FAD7": Emoji{
+		Key:        "1FAD7",
+		Value:      "🫗",
+		Descriptor: "Pouring Liquid",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "drink",
+		Version:    "14.0",
+	},
+	"1FAD8": Emoji{
+		Key:        "1FAD8",
+		Value:      "🫘",
+		Descriptor: "Beans",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-vegetable",
+		Version:    "14.0",
+	},
+	"1FAD9": Emoji{
+		Key:        "1FAD9",
+		Value:      "🫙",
+		Descriptor: "Jar",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "dishware",
+		Version:    "14.0",
+	},
+	"1FADA": Emoji{
+		Key:        "1FADA",
+		Value:      "🫚",
+		Descriptor: "Ginger Root",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-vegetable",
+		Version:    "15.0",
+	},
+	"1FADB": Emoji{
+		Key:        "1FADB",
+		Value:      "🫛",
+		Descriptor: "Pea Pod",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "food-vegetable",
+		Version:    "15.0",
+	},
+	"1FAE0": Emoji{
+		Key:        "1FAE0",
+		Value:      "🫠",
+		Descriptor: "Melting Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-smiling",
+		Version:    "14.0",
+	},
+	"1FAE1": Emoji{
+		Key:        "1FAE1",
+		Value:      "🫡",
+		Descriptor: "Saluting Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-hand",
+		Version:    "14.0",
+	},
+	"1FAE2": Emoji{
+		Key:        "1FAE2",
+		Value:      "🫢",
+		Descriptor: "Face With Open Eyes and Hand Over Mouth",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-hand",
+		Version:    "14.0",
+	},
+	"1FAE3": Emoji{
+		Key:        "1FAE3",
+		Value:      "🫣",
+		Descriptor: "Face With Peeking Eye",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-hand",
+		Version:    "14.0",
+	},
+	"1FAE4": Emoji{
+		Key:        "1FAE4",
+		Value:      "🫤",
+		Descriptor: "Face With Diagonal Mouth",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "14.0",
+	},
+	"1FAE5": Emoji{
+		Key:        "1FAE5",
+		Value:      "🫥",
+		Descriptor: "Dotted Line Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-neutral-skeptical",
+		Version:    "14.0",
+	},
+	"1FAE6": Emoji{
+		Key:        "1FAE6",
+		Value:      "🫦",
+		Descriptor: "Biting Lip",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "body-parts",
+		Version:    "14.0",
+	},
+	"1FAE7": Emoji{
+		Key:        "1FAE7",
+		Value:      "🫧",
+		Descriptor: "Bubbles",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "household",
+		Version:    "14.0",
+	},
+	"1FAE8": Emoji{
+		Key:        "1FAE8",
+		Value:      "🫨",
+		Descriptor: "Shaking Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-neutral-skeptical",
+		Version:    "15.0",
+	},
+	"1FAF0": Emoji{
+		Key:        "1FAF0",
+		Value:      "🫰",
+		Descriptor: "Hand With Index Finger and Thumb Crossed",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "14.0",
+	},
+	"1FAF0-1F3FB": Emoji{
+		Key:        "1FAF0-1F3FB",
+		Value:      "🫰🏻",
+		Descriptor: "Hand With Index Finger and Thumb Crossed: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "14.0",
+	},
+	"1FAF0-1F3FC": Emoji{
+		Key:        "1FAF0-1F3FC",
+		Value:      "🫰🏼",
+		Descriptor: "Hand With Index Finger and Thumb Crossed: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "14.0",
+	},
+	"1FAF0-1F3FD": Emoji{
+		Key:        "1FAF0-1F3FD",
+		Value:      "🫰🏽",
+		Descriptor: "Hand With Index Finger and Thumb Crossed: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "14.0",
+	},
+	"1FAF0-1F3FE": Emoji{
+		Key:        "1FAF0-1F3FE",
+		Value:      "🫰🏾",
+		Descriptor: "Hand With Index Finger and Thumb Crossed: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "14.0",
+	},
+	"1FAF0-1F3FF": Emoji{
+		Key:        "1FAF0-1F3FF",
+		Value:      "🫰🏿",
+		Descriptor: "Hand With Index Finger and Thumb Crossed: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "14.0",
+	},
+	"1FAF1": Emoji{
+		Key:        "1FAF1",
+		Value:      "🫱",
+		Descriptor: "Rightwards Hand",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FB": Emoji{
+		Key:        "1FAF1-1F3FB",
+		Value:      "🫱🏻",
+		Descriptor: "Rightwards Hand: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FB-200D-1FAF2-1F3FC": Emoji{
+		Key:        "1FAF1-1F3FB-200D-1FAF2-1F3FC",
+		Value:      "🫱🏻‍🫲🏼",
+		Descriptor: "Handshake: Light Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FB-200D-1FAF2-1F3FD": Emoji{
+		Key:        "1FAF1-1F3FB-200D-1FAF2-1F3FD",
+		Value:      "🫱🏻‍🫲🏽",
+		Descriptor: "Handshake: Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FB-200D-1FAF2-1F3FE": Emoji{
+		Key:        "1FAF1-1F3FB-200D-1FAF2-1F3FE",
+		Value:      "🫱🏻‍🫲🏾",
+		Descriptor: "Handshake: Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FB-200D-1FAF2-1F3FF": Emoji{
+		Key:        "1FAF1-1F3FB-200D-1FAF2-1F3FF",
+		Value:      "🫱🏻‍🫲🏿",
+		Descriptor: "Handshake: Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FC": Emoji{
+		Key:        "1FAF1-1F3FC",
+		Value:      "🫱🏼",
+		Descriptor: "Rightwards Hand: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FC-200D-1FAF2-1F3FB": Emoji{
+		Key:        "1FAF1-1F3FC-200D-1FAF2-1F3FB",
+		Value:      "🫱🏼‍🫲🏻",
+		Descriptor: "Handshake: Medium-Light Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FC-200D-1FAF2-1F3FD": Emoji{
+		Key:        "1FAF1-1F3FC-200D-1FAF2-1F3FD",
+		Value:      "🫱🏼‍🫲🏽",
+		Descriptor: "Handshake: Medium-Light Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FC-200D-1FAF2-1F3FE": Emoji{
+		Key:        "1FAF1-1F3FC-200D-1FAF2-1F3FE",
+		Value:      "🫱🏼‍🫲🏾",
+		Descriptor: "Handshake: Medium-Light Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FC-200D-1FAF2-1F3FF": Emoji{
+		Key:        "1FAF1-1F3FC-200D-1FAF2-1F3FF",
+		Value:      "🫱🏼‍🫲🏿",
+		Descriptor: "Handshake: Medium-Light Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FD": Emoji{
+		Key:        "1FAF1-1F3FD",
+		Value:      "🫱🏽",
+		Descriptor: "Rightwards Hand: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FD-200D-1FAF2-1F3FB": Emoji{
+		Key:        "1FAF1-1F3FD-200D-1FAF2-1F3FB",
+		Value:      "🫱🏽‍🫲🏻",
+		Descriptor: "Handshake: Medium Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FD-200D-1FAF2-1F3FC": Emoji{
+		Key:        "1FAF1-1F3FD-200D-1FAF2-1F3FC",
+		Value:      "🫱🏽‍🫲🏼",
+		Descriptor: "Handshake: Medium Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FD-200D-1FAF2-1F3FE": Emoji{
+		Key:        "1FAF1-1F3FD-200D-1FAF2-1F3FE",
+		Value:      "🫱🏽‍🫲🏾",
+		Descriptor: "Handshake: Medium Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FD-200D-1FAF2-1F3FF": Emoji{
+		Key:        "1FAF1-1F3FD-200D-1FAF2-1F3FF",
+		Value:      "🫱🏽‍🫲🏿",
+		Descriptor: "Handshake: Medium Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FE": Emoji{
+		Key:        "1FAF1-1F3FE",
+		Value:      "🫱🏾",
+		Descriptor: "Rightwards Hand: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FE-200D-1FAF2-1F3FB": Emoji{
+		Key:        "1FAF1-1F3FE-200D-1FAF2-1F3FB",
+		Value:      "🫱🏾‍🫲🏻",
+		Descriptor: "Handshake: Medium-Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FE-200D-1FAF2-1F3FC": Emoji{
+		Key:        "1FAF1-1F3FE-200D-1FAF2-1F3FC",
+		Value:      "🫱🏾‍🫲🏼",
+		Descriptor: "Handshake: Medium-Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FE-200D-1FAF2-1F3FD": Emoji{
+		Key:        "1FAF1-1F3FE-200D-1FAF2-1F3FD",
+		Value:      "🫱🏾‍🫲🏽",
+		Descriptor: "Handshake: Medium-Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FE-200D-1FAF2-1F3FF": Emoji{
+		Key:        "1FAF1-1F3FE-200D-1FAF2-1F3FF",
+		Value:      "🫱🏾‍🫲🏿",
+		Descriptor: "Handshake: Medium-Dark Skin Tone, Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FF": Emoji{
+		Key:        "1FAF1-1F3FF",
+		Value:      "🫱🏿",
+		Descriptor: "Rightwards Hand: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FF-200D-1FAF2-1F3FB": Emoji{
+		Key:        "1FAF1-1F3FF-200D-1FAF2-1F3FB",
+		Value:      "🫱🏿‍🫲🏻",
+		Descriptor: "Handshake: Dark Skin Tone, Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FF-200D-1FAF2-1F3FC": Emoji{
+		Key:        "1FAF1-1F3FF-200D-1FAF2-1F3FC",
+		Value:      "🫱🏿‍🫲🏼",
+		Descriptor: "Handshake: Dark Skin Tone, Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FF-200D-1FAF2-1F3FD": Emoji{
+		Key:        "1FAF1-1F3FF-200D-1FAF2-1F3FD",
+		Value:      "🫱🏿‍🫲🏽",
+		Descriptor: "Handshake: Dark Skin Tone, Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF1-1F3FF-200D-1FAF2-1F3FE": Emoji{
+		Key:        "1FAF1-1F3FF-200D-1FAF2-1F3FE",
+		Value:      "🫱🏿‍🫲🏾",
+		Descriptor: "Handshake: Dark Skin Tone, Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF2": Emoji{
+		Key:        "1FAF2",
+		Value:      "🫲",
+		Descriptor: "Leftwards Hand",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF2-1F3FB": Emoji{
+		Key:        "1FAF2-1F3FB",
+		Value:      "🫲🏻",
+		Descriptor: "Leftwards Hand: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF2-1F3FC": Emoji{
+		Key:        "1FAF2-1F3FC",
+		Value:      "🫲🏼",
+		Descriptor: "Leftwards Hand: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF2-1F3FD": Emoji{
+		Key:        "1FAF2-1F3FD",
+		Value:      "🫲🏽",
+		Descriptor: "Leftwards Hand: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF2-1F3FE": Emoji{
+		Key:        "1FAF2-1F3FE",
+		Value:      "🫲🏾",
+		Descriptor: "Leftwards Hand: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF2-1F3FF": Emoji{
+		Key:        "1FAF2-1F3FF",
+		Value:      "🫲🏿",
+		Descriptor: "Leftwards Hand: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF3": Emoji{
+		Key:        "1FAF3",
+		Value:      "🫳",
+		Descriptor: "Palm Down Hand",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF3-1F3FB": Emoji{
+		Key:        "1FAF3-1F3FB",
+		Value:      "🫳🏻",
+		Descriptor: "Palm Down Hand: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF3-1F3FC": Emoji{
+		Key:        "1FAF3-1F3FC",
+		Value:      "🫳🏼",
+		Descriptor: "Palm Down Hand: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF3-1F3FD": Emoji{
+		Key:        "1FAF3-1F3FD",
+		Value:      "🫳🏽",
+		Descriptor: "Palm Down Hand: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF3-1F3FE": Emoji{
+		Key:        "1FAF3-1F3FE",
+		Value:      "🫳🏾",
+		Descriptor: "Palm Down Hand: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF3-1F3FF": Emoji{
+		Key:        "1FAF3-1F3FF",
+		Value:      "🫳🏿",
+		Descriptor: "Palm Down Hand: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF4": Emoji{
+		Key:        "1FAF4",
+		Value:      "🫴",
+		Descriptor: "Palm Up Hand",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF4-1F3FB": Emoji{
+		Key:        "1FAF4-1F3FB",
+		Value:      "🫴🏻",
+		Descriptor: "Palm Up Hand: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF4-1F3FC": Emoji{
+		Key:        "1FAF4-1F3FC",
+		Value:      "🫴🏼",
+		Descriptor: "Palm Up Hand: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF4-1F3FD": Emoji{
+		Key:        "1FAF4-1F3FD",
+		Value:      "🫴🏽",
+		Descriptor: "Palm Up Hand: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF4-1F3FE": Emoji{
+		Key:        "1FAF4-1F3FE",
+		Value:      "🫴🏾",
+		Descriptor: "Palm Up Hand: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF4-1F3FF": Emoji{
+		Key:        "1FAF4-1F3FF",
+		Value:      "🫴🏿",
+		Descriptor: "Palm Up Hand: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "14.0",
+	},
+	"1FAF5": Emoji{
+		Key:        "1FAF5",
+		Value:      "🫵",
+		Descriptor: "Index Pointing at the Viewer",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "14.0",
+	},
+	"1FAF5-1F3FB": Emoji{
+		Key:        "1FAF5-1F3FB",
+		Value:      "🫵🏻",
+		Descriptor: "Index Pointing at the Viewer: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "14.0",
+	},
+	"1FAF5-1F3FC": Emoji{
+		Key:        "1FAF5-1F3FC",
+		Value:      "🫵🏼",
+		Descriptor: "Index Pointing at the Viewer: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "14.0",
+	},
+	"1FAF5-1F3FD": Emoji{
+		Key:        "1FAF5-1F3FD",
+		Value:      "🫵🏽",
+		Descriptor: "Index Pointing at the Viewer: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "14.0",
+	},
+	"1FAF5-1F3FE": Emoji{
+		Key:        "1FAF5-1F3FE",
+		Value:      "🫵🏾",
+		Descriptor: "Index Pointing at the Viewer: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "14.0",
+	},
+	"1FAF5-1F3FF": Emoji{
+		Key:        "1FAF5-1F3FF",
+		Value:      "🫵🏿",
+		Descriptor: "Index Pointing at the Viewer: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "14.0",
+	},
+	"1FAF6": Emoji{
+		Key:        "1FAF6",
+		Value:      "🫶",
+		Descriptor: "Heart Hands",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF6-1F3FB": Emoji{
+		Key:        "1FAF6-1F3FB",
+		Value:      "🫶🏻",
+		Descriptor: "Heart Hands: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF6-1F3FC": Emoji{
+		Key:        "1FAF6-1F3FC",
+		Value:      "🫶🏼",
+		Descriptor: "Heart Hands: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF6-1F3FD": Emoji{
+		Key:        "1FAF6-1F3FD",
+		Value:      "🫶🏽",
+		Descriptor: "Heart Hands: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF6-1F3FE": Emoji{
+		Key:        "1FAF6-1F3FE",
+		Value:      "🫶🏾",
+		Descriptor: "Heart Hands: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF6-1F3FF": Emoji{
+		Key:        "1FAF6-1F3FF",
+		Value:      "🫶🏿",
+		Descriptor: "Heart Hands: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hands",
+		Version:    "14.0",
+	},
+	"1FAF7": Emoji{
+		Key:        "1FAF7",
+		Value:      "🫷",
+		Descriptor: "Leftwards Pushing Hand",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "15.0",
+	},
+	"1FAF7-1F3FB": Emoji{
+		Key:        "1FAF7-1F3FB",
+		Value:      "🫷🏻",
+		Descriptor: "Leftwards Pushing Hand: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "15.0",
+	},
+	"1FAF7-1F3FC": Emoji{
+		Key:        "1FAF7-1F3FC",
+		Value:      "🫷🏼",
+		Descriptor: "Leftwards Pushing Hand: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "15.0",
+	},
+	"1FAF7-1F3FD": Emoji{
+		Key:        "1FAF7-1F3FD",
+		Value:      "🫷🏽",
+		Descriptor: "Leftwards Pushing Hand: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "15.0",
+	},
+	"1FAF7-1F3FE": Emoji{
+		Key:        "1FAF7-1F3FE",
+		Value:      "🫷🏾",
+		Descriptor: "Leftwards Pushing Hand: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "15.0",
+	},
+	"1FAF7-1F3FF": Emoji{
+		Key:        "1FAF7-1F3FF",
+		Value:      "🫷🏿",
+		Descriptor: "Leftwards Pushing Hand: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "15.0",
+	},
+	"1FAF8": Emoji{
+		Key:        "1FAF8",
+		Value:      "🫸",
+		Descriptor: "Rightwards Pushing Hand",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "15.0",
+	},
+	"1FAF8-1F3FB": Emoji{
+		Key:        "1FAF8-1F3FB",
+		Value:      "🫸🏻",
+		Descriptor: "Rightwards Pushing Hand: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "15.0",
+	},
+	"1FAF8-1F3FC": Emoji{
+		Key:        "1FAF8-1F3FC",
+		Value:      "🫸🏼",
+		Descriptor: "Rightwards Pushing Hand: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "15.0",
+	},
+	"1FAF8-1F3FD": Emoji{
+		Key:        "1FAF8-1F3FD",
+		Value:      "🫸🏽",
+		Descriptor: "Rightwards Pushing Hand: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "15.0",
+	},
+	"1FAF8-1F3FE": Emoji{
+		Key:        "1FAF8-1F3FE",
+		Value:      "🫸🏾",
+		Descriptor: "Rightwards Pushing Hand: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "15.0",
+	},
+	"1FAF8-1F3FF": Emoji{
+		Key:        "1FAF8-1F3FF",
+		Value:      "🫸🏿",
+		Descriptor: "Rightwards Pushing Hand: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "15.0",
 	},
 	"203C-FE0F": Emoji{
 		Key:        "203C-FE0F",
 		Value:      "‼️",
 		Descriptor: "Double Exclamation Mark",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "punctuation",
+		Version:    "0.6",
 	},
 	"2049-FE0F": Emoji{
 		Key:        "2049-FE0F",
 		Value:      "⁉️",
 		Descriptor: "Exclamation Question Mark",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "punctuation",
+		Version:    "0.6",
 	},
 	"2122-FE0F": Emoji{
 		Key:        "2122-FE0F",
 		Value:      "™️",
 		Descriptor: "Trade Mark",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "other-symbol",
+		Version:    "0.6",
 	},
 	"2139-FE0F": Emoji{
 		Key:        "2139-FE0F",
 		Value:      "ℹ️",
 		Descriptor: "Information",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"2194-FE0F": Emoji{
 		Key:        "2194-FE0F",
 		Value:      "↔️",
 		Descriptor: "Left-Right Arrow",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "arrow",
+		Version:    "0.6",
 	},
 	"2195-FE0F": Emoji{
 		Key:        "2195-FE0F",
 		Value:      "↕️",
 		Descriptor: "Up-Down Arrow",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "arrow",
+		Version:    "0.6",
 	},
 	"2196-FE0F": Emoji{
 		Key:        "2196-FE0F",
 		Value:      "↖️",
 		Descriptor: "Up-Left Arrow",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "arrow",
+		Version:    "0.6",
 	},
 	"2197-FE0F": Emoji{
 		Key:        "2197-FE0F",
 		Value:      "↗️",
 		Descriptor: "Up-Right Arrow",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "arrow",
+		Version:    "0.6",
 	},
 	"2198-FE0F": Emoji{
 		Key:        "2198-FE0F",
 		Value:      "↘️",
 		Descriptor: "Down-Right Arrow",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "arrow",
+		Version:    "0.6",
 	},
 	"2199-FE0F": Emoji{
 		Key:        "2199-FE0F",
 		Value:      "↙️",
 		Descriptor: "Down-Left Arrow",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "arrow",
+		Version:    "0.6",
 	},
 	"21A9-FE0F": Emoji{
 		Key:        "21A9-FE0F",
 		Value:      "↩️",
 		Descriptor: "Right Arrow Curving Left",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "arrow",
+		Version:    "0.6",
 	},
 	"21AA-FE0F": Emoji{
 		Key:        "21AA-FE0F",
 		Value:      "↪️",
 		Descriptor: "Left Arrow Curving Right",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "arrow",
+		Version:    "0.6",
 	},
 	"23-FE0F-20E3": Emoji{
 		Key:        "23-FE0F-20E3",
 		Value:      "#️⃣",
-		Descriptor: "Keycap Number Sign",
+		Descriptor: "Keycap: #",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "keycap",
+		Version:    "0.6",
 	},
 	"231A": Emoji{
 		Key:        "231A",
 		Value:      "⌚",
 		Descriptor: "Watch",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.6",
 	},
 	"231B": Emoji{
 		Key:        "231B",
 		Value:      "⌛",
 		Descriptor: "Hourglass Done",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.6",
 	},
 	"2328-FE0F": Emoji{
 		Key:        "2328-FE0F",
 		Value:      "⌨️",
 		Descriptor: "Keyboard",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "computer",
+		Version:    "1.0",
 	},
 	"23CF-FE0F": Emoji{
 		Key:        "23CF-FE0F",
 		Value:      "⏏️",
 		Descriptor: "Eject Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "1.0",
 	},
 	"23E9": Emoji{
 		Key:        "23E9",
 		Value:      "⏩",
 		Descriptor: "Fast-Forward Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "0.6",
 	},
 	"23EA": Emoji{
 		Key:        "23EA",
 		Value:      "⏪",
 		Descriptor: "Fast Reverse Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "0.6",
 	},
 	"23EB": Emoji{
 		Key:        "23EB",
 		Value:      "⏫",
 		Descriptor: "Fast Up Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "0.6",
 	},
 	"23EC": Emoji{
 		Key:        "23EC",
 		Value:      "⏬",
 		Descriptor: "Fast Down Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "0.6",
 	},
 	"23ED-FE0F": Emoji{
 		Key:        "23ED-FE0F",
 		Value:      "⏭️",
 		Descriptor: "Next Track Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "0.7",
 	},
 	"23EE-FE0F": Emoji{
 		Key:        "23EE-FE0F",
 		Value:      "⏮️",
 		Descriptor: "Last Track Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "0.7",
 	},
 	"23EF-FE0F": Emoji{
 		Key:        "23EF-FE0F",
 		Value:      "⏯️",
 		Descriptor: "Play or Pause Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "1.0",
 	},
 	"23F0": Emoji{
 		Key:        "23F0",
 		Value:      "⏰",
 		Descriptor: "Alarm Clock",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.6",
 	},
 	"23F1-FE0F": Emoji{
 		Key:        "23F1-FE0F",
 		Value:      "⏱️",
 		Descriptor: "Stopwatch",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "1.0",
 	},
 	"23F2-FE0F": Emoji{
 		Key:        "23F2-FE0F",
 		Value:      "⏲️",
 		Descriptor: "Timer Clock",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "1.0",
 	},
 	"23F3": Emoji{
 		Key:        "23F3",
 		Value:      "⏳",
 		Descriptor: "Hourglass Not Done",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "time",
+		Version:    "0.6",
 	},
 	"23F8-FE0F": Emoji{
 		Key:        "23F8-FE0F",
 		Value:      "⏸️",
 		Descriptor: "Pause Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "0.7",
 	},
 	"23F9-FE0F": Emoji{
 		Key:        "23F9-FE0F",
 		Value:      "⏹️",
 		Descriptor: "Stop Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "0.7",
 	},
 	"23FA-FE0F": Emoji{
 		Key:        "23FA-FE0F",
 		Value:      "⏺️",
 		Descriptor: "Record Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "0.7",
 	},
 	"24C2-FE0F": Emoji{
 		Key:        "24C2-FE0F",
 		Value:      "Ⓜ️",
 		Descriptor: "Circled M",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"25AA-FE0F": Emoji{
 		Key:        "25AA-FE0F",
 		Value:      "▪️",
 		Descriptor: "Black Small Square",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "0.6",
 	},
 	"25AB-FE0F": Emoji{
 		Key:        "25AB-FE0F",
 		Value:      "▫️",
 		Descriptor: "White Small Square",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "0.6",
 	},
 	"25B6-FE0F": Emoji{
 		Key:        "25B6-FE0F",
 		Value:      "▶️",
 		Descriptor: "Play Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "0.6",
 	},
 	"25C0-FE0F": Emoji{
 		Key:        "25C0-FE0F",
 		Value:      "◀️",
 		Descriptor: "Reverse Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "av-symbol",
+		Version:    "0.6",
 	},
 	"25FB-FE0F": Emoji{
 		Key:        "25FB-FE0F",
 		Value:      "◻️",
 		Descriptor: "White Medium Square",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "0.6",
 	},
 	"25FC-FE0F": Emoji{
 		Key:        "25FC-FE0F",
 		Value:      "◼️",
 		Descriptor: "Black Medium Square",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "0.6",
 	},
 	"25FD": Emoji{
 		Key:        "25FD",
 		Value:      "◽",
 		Descriptor: "White Medium-Small Square",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "0.6",
 	},
 	"25FE": Emoji{
 		Key:        "25FE",
 		Value:      "◾",
 		Descriptor: "Black Medium-Small Square",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "0.6",
 	},
 	"2600-FE0F": Emoji{
 		Key:        "2600-FE0F",
 		Value:      "☀️",
 		Descriptor: "Sun",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.6",
 	},
 	"2601-FE0F": Emoji{
 		Key:        "2601-FE0F",
 		Value:      "☁️",
 		Descriptor: "Cloud",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.6",
 	},
 	"2602-FE0F": Emoji{
 		Key:        "2602-FE0F",
 		Value:      "☂️",
 		Descriptor: "Umbrella",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.7",
 	},
 	"2603-FE0F": Emoji{
 		Key:        "2603-FE0F",
 		Value:      "☃️",
 		Descriptor: "Snowman",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.7",
 	},
 	"2604-FE0F": Emoji{
 		Key:        "2604-FE0F",
 		Value:      "☄️",
 		Descriptor: "Comet",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "1.0",
 	},
 	"260E-FE0F": Emoji{
 		Key:        "260E-FE0F",
 		Value:      "☎️",
 		Descriptor: "Telephone",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "phone",
+		Version:    "0.6",
 	},
 	"2611-FE0F": Emoji{
 		Key:        "2611-FE0F",
 		Value:      "☑️",
-		Descriptor: "Ballot Box With Check",
+		Descriptor: "Check Box With Check",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "other-symbol",
+		Version:    "0.6",
 	},
 	"2614": Emoji{
 		Key:        "2614",
 		Value:      "☔",
 		Descriptor: "Umbrella With Rain Drops",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.6",
 	},
 	"2615": Emoji{
 		Key:        "2615",
 		Value:      "☕",
 		Descriptor: "Hot Beverage",
+		Status:     "fully-qualified",
+		Group:      "Food & Drink",
+		Subgroup:   "drink",
+		Version:    "0.6",
 	},
 	"2618-FE0F": Emoji{
 		Key:        "2618-FE0F",
 		Value:      "☘️",
 		Descriptor: "Shamrock",
+		Status:     "fully-qualified",
+		Group:      "Animals & Nature",
+		Subgroup:   "plant-other",
+		Version:    "1.0",
 	},
 	"261D-1F3FB": Emoji{
 		Key:        "261D-1F3FB",
 		Value:      "☝🏻",
 		Descriptor: "Index Pointing Up: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"261D-1F3FC": Emoji{
 		Key:        "261D-1F3FC",
 		Value:      "☝🏼",
 		Descriptor: "Index Pointing Up: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"261D-1F3FD": Emoji{
 		Key:        "261D-1F3FD",
 		Value:      "☝🏽",
 		Descriptor: "Index Pointing Up: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"261D-1F3FE": Emoji{
 		Key:        "261D-1F3FE",
 		Value:      "☝🏾",
 		Descriptor: "Index Pointing Up: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"261D-1F3FF": Emoji{
 		Key:        "261D-1F3FF",
 		Value:      "☝🏿",
 		Descriptor: "Index Pointing Up: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "1.0",
 	},
 	"261D-FE0F": Emoji{
 		Key:        "261D-FE0F",
 		Value:      "☝️",
 		Descriptor: "Index Pointing Up",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-single-finger",
+		Version:    "0.6",
 	},
 	"2620-FE0F": Emoji{
 		Key:        "2620-FE0F",
 		Value:      "☠️",
 		Descriptor: "Skull and Crossbones",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-negative",
+		Version:    "1.0",
 	},
 	"2622-FE0F": Emoji{
 		Key:        "2622-FE0F",
 		Value:      "☢️",
 		Descriptor: "Radioactive",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "warning",
+		Version:    "1.0",
 	},
 	"2623-FE0F": Emoji{
 		Key:        "2623-FE0F",
 		Value:      "☣️",
 		Descriptor: "Biohazard",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "warning",
+		Version:    "1.0",
 	},
 	"2626-FE0F": Emoji{
 		Key:        "2626-FE0F",
 		Value:      "☦️",
 		Descriptor: "Orthodox Cross",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "religion",
+		Version:    "1.0",
 	},
 	"262A-FE0F": Emoji{
 		Key:        "262A-FE0F",
 		Value:      "☪️",
 		Descriptor: "Star and Crescent",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "religion",
+		Version:    "0.7",
 	},
 	"262E-FE0F": Emoji{
 		Key:        "262E-FE0F",
 		Value:      "☮️",
 		Descriptor: "Peace Symbol",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "religion",
+		Version:    "1.0",
 	},
 	"262F-FE0F": Emoji{
 		Key:        "262F-FE0F",
 		Value:      "☯️",
 		Descriptor: "Yin Yang",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "religion",
+		Version:    "0.7",
 	},
 	"2638-FE0F": Emoji{
 		Key:        "2638-FE0F",
 		Value:      "☸️",
 		Descriptor: "Wheel of Dharma",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "religion",
+		Version:    "0.7",
 	},
 	"2639-FE0F": Emoji{
 		Key:        "2639-FE0F",
 		Value:      "☹️",
 		Descriptor: "Frowning Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-concerned",
+		Version:    "0.7",
 	},
 	"263A-FE0F": Emoji{
 		Key:        "263A-FE0F",
 		Value:      "☺️",
 		Descriptor: "Smiling Face",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "face-affection",
+		Version:    "0.6",
+	},
+	"2640-FE0F": Emoji{
+		Key:        "2640-FE0F",
+		Value:      "♀️",
+		Descriptor: "Female Sign",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "gender",
+		Version:    "4.0",
+	},
+	"2642-FE0F": Emoji{
+		Key:        "2642-FE0F",
+		Value:      "♂️",
+		Descriptor: "Male Sign",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "gender",
+		Version:    "4.0",
 	},
 	"2648": Emoji{
 		Key:        "2648",
 		Value:      "♈",
 		Descriptor: "Aries",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "zodiac",
+		Version:    "0.6",
 	},
 	"2649": Emoji{
 		Key:        "2649",
 		Value:      "♉",
 		Descriptor: "Taurus",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "zodiac",
+		Version:    "0.6",
 	},
 	"264A": Emoji{
 		Key:        "264A",
 		Value:      "♊",
 		Descriptor: "Gemini",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "zodiac",
+		Version:    "0.6",
 	},
 	"264B": Emoji{
 		Key:        "264B",
 		Value:      "♋",
 		Descriptor: "Cancer",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "zodiac",
+		Version:    "0.6",
 	},
 	"264C": Emoji{
 		Key:        "264C",
 		Value:      "♌",
 		Descriptor: "Leo",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "zodiac",
+		Version:    "0.6",
 	},
 	"264D": Emoji{
 		Key:        "264D",
 		Value:      "♍",
 		Descriptor: "Virgo",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "zodiac",
+		Version:    "0.6",
 	},
 	"264E": Emoji{
 		Key:        "264E",
 		Value:      "♎",
 		Descriptor: "Libra",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "zodiac",
+		Version:    "0.6",
 	},
 	"264F": Emoji{
 		Key:        "264F",
 		Value:      "♏",
 		Descriptor: "Scorpio",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "zodiac",
+		Version:    "0.6",
 	},
 	"2650": Emoji{
 		Key:        "2650",
 		Value:      "♐",
 		Descriptor: "Sagittarius",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "zodiac",
+		Version:    "0.6",
 	},
 	"2651": Emoji{
 		Key:        "2651",
 		Value:      "♑",
 		Descriptor: "Capricorn",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "zodiac",
+		Version:    "0.6",
 	},
 	"2652": Emoji{
 		Key:        "2652",
 		Value:      "♒",
 		Descriptor: "Aquarius",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "zodiac",
+		Version:    "0.6",
 	},
 	"2653": Emoji{
 		Key:        "2653",
 		Value:      "♓",
 		Descriptor: "Pisces",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "zodiac",
+		Version:    "0.6",
 	},
 	"265F-FE0F": Emoji{
 		Key:        "265F-FE0F",
 		Value:      "♟️",
 		Descriptor: "Chess Pawn",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "11.0",
 	},
 	"2660-FE0F": Emoji{
 		Key:        "2660-FE0F",
 		Value:      "♠️",
 		Descriptor: "Spade Suit",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "0.6",
 	},
 	"2663-FE0F": Emoji{
 		Key:        "2663-FE0F",
 		Value:      "♣️",
 		Descriptor: "Club Suit",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "0.6",
 	},
 	"2665-FE0F": Emoji{
 		Key:        "2665-FE0F",
 		Value:      "♥️",
 		Descriptor: "Heart Suit",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "0.6",
 	},
 	"2666-FE0F": Emoji{
 		Key:        "2666-FE0F",
 		Value:      "♦️",
 		Descriptor: "Diamond Suit",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "game",
+		Version:    "0.6",
 	},
 	"2668-FE0F": Emoji{
 		Key:        "2668-FE0F",
 		Value:      "♨️",
 		Descriptor: "Hot Springs",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-other",
+		Version:    "0.6",
 	},
 	"267B-FE0F": Emoji{
 		Key:        "267B-FE0F",
 		Value:      "♻️",
 		Descriptor: "Recycling Symbol",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "other-symbol",
+		Version:    "0.6",
 	},
 	"267E-FE0F": Emoji{
 		Key:        "267E-FE0F",
 		Value:      "♾️",
 		Descriptor: "Infinity",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "math",
+		Version:    "11.0",
 	},
 	"267F": Emoji{
 		Key:        "267F",
 		Value:      "♿",
 		Descriptor: "Wheelchair Symbol",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "transport-sign",
+		Version:    "0.6",
 	},
 	"2692-FE0F": Emoji{
 		Key:        "2692-FE0F",
 		Value:      "⚒️",
 		Descriptor: "Hammer and Pick",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "1.0",
 	},
 	"2693": Emoji{
 		Key:        "2693",
 		Value:      "⚓",
 		Descriptor: "Anchor",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-water",
+		Version:    "0.6",
 	},
 	"2694-FE0F": Emoji{
 		Key:        "2694-FE0F",
 		Value:      "⚔️",
 		Descriptor: "Crossed Swords",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "1.0",
+	},
+	"2695-FE0F": Emoji{
+		Key:        "2695-FE0F",
+		Value:      "⚕️",
+		Descriptor: "Medical Symbol",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "other-symbol",
+		Version:    "4.0",
 	},
 	"2696-FE0F": Emoji{
 		Key:        "2696-FE0F",
 		Value:      "⚖️",
 		Descriptor: "Balance Scale",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "1.0",
 	},
 	"2697-FE0F": Emoji{
 		Key:        "2697-FE0F",
 		Value:      "⚗️",
 		Descriptor: "Alembic",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "science",
+		Version:    "1.0",
 	},
 	"2699-FE0F": Emoji{
 		Key:        "2699-FE0F",
 		Value:      "⚙️",
 		Descriptor: "Gear",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "1.0",
 	},
 	"269B-FE0F": Emoji{
 		Key:        "269B-FE0F",
 		Value:      "⚛️",
 		Descriptor: "Atom Symbol",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "religion",
+		Version:    "1.0",
 	},
 	"269C-FE0F": Emoji{
 		Key:        "269C-FE0F",
 		Value:      "⚜️",
-		Descriptor: "Fleur-de-lis",
+		Descriptor: "Fleur-De-Lis",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "other-symbol",
+		Version:    "1.0",
 	},
 	"26A0-FE0F": Emoji{
 		Key:        "26A0-FE0F",
 		Value:      "⚠️",
 		Descriptor: "Warning",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "warning",
+		Version:    "0.6",
 	},
 	"26A1": Emoji{
 		Key:        "26A1",
 		Value:      "⚡",
 		Descriptor: "High Voltage",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.6",
+	},
+	"26A7-FE0F": Emoji{
+		Key:        "26A7-FE0F",
+		Value:      "⚧️",
+		Descriptor: "Transgender Symbol",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "gender",
+		Version:    "13.0",
 	},
 	"26AA": Emoji{
 		Key:        "26AA",
 		Value:      "⚪",
 		Descriptor: "White Circle",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "0.6",
 	},
 	"26AB": Emoji{
 		Key:        "26AB",
 		Value:      "⚫",
 		Descriptor: "Black Circle",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "0.6",
 	},
 	"26B0-FE0F": Emoji{
 		Key:        "26B0-FE0F",
 		Value:      "⚰️",
 		Descriptor: "Coffin",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "other-object",
+		Version:    "1.0",
 	},
 	"26B1-FE0F": Emoji{
 		Key:        "26B1-FE0F",
 		Value:      "⚱️",
 		Descriptor: "Funeral Urn",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "other-object",
+		Version:    "1.0",
 	},
 	"26BD": Emoji{
 		Key:        "26BD",
 		Value:      "⚽",
 		Descriptor: "Soccer Ball",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "0.6",
 	},
 	"26BE": Emoji{
 		Key:        "26BE",
 		Value:      "⚾",
 		Descriptor: "Baseball",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "0.6",
 	},
 	"26C4": Emoji{
 		Key:        "26C4",
 		Value:      "⛄",
 		Descriptor: "Snowman Without Snow",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.6",
 	},
 	"26C5": Emoji{
 		Key:        "26C5",
 		Value:      "⛅",
 		Descriptor: "Sun Behind Cloud",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.6",
 	},
 	"26C8-FE0F": Emoji{
 		Key:        "26C8-FE0F",
 		Value:      "⛈️",
 		Descriptor: "Cloud With Lightning and Rain",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.7",
 	},
 	"26CE": Emoji{
 		Key:        "26CE",
 		Value:      "⛎",
 		Descriptor: "Ophiuchus",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "zodiac",
+		Version:    "0.6",
 	},
 	"26CF-FE0F": Emoji{
 		Key:        "26CF-FE0F",
 		Value:      "⛏️",
 		Descriptor: "Pick",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "0.7",
 	},
 	"26D1-FE0F": Emoji{
 		Key:        "26D1-FE0F",
 		Value:      "⛑️",
 		Descriptor: "Rescue Worker’s Helmet",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "clothing",
+		Version:    "0.7",
 	},
 	"26D3-FE0F": Emoji{
 		Key:        "26D3-FE0F",
 		Value:      "⛓️",
 		Descriptor: "Chains",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "0.7",
+	},
+	"26D3-FE0F-200D-1F4A5": Emoji{
+		Key:        "26D3-FE0F-200D-1F4A5",
+		Value:      "⛓️‍💥",
+		Descriptor: "Broken Chain",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "tool",
+		Version:    "15.1",
 	},
 	"26D4": Emoji{
 		Key:        "26D4",
 		Value:      "⛔",
 		Descriptor: "No Entry",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "warning",
+		Version:    "0.6",
 	},
 	"26E9-FE0F": Emoji{
 		Key:        "26E9-FE0F",
 		Value:      "⛩️",
 		Descriptor: "Shinto Shrine",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-religious",
+		Version:    "0.7",
 	},
 	"26EA": Emoji{
 		Key:        "26EA",
 		Value:      "⛪",
 		Descriptor: "Church",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-religious",
+		Version:    "0.6",
 	},
 	"26F0-FE0F": Emoji{
 		Key:        "26F0-FE0F",
 		Value:      "⛰️",
 		Descriptor: "Mountain",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-geographic",
+		Version:    "0.7",
 	},
 	"26F1-FE0F": Emoji{
 		Key:        "26F1-FE0F",
 		Value:      "⛱️",
 		Descriptor: "Umbrella on Ground",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.7",
 	},
 	"26F2": Emoji{
 		Key:        "26F2",
 		Value:      "⛲",
 		Descriptor: "Fountain",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-other",
+		Version:    "0.6",
 	},
 	"26F3": Emoji{
 		Key:        "26F3",
 		Value:      "⛳",
 		Descriptor: "Flag in Hole",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "0.6",
 	},
 	"26F4-FE0F": Emoji{
 		Key:        "26F4-FE0F",
 		Value:      "⛴️",
 		Descriptor: "Ferry",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-water",
+		Version:    "0.7",
 	},
 	"26F5": Emoji{
 		Key:        "26F5",
 		Value:      "⛵",
 		Descriptor: "Sailboat",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-water",
+		Version:    "0.6",
 	},
 	"26F7-FE0F": Emoji{
 		Key:        "26F7-FE0F",
 		Value:      "⛷️",
 		Descriptor: "Skier",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "0.7",
 	},
 	"26F8-FE0F": Emoji{
 		Key:        "26F8-FE0F",
 		Value:      "⛸️",
 		Descriptor: "Ice Skate",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "sport",
+		Version:    "0.7",
 	},
 	"26F9-1F3FB": Emoji{
 		Key:        "26F9-1F3FB",
 		Value:      "⛹🏻",
 		Descriptor: "Person Bouncing Ball: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "2.0",
 	},
 	"26F9-1F3FB-200D-2640-FE0F": Emoji{
 		Key:        "26F9-1F3FB-200D-2640-FE0F",
 		Value:      "⛹🏻‍♀️",
 		Descriptor: "Woman Bouncing Ball: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"26F9-1F3FB-200D-2642-FE0F": Emoji{
 		Key:        "26F9-1F3FB-200D-2642-FE0F",
 		Value:      "⛹🏻‍♂️",
 		Descriptor: "Man Bouncing Ball: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"26F9-1F3FC": Emoji{
 		Key:        "26F9-1F3FC",
 		Value:      "⛹🏼",
 		Descriptor: "Person Bouncing Ball: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "2.0",
 	},
 	"26F9-1F3FC-200D-2640-FE0F": Emoji{
 		Key:        "26F9-1F3FC-200D-2640-FE0F",
 		Value:      "⛹🏼‍♀️",
 		Descriptor: "Woman Bouncing Ball: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"26F9-1F3FC-200D-2642-FE0F": Emoji{
 		Key:        "26F9-1F3FC-200D-2642-FE0F",
 		Value:      "⛹🏼‍♂️",
 		Descriptor: "Man Bouncing Ball: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"26F9-1F3FD": Emoji{
 		Key:        "26F9-1F3FD",
 		Value:      "⛹🏽",
 		Descriptor: "Person Bouncing Ball: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "2.0",
 	},
 	"26F9-1F3FD-200D-2640-FE0F": Emoji{
 		Key:        "26F9-1F3FD-200D-2640-FE0F",
 		Value:      "⛹🏽‍♀️",
 		Descriptor: "Woman Bouncing Ball: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"26F9-1F3FD-200D-2642-FE0F": Emoji{
 		Key:        "26F9-1F3FD-200D-2642-FE0F",
 		Value:      "⛹🏽‍♂️",
 		Descriptor: "Man Bouncing Ball: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"26F9-1F3FE": Emoji{
 		Key:        "26F9-1F3FE",
 		Value:      "⛹🏾",
 		Descriptor: "Person Bouncing Ball: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "2.0",
 	},
 	"26F9-1F3FE-200D-2640-FE0F": Emoji{
 		Key:        "26F9-1F3FE-200D-2640-FE0F",
 		Value:      "⛹🏾‍♀️",
 		Descriptor: "Woman Bouncing Ball: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"26F9-1F3FE-200D-2642-FE0F": Emoji{
 		Key:        "26F9-1F3FE-200D-2642-FE0F",
 		Value:      "⛹🏾‍♂️",
 		Descriptor: "Man Bouncing Ball: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"26F9-1F3FF": Emoji{
 		Key:        "26F9-1F3FF",
 		Value:      "⛹🏿",
 		Descriptor: "Person Bouncing Ball: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "2.0",
 	},
 	"26F9-1F3FF-200D-2640-FE0F": Emoji{
 		Key:        "26F9-1F3FF-200D-2640-FE0F",
 		Value:      "⛹🏿‍♀️",
 		Descriptor: "Woman Bouncing Ball: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"26F9-1F3FF-200D-2642-FE0F": Emoji{
 		Key:        "26F9-1F3FF-200D-2642-FE0F",
 		Value:      "⛹🏿‍♂️",
 		Descriptor: "Man Bouncing Ball: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"26F9-FE0F": Emoji{
 		Key:        "26F9-FE0F",
 		Value:      "⛹️",
 		Descriptor: "Person Bouncing Ball",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "0.7",
 	},
 	"26F9-FE0F-200D-2640-FE0F": Emoji{
 		Key:        "26F9-FE0F-200D-2640-FE0F",
 		Value:      "⛹️‍♀️",
 		Descriptor: "Woman Bouncing Ball",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"26F9-FE0F-200D-2642-FE0F": Emoji{
 		Key:        "26F9-FE0F-200D-2642-FE0F",
 		Value:      "⛹️‍♂️",
 		Descriptor: "Man Bouncing Ball",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "person-sport",
+		Version:    "4.0",
 	},
 	"26FA": Emoji{
 		Key:        "26FA",
 		Value:      "⛺",
 		Descriptor: "Tent",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "place-other",
+		Version:    "0.6",
 	},
 	"26FD": Emoji{
 		Key:        "26FD",
 		Value:      "⛽",
 		Descriptor: "Fuel Pump",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-ground",
+		Version:    "0.6",
 	},
 	"2702-FE0F": Emoji{
 		Key:        "2702-FE0F",
 		Value:      "✂️",
 		Descriptor: "Scissors",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "office",
+		Version:    "0.6",
 	},
 	"2705": Emoji{
 		Key:        "2705",
 		Value:      "✅",
-		Descriptor: "White Heavy Check Mark",
+		Descriptor: "Check Mark Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "other-symbol",
+		Version:    "0.6",
 	},
 	"2708-FE0F": Emoji{
 		Key:        "2708-FE0F",
 		Value:      "✈️",
 		Descriptor: "Airplane",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "transport-air",
+		Version:    "0.6",
 	},
 	"2709-FE0F": Emoji{
 		Key:        "2709-FE0F",
 		Value:      "✉️",
 		Descriptor: "Envelope",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "mail",
+		Version:    "0.6",
 	},
 	"270A": Emoji{
 		Key:        "270A",
 		Value:      "✊",
 		Descriptor: "Raised Fist",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "0.6",
 	},
 	"270A-1F3FB": Emoji{
 		Key:        "270A-1F3FB",
 		Value:      "✊🏻",
 		Descriptor: "Raised Fist: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "1.0",
 	},
 	"270A-1F3FC": Emoji{
 		Key:        "270A-1F3FC",
 		Value:      "✊🏼",
 		Descriptor: "Raised Fist: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "1.0",
 	},
 	"270A-1F3FD": Emoji{
 		Key:        "270A-1F3FD",
 		Value:      "✊🏽",
 		Descriptor: "Raised Fist: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "1.0",
 	},
 	"270A-1F3FE": Emoji{
 		Key:        "270A-1F3FE",
 		Value:      "✊🏾",
 		Descriptor: "Raised Fist: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "1.0",
 	},
 	"270A-1F3FF": Emoji{
 		Key:        "270A-1F3FF",
 		Value:      "✊🏿",
 		Descriptor: "Raised Fist: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-closed",
+		Version:    "1.0",
 	},
 	"270B": Emoji{
 		Key:        "270B",
 		Value:      "✋",
 		Descriptor: "Raised Hand",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "0.6",
 	},
 	"270B-1F3FB": Emoji{
 		Key:        "270B-1F3FB",
 		Value:      "✋🏻",
 		Descriptor: "Raised Hand: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "1.0",
 	},
 	"270B-1F3FC": Emoji{
 		Key:        "270B-1F3FC",
 		Value:      "✋🏼",
 		Descriptor: "Raised Hand: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "1.0",
 	},
 	"270B-1F3FD": Emoji{
 		Key:        "270B-1F3FD",
 		Value:      "✋🏽",
 		Descriptor: "Raised Hand: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "1.0",
 	},
 	"270B-1F3FE": Emoji{
 		Key:        "270B-1F3FE",
 		Value:      "✋🏾",
 		Descriptor: "Raised Hand: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "1.0",
 	},
 	"270B-1F3FF": Emoji{
 		Key:        "270B-1F3FF",
 		Value:      "✋🏿",
 		Descriptor: "Raised Hand: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-open",
+		Version:    "1.0",
 	},
 	"270C-1F3FB": Emoji{
 		Key:        "270C-1F3FB",
 		Value:      "✌🏻",
 		Descriptor: "Victory Hand: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "1.0",
 	},
 	"270C-1F3FC": Emoji{
 		Key:        "270C-1F3FC",
 		Value:      "✌🏼",
 		Descriptor: "Victory Hand: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "1.0",
 	},
 	"270C-1F3FD": Emoji{
 		Key:        "270C-1F3FD",
 		Value:      "✌🏽",
 		Descriptor: "Victory Hand: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "1.0",
 	},
 	"270C-1F3FE": Emoji{
 		Key:        "270C-1F3FE",
 		Value:      "✌🏾",
 		Descriptor: "Victory Hand: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "1.0",
 	},
 	"270C-1F3FF": Emoji{
 		Key:        "270C-1F3FF",
 		Value:      "✌🏿",
 		Descriptor: "Victory Hand: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "1.0",
 	},
 	"270C-FE0F": Emoji{
 		Key:        "270C-FE0F",
 		Value:      "✌️",
 		Descriptor: "Victory Hand",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-fingers-partial",
+		Version:    "0.6",
 	},
 	"270D-1F3FB": Emoji{
 		Key:        "270D-1F3FB",
 		Value:      "✍🏻",
 		Descriptor: "Writing Hand: Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-prop",
+		Version:    "1.0",
 	},
 	"270D-1F3FC": Emoji{
 		Key:        "270D-1F3FC",
 		Value:      "✍🏼",
 		Descriptor: "Writing Hand: Medium-Light Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-prop",
+		Version:    "1.0",
 	},
 	"270D-1F3FD": Emoji{
 		Key:        "270D-1F3FD",
 		Value:      "✍🏽",
 		Descriptor: "Writing Hand: Medium Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-prop",
+		Version:    "1.0",
 	},
 	"270D-1F3FE": Emoji{
 		Key:        "270D-1F3FE",
 		Value:      "✍🏾",
 		Descriptor: "Writing Hand: Medium-Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-prop",
+		Version:    "1.0",
 	},
 	"270D-1F3FF": Emoji{
 		Key:        "270D-1F3FF",
 		Value:      "✍🏿",
 		Descriptor: "Writing Hand: Dark Skin Tone",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-prop",
+		Version:    "1.0",
 	},
 	"270D-FE0F": Emoji{
 		Key:        "270D-FE0F",
 		Value:      "✍️",
 		Descriptor: "Writing Hand",
+		Status:     "fully-qualified",
+		Group:      "People & Body",
+		Subgroup:   "hand-prop",
+		Version:    "0.7",
 	},
 	"270F-FE0F": Emoji{
 		Key:        "270F-FE0F",
 		Value:      "✏️",
 		Descriptor: "Pencil",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "writing",
+		Version:    "0.6",
 	},
 	"2712-FE0F": Emoji{
 		Key:        "2712-FE0F",
 		Value:      "✒️",
 		Descriptor: "Black Nib",
+		Status:     "fully-qualified",
+		Group:      "Objects",
+		Subgroup:   "writing",
+		Version:    "0.6",
 	},
 	"2714-FE0F": Emoji{
 		Key:        "2714-FE0F",
 		Value:      "✔️",
-		Descriptor: "Heavy Check Mark",
+		Descriptor: "Check Mark",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "other-symbol",
+		Version:    "0.6",
 	},
 	"2716-FE0F": Emoji{
 		Key:        "2716-FE0F",
 		Value:      "✖️",
-		Descriptor: "Heavy Multiplication X",
+		Descriptor: "Multiply",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "math",
+		Version:    "0.6",
 	},
 	"271D-FE0F": Emoji{
 		Key:        "271D-FE0F",
 		Value:      "✝️",
 		Descriptor: "Latin Cross",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "religion",
+		Version:    "0.7",
 	},
 	"2721-FE0F": Emoji{
 		Key:        "2721-FE0F",
 		Value:      "✡️",
 		Descriptor: "Star of David",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "religion",
+		Version:    "0.7",
 	},
 	"2728": Emoji{
 		Key:        "2728",
 		Value:      "✨",
 		Descriptor: "Sparkles",
+		Status:     "fully-qualified",
+		Group:      "Activities",
+		Subgroup:   "event",
+		Version:    "0.6",
 	},
 	"2733-FE0F": Emoji{
 		Key:        "2733-FE0F",
 		Value:      "✳️",
 		Descriptor: "Eight-Spoked Asterisk",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "other-symbol",
+		Version:    "0.6",
 	},
 	"2734-FE0F": Emoji{
 		Key:        "2734-FE0F",
 		Value:      "✴️",
 		Descriptor: "Eight-Pointed Star",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "other-symbol",
+		Version:    "0.6",
 	},
 	"2744-FE0F": Emoji{
 		Key:        "2744-FE0F",
 		Value:      "❄️",
 		Descriptor: "Snowflake",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.6",
 	},
 	"2747-FE0F": Emoji{
 		Key:        "2747-FE0F",
 		Value:      "❇️",
 		Descriptor: "Sparkle",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "other-symbol",
+		Version:    "0.6",
 	},
 	"274C": Emoji{
 		Key:        "274C",
 		Value:      "❌",
 		Descriptor: "Cross Mark",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "other-symbol",
+		Version:    "0.6",
 	},
 	"274E": Emoji{
 		Key:        "274E",
 		Value:      "❎",
 		Descriptor: "Cross Mark Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "other-symbol",
+		Version:    "0.6",
 	},
 	"2753": Emoji{
 		Key:        "2753",
 		Value:      "❓",
-		Descriptor: "Question Mark",
+		Descriptor: "Red Question Mark",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "punctuation",
+		Version:    "0.6",
 	},
 	"2754": Emoji{
 		Key:        "2754",
 		Value:      "❔",
 		Descriptor: "White Question Mark",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "punctuation",
+		Version:    "0.6",
 	},
 	"2755": Emoji{
 		Key:        "2755",
 		Value:      "❕",
 		Descriptor: "White Exclamation Mark",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "punctuation",
+		Version:    "0.6",
 	},
 	"2757": Emoji{
 		Key:        "2757",
 		Value:      "❗",
-		Descriptor: "Exclamation Mark",
+		Descriptor: "Red Exclamation Mark",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "punctuation",
+		Version:    "0.6",
 	},
 	"2763-FE0F": Emoji{
 		Key:        "2763-FE0F",
 		Value:      "❣️",
-		Descriptor: "Heavy Heart Exclamation",
+		Descriptor: "Heart Exclamation",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "1.0",
 	},
 	"2764-FE0F": Emoji{
 		Key:        "2764-FE0F",
 		Value:      "❤️",
 		Descriptor: "Red Heart",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "0.6",
+	},
+	"2764-FE0F-200D-1F525": Emoji{
+		Key:        "2764-FE0F-200D-1F525",
+		Value:      "❤️‍🔥",
+		Descriptor: "Heart on Fire",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "13.1",
+	},
+	"2764-FE0F-200D-1FA79": Emoji{
+		Key:        "2764-FE0F-200D-1FA79",
+		Value:      "❤️‍🩹",
+		Descriptor: "Mending Heart",
+		Status:     "fully-qualified",
+		Group:      "Smileys & Emotion",
+		Subgroup:   "heart",
+		Version:    "13.1",
 	},
 	"2795": Emoji{
 		Key:        "2795",
 		Value:      "➕",
-		Descriptor: "Heavy Plus Sign",
+		Descriptor: "Plus",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "math",
+		Version:    "0.6",
 	},
 	"2796": Emoji{
 		Key:        "2796",
 		Value:      "➖",
-		Descriptor: "Heavy Minus Sign",
+		Descriptor: "Minus",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "math",
+		Version:    "0.6",
 	},
 	"2797": Emoji{
 		Key:        "2797",
 		Value:      "➗",
-		Descriptor: "Heavy Division Sign",
+		Descriptor: "Divide",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "math",
+		Version:    "0.6",
 	},
 	"27A1-FE0F": Emoji{
 		Key:        "27A1-FE0F",
 		Value:      "➡️",
 		Descriptor: "Right Arrow",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "arrow",
+		Version:    "0.6",
 	},
 	"27B0": Emoji{
 		Key:        "27B0",
 		Value:      "➰",
 		Descriptor: "Curly Loop",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "other-symbol",
+		Version:    "0.6",
 	},
 	"27BF": Emoji{
 		Key:        "27BF",
 		Value:      "➿",
 		Descriptor: "Double Curly Loop",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "other-symbol",
+		Version:    "1.0",
 	},
 	"2934-FE0F": Emoji{
 		Key:        "2934-FE0F",
 		Value:      "⤴️",
 		Descriptor: "Right Arrow Curving Up",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "arrow",
+		Version:    "0.6",
 	},
 	"2935-FE0F": Emoji{
 		Key:        "2935-FE0F",
 		Value:      "⤵️",
 		Descriptor: "Right Arrow Curving Down",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "arrow",
+		Version:    "0.6",
 	},
 	"2A-FE0F-20E3": Emoji{
 		Key:        "2A-FE0F-20E3",
 		Value:      "*️⃣",
-		Descriptor: "Keycap Asterisk",
+		Descriptor: "Keycap: *",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "keycap",
+		Version:    "2.0",
 	},
 	"2B05-FE0F": Emoji{
 		Key:        "2B05-FE0F",
 		Value:      "⬅️",
 		Descriptor: "Left Arrow",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "arrow",
+		Version:    "0.6",
 	},
 	"2B06-FE0F": Emoji{
 		Key:        "2B06-FE0F",
 		Value:      "⬆️",
 		Descriptor: "Up Arrow",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "arrow",
+		Version:    "0.6",
 	},
 	"2B07-FE0F": Emoji{
 		Key:        "2B07-FE0F",
 		Value:      "⬇️",
 		Descriptor: "Down Arrow",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "arrow",
+		Version:    "0.6",
 	},
 	"2B1B": Emoji{
 		Key:        "2B1B",
 		Value:      "⬛",
 		Descriptor: "Black Large Square",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "0.6",
 	},
 	"2B1C": Emoji{
 		Key:        "2B1C",
 		Value:      "⬜",
 		Descriptor: "White Large Square",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "geometric",
+		Version:    "0.6",
 	},
 	"2B50": Emoji{
 		Key:        "2B50",
 		Value:      "⭐",
 		Descriptor: "Star",
+		Status:     "fully-qualified",
+		Group:      "Travel & Places",
+		Subgroup:   "sky & weather",
+		Version:    "0.6",
 	},
 	"2B55": Emoji{
 		Key:        "2B55",
 		Value:      "⭕",
-		Descriptor: "Heavy Large Circle",
+		Descriptor: "Hollow Red Circle",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "other-symbol",
+		Version:    "0.6",
 	},
 	"30-FE0F-20E3": Emoji{
 		Key:        "30-FE0F-20E3",
 		Value:      "0️⃣",
-		Descriptor: "Keycap Digit Zero",
+		Descriptor: "Keycap: 0",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "keycap",
+		Version:    "0.6",
 	},
 	"3030-FE0F": Emoji{
 		Key:        "3030-FE0F",
 		Value:      "〰️",
 		Descriptor: "Wavy Dash",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "punctuation",
+		Version:    "0.6",
 	},
 	"303D-FE0F": Emoji{
 		Key:        "303D-FE0F",
 		Value:      "〽️",
 		Descriptor: "Part Alternation Mark",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "other-symbol",
+		Version:    "0.6",
 	},
 	"31-FE0F-20E3": Emoji{
 		Key:        "31-FE0F-20E3",
 		Value:      "1️⃣",
-		Descriptor: "Keycap Digit One",
+		Descriptor: "Keycap: 1",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "keycap",
+		Version:    "0.6",
 	},
 	"32-FE0F-20E3": Emoji{
 		Key:        "32-FE0F-20E3",
 		Value:      "2️⃣",
-		Descriptor: "Keycap Digit Two",
+		Descriptor: "Keycap: 2",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "keycap",
+		Version:    "0.6",
 	},
 	"3297-FE0F": Emoji{
 		Key:        "3297-FE0F",
 		Value:      "㊗️",
 		Descriptor: "Japanese “Congratulations” Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"3299-FE0F": Emoji{
 		Key:        "3299-FE0F",
 		Value:      "㊙️",
 		Descriptor: "Japanese “Secret” Button",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "alphanum",
+		Version:    "0.6",
 	},
 	"33-FE0F-20E3": Emoji{
 		Key:        "33-FE0F-20E3",
 		Value:      "3️⃣",
-		Descriptor: "Keycap Digit Three",
+		Descriptor: "Keycap: 3",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "keycap",
+		Version:    "0.6",
 	},
 	"34-FE0F-20E3": Emoji{
 		Key:        "34-FE0F-20E3",
 		Value:      "4️⃣",
-		Descriptor: "Keycap Digit Four",
+		Descriptor: "Keycap: 4",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "keycap",
+		Version:    "0.6",
 	},
 	"35-FE0F-20E3": Emoji{
 		Key:        "35-FE0F-20E3",
 		Value:      "5️⃣",
-		Descriptor: "Keycap Digit Five",
+		Descriptor: "Keycap: 5",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "keycap",
+		Version:    "0.6",
 	},
 	"36-FE0F-20E3": Emoji{
 		Key:        "36-FE0F-20E3",
 		Value:      "6️⃣",
-		Descriptor: "Keycap Digit Six",
+		Descriptor: "Keycap: 6",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "keycap",
+		Version:    "0.6",
 	},
 	"37-FE0F-20E3": Emoji{
 		Key:        "37-FE0F-20E3",
 		Value:      "7️⃣",
-		Descriptor: "Keycap Digit Seven",
+		Descriptor: "Keycap: 7",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "keycap",
+		Version:    "0.6",
 	},
 	"38-FE0F-20E3": Emoji{
 		Key:        "38-FE0F-20E3",
 		Value:      "8️⃣",
-		Descriptor: "Keycap Digit Eight",
+		Descriptor: "Keycap: 8",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "keycap",
+		Version:    "0.6",
 	},
 	"39-FE0F-20E3": Emoji{
 		Key:        "39-FE0F-20E3",
 		Value:      "9️⃣",
-		Descriptor: "Keycap Digit Nine",
+		Descriptor: "Keycap: 9",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "keycap",
+		Version:    "0.6",
 	},
 	"A9-FE0F": Emoji{
 		Key:        "A9-FE0F",
 		Value:      "©️",
 		Descriptor: "Copyright",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "other-symbol",
+		Version:    "0.6",
 	},
 	"AE-FE0F": Emoji{
 		Key:        "AE-FE0F",
 		Value:      "®️",
 		Descriptor: "Registered",
+		Status:     "fully-qualified",
+		Group:      "Symbols",
+		Subgroup:   "other-symbol",
+		Version:    "0.6",
 	},
 }
@@ -8,13 +8,25 @@ import (
 	"github.com/tmdvs/Go-Emoji-Utils/utils"
 )
 
+//go:generate go run gen.go -in emoji-test.txt -out data.go
+
 // Emoji - Struct representing Emoji
 type Emoji struct {
 	Key        string `json:"key"`
 	Value      string `json:"value"`
 	Descriptor string `json:"descriptor"`
+	Status     string `json:"status"`
+	Group      string `json:"group"`
+	Subgroup   string `json:"subgroup"`
+	Version    string `json:"version"`
 }
 
+// Emoji statuses, see https://www.unicode.org/reports/tr51/#def_qualified_emoji_character
+const (
+	StatusFullyQualified = "fully-qualified"
+	StatusComponent      = "component"
+)
+
 // LookupEmoji - Lookup a single emoji definition
 func LookupEmoji(emojiString string) (emoji Emoji, err error) {
 
@@ -111,110 +123,5 @@ func Find(emojiString string, input string) (result SearchResult, err error) {
 
 // FindAll - Find all instances of emoji
 func FindAll(input string) (detectedEmojis SearchResults) {
-
-	// Convert our string to UTF runes
-	runes := []rune(input)
-
-	// Any potential modifiers such as a skin tone/gender
-	detectedModifiers := map[int]bool{}
-
-	// Loop over each "word" in the string
-	for index, r := range runes {
-
-		// If this index has been flaged as a modifier we do
-		// not want to process it again
-		if detectedModifiers[index] {
-			continue
-		}
-
-		// Grab the initial hex value of this run
-		hexKey := utils.RunesToHexKey([]rune{r})
-
-		// Ignore any basic runes, we'll get funny partials
-		// that we dont care about
-		if len(hexKey) < 4 {
-			continue
-		}
-
-		previousKey := hexKey
-		potentialMatches := Emojis
-		nextIndex := index + 1
-
-		for {
-			// Search the Emoji definitions map to see if we have
-			// any matching results
-			potentialMatches = findEmoji(hexKey, potentialMatches)
-
-			// We found a definitive match
-			if len(potentialMatches) == 1 {
-				break
-			} else if len(potentialMatches) == 0 {
-				// We didnt find anything, so we'll check if its a single rune emoji
-				// Reset to original hexKey
-				if _, match := Emojis[previousKey]; match {
-					potentialMatches[previousKey] = Emojis[previousKey]
-				}
-
-				// Definately no modifiers
-				detectedModifiers = map[int]bool{}
-
-				break
-			} else {
-				// Have we hit the last rune? If so we'll stop
-				if nextIndex == len(runes) {
-					// We need to return the match for this current key
-					potentialMatches = map[string]Emoji{
-						hexKey: Emojis[hexKey],
-					}
-					break
-				}
-
-				// We have more than one potential match so we'll add the
-				// next UTF rune to the key and search again!
-				previousKey = hexKey
-				hexKey = hexKey + "-" + utils.RunesToHexKey([]rune{runes[nextIndex]})
-				detectedModifiers[nextIndex] = true
-				nextIndex++
-			}
-		}
-
-		// Loop over potential matches and ensure we're not counting partials
-		for key, e := range potentialMatches {
-			if _, match := Emojis[key]; match {
-
-				// How many runes does this emoji use
-				emojiRuneLength := len(strings.Split(e.Key, "-"))
-
-				// Have we already accounted for this match?
-				if i := detectedEmojis.IndexOf(e); i != -1 {
-					detectedEmojis[i].Occurrences++
-					detectedEmojis[i].Locations = append(detectedEmojis[i].Locations, []int{index, index + emojiRuneLength})
-				} else {
-					detectedEmojis = append(detectedEmojis, SearchResult{
-						Match:       e,
-						Occurrences: 1,
-						Locations: [][]int{
-							[]int{index, index + emojiRuneLength},
-						},
-					})
-				}
-			}
-		}
-	}
-
-	// Return a map of Emojis and their counts
-	return detectedEmojis
-}
-
-// Search an array of emoji definitions for a key with a partial match
-func findEmoji(term string, list map[string]Emoji) (results map[string]Emoji) {
-	results = map[string]Emoji{}
-
-	// Look for anything that has
-	for key, value := range list {
-		if strings.Index(key, term) == 0 {
-			results[key] = value
-		}
-	}
-	return
+	return findAll(input)
 }
@@ -0,0 +1,178 @@
+package emoji
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/tmdvs/Go-Emoji-Utils/utils"
+)
+
+// findAllScan is the original FindAll, which scans Emojis for every candidate rune.
+func findAllScan(input string) (detectedEmojis SearchResults) {
+
+	// Convert our string to UTF runes
+	runes := []rune(input)
+
+	// Any potential modifiers such as a skin tone/gender
+	detectedModifiers := map[int]bool{}
+
+	// Loop over each "word" in the string
+	for index, r := range runes {
+
+		// If this index has been flaged as a modifier we do
+		// not want to process it again
+		if detectedModifiers[index] {
+			continue
+		}
+
+		// Grab the initial hex value of this run
+		hexKey := utils.RunesToHexKey([]rune{r})
+
+		// Ignore any basic runes, we'll get funny partials
+		// that we dont care about
+		if len(hexKey) < 4 {
+			continue
+		}
+
+		previousKey := hexKey
+		potentialMatches := Emojis
+		nextIndex := index + 1
+
+		for {
+			// Search the Emoji definitions map to see if we have
+			// any matching results
+			potentialMatches = findEmojiScan(hexKey, potentialMatches)
+
+			// We found a definitive match
+			if len(potentialMatches) == 1 {
+				break
+			} else if len(potentialMatches) == 0 {
+				// We didnt find anything, so we'll check if its a single rune emoji
+				// Reset to original hexKey
+				if _, match := Emojis[previousKey]; match {
+					potentialMatches[previousKey] = Emojis[previousKey]
+				}
+
+				// Definately no modifiers
+				detectedModifiers = map[int]bool{}
+
+				break
+			} else {
+				// Have we hit the last rune? If so we'll stop
+				if nextIndex == len(runes) {
+					// We need to return the match for this current key
+					potentialMatches = map[string]Emoji{
+						hexKey: Emojis[hexKey],
+					}
+					break
+				}
+
+				// We have more than one potential match so we'll add the
+				// next UTF rune to the key and search again!
+				previousKey = hexKey
+				hexKey = hexKey + "-" + utils.RunesToHexKey([]rune{runes[nextIndex]})
+				detectedModifiers[nextIndex] = true
+				nextIndex++
+			}
+		}
+
+		// Loop over potential matches and ensure we're not counting partials
+		for key, e := range potentialMatches {
+			if _, match := Emojis[key]; match {
+
+				// How many runes does this emoji use
+				emojiRuneLength := len(strings.Split(e.Key, "-"))
+
+				// Have we already accounted for this match?
+				if i := detectedEmojis.IndexOf(e); i != -1 {
+					detectedEmojis[i].Occurrences++
+					detectedEmojis[i].Locations = append(detectedEmojis[i].Locations, []int{index, index + emojiRuneLength})
+				} else {
+					detectedEmojis = append(detectedEmojis, SearchResult{
+						Match:       e,
+						Occurrences: 1,
+						Locations: [][]int{
+							[]int{index, index + emojiRuneLength},
+						},
+					})
+				}
+			}
+		}
+	}
+
+	// Return a map of Emojis and their counts
+	return detectedEmojis
+}
+
+// Search an array of emoji definitions for a key with a partial match
+func findEmojiScan(term string, list map[string]Emoji) (results map[string]Emoji) {
+	results = map[string]Emoji{}
+
+	// Look for anything that has
+	for key, value := range list {
+		if strings.Index(key, term) == 0 {
+			results[key] = value
+		}
+	}
+	return
+}
+
+func equivalenceInputs() []string {
+	var values []string
+	for _, e := range Emojis {
+		values = append(values, e.Value)
+	}
+	sort.Strings(values)
+	inputs := []string{
+		"",
+		"hello",
+		"👍",
+		"👍👍🔥 👍",
+		"❤️ thanks",
+		"👨\u200dx",
+		"👩‍👩‍👧‍👦👩‍👩‍👧",
+		"🏳️‍🌈🏴‍☠️",
+		"🇩🇪🇺🇸🇫",
+		"ὌᾓἎ 👍🏻👍🏿",
+		"#️⃣ 1️⃣ ©️",
+	}
+	inputs = append(inputs, values...)
+	rng := rand.New(rand.NewSource(1))
+	separators := []string{"", " ", "x", "\u200d", "\ufe0f", "\U0001F3FB", "Ὄ"}
+	for i := 0; i < 2000; i++ {
+		var b strings.Builder
+		for j := rng.Intn(6); j >= 0; j-- {
+			b.WriteString(values[rng.Intn(len(values))])
+			b.WriteString(separators[rng.Intn(len(separators))])
+		}
+		inputs = append(inputs, b.String())
+	}
+	return inputs
+}
+
+func TestFindAllMatchesScan(t *testing.T) {
+	for _, input := range equivalenceInputs() {
+		got, want := FindAll(input), findAllScan(input)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("FindAll(%q) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+const benchmarkInput = "Thanks everyone 🎉🎉 see you all tomorrow 👋 👍🏽 ❤️ (and bring 🍕!)"
+
+func BenchmarkFindAll(b *testing.B) {
+	b.Run("trie", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			FindAll(benchmarkInput)
+		}
+	})
+	b.Run("scan", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			findAllScan(benchmarkInput)
+		}
+	})
+}
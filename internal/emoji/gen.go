@@ -0,0 +1,177 @@
+//go:build ignore
+// +build ignore
+
+// gen.go writes data.go from a local copy of the Unicode emoji-test.txt:
+//
+//	go run gen.go -in emoji-test.txt -out data.go
+//
+// Get the latest emoji-test.txt from https://unicode.org/Public/emoji/latest/emoji-test.txt
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type emoji struct {
+	Key        string
+	Value      string
+	Descriptor string
+	Status     string
+	Group      string
+	Subgroup   string
+	Version    string
+}
+
+var (
+	// 1F44D 1F3FB ; fully-qualified # 👍🏻 E1.0 thumbs up: light skin tone
+	lineRx    = regexp.MustCompile(`^([0-9A-F ]+?)\s*;\s*([a-z-]+)\s*#\s*\S+\s+E([0-9.]+)\s+(.+)$`)
+	versionRx = regexp.MustCompile(`^# Version: ([0-9.]+)`)
+)
+
+// statuses are the statuses of the emoji included in data.go.
+var statuses = map[string]bool{
+	"fully-qualified": true,
+	"component":       true,
+}
+
+// lowerWords stay lower case in descriptors unless they come first.
+var lowerWords = map[string]bool{
+	"a": true, "an": true, "and": true, "as": true, "at": true, "for": true, "from": true,
+	"in": true, "of": true, "on": true, "or": true, "the": true, "to": true,
+}
+
+func main() {
+	in := flag.String("in", "emoji-test.txt", "")
+	out := flag.String("out", "data.go", "")
+	flag.Parse()
+
+	f, err := os.Open(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	version, emojis, err := parse(f)
+	if err != nil {
+		log.Fatalf("%s: %v", *in, err)
+	}
+	src, err := render(version, emojis)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("wrote %d emoji (Unicode emoji %s) to %s", len(emojis), version, *out)
+}
+
+func parse(f *os.File) (string, []emoji, error) {
+	var version, group, subgroup string
+	var out []emoji
+	scanner := bufio.NewScanner(f)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := scanner.Text()
+		switch {
+		case versionRx.MatchString(line):
+			version = versionRx.FindStringSubmatch(line)[1]
+		case strings.HasPrefix(line, "# group: "):
+			group = strings.TrimPrefix(line, "# group: ")
+		case strings.HasPrefix(line, "# subgroup: "):
+			subgroup = strings.TrimPrefix(line, "# subgroup: ")
+		case line == "" || strings.HasPrefix(line, "#"):
+		default:
+			match := lineRx.FindStringSubmatch(line)
+			if match == nil {
+				return "", nil, fmt.Errorf("line %d: cannot parse %q", lineNumber, line)
+			}
+			if !statuses[match[2]] {
+				continue
+			}
+			var runes []rune
+			var keyParts []string
+			for _, cp := range strings.Fields(match[1]) {
+				r, err := strconv.ParseUint(cp, 16, 32)
+				if err != nil {
+					return "", nil, fmt.Errorf("line %d: %v", lineNumber, err)
+				}
+				runes = append(runes, rune(r))
+				// keys have no leading zeros, like utils.RunesToHexKey
+				keyParts = append(keyParts, fmt.Sprintf("%X", r))
+			}
+			out = append(out, emoji{
+				Key:        strings.Join(keyParts, "-"),
+				Value:      string(runes),
+				Descriptor: titleCase(match[4]),
+				Status:     match[2],
+				Group:      group,
+				Subgroup:   subgroup,
+				Version:    match[3],
+			})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", nil, err
+	}
+	if version == "" {
+		return "", nil, fmt.Errorf("no version header")
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return version, out, nil
+}
+
+// titleCase turns "man in steamy room: medium-light skin tone" into "Man in Steamy Room: Medium-Light Skin Tone"
+// and "A button (blood type)" into "A Button (Blood Type)".
+func titleCase(name string) string {
+	words := strings.Split(name, " ")
+	for i, w := range words {
+		if i > 0 && lowerWords[w] {
+			continue
+		}
+		parts := strings.Split(w, "-")
+		for j, p := range parts {
+			rs := []rune(p)
+			for k, r := range rs {
+				if unicode.IsLetter(r) {
+					rs[k] = unicode.ToUpper(r)
+					break
+				}
+			}
+			parts[j] = string(rs)
+		}
+		words[i] = strings.Join(parts, "-")
+	}
+	return strings.Join(words, " ")
+}
+
+func render(version string, emojis []emoji) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen.go from emoji-test.txt; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package emoji\n\n")
+	fmt.Fprintf(&buf, "// UnicodeVersion is the version of the Unicode emoji data in Emojis.\n")
+	fmt.Fprintf(&buf, "const UnicodeVersion = %q\n\n", version)
+	fmt.Fprintf(&buf, "var Emojis = map[string]Emoji{\n")
+	for _, e := range emojis {
+		fmt.Fprintf(&buf, "\t%q: Emoji{\n", e.Key)
+		fmt.Fprintf(&buf, "\t\tKey: %q,\n", e.Key)
+		fmt.Fprintf(&buf, "\t\tValue: \"%s\",\n", e.Value) // raw, %q would escape ZWJs and tags
+		fmt.Fprintf(&buf, "\t\tDescriptor: %q,\n", e.Descriptor)
+		fmt.Fprintf(&buf, "\t\tStatus: %q,\n", e.Status)
+		fmt.Fprintf(&buf, "\t\tGroup: %q,\n", e.Group)
+		fmt.Fprintf(&buf, "\t\tSubgroup: %q,\n", e.Subgroup)
+		fmt.Fprintf(&buf, "\t\tVersion: %q,\n", e.Version)
+		fmt.Fprintf(&buf, "\t},\n")
+	}
+	fmt.Fprintf(&buf, "}\n")
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,61 @@
+package emoji
+
+import (
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+var ids struct {
+	once    sync.Once
+	byValue map[string]string
+	byID    map[string]Emoji
+}
+
+// ID returns a short identifier for the emoji with the given value.
+// IDs are derived from the emoji's key only. Of two emoji whose IDs collide, the one with the
+// smaller key keeps the ID and the other has none, so an emoji's ID only changes if an emoji
+// with a smaller, colliding key is added to Emojis.
+func ID(value string) (string, bool) {
+	ids.once.Do(buildIDs)
+	id, ok := ids.byValue[value]
+	return id, ok
+}
+
+// ByID returns the emoji with the given ID.
+func ByID(id string) (Emoji, bool) {
+	ids.once.Do(buildIDs)
+	e, ok := ids.byID[id]
+	return e, ok
+}
+
+func buildIDs() {
+	ids.byValue, ids.byID = makeIDs(Emojis, keyID)
+}
+
+func keyID(key string) string {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return strconv.FormatUint(uint64(h.Sum32()), 36)
+}
+
+func makeIDs(emojis map[string]Emoji, id func(key string) string) (byValue map[string]string, byID map[string]Emoji) {
+	keys := make([]string, 0, len(emojis))
+	for key := range emojis {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	byValue = make(map[string]string, len(emojis))
+	byID = make(map[string]Emoji, len(emojis))
+	for _, key := range keys {
+		e := emojis[key]
+		id := id(key)
+		if _, ok := byID[id]; ok {
+			continue
+		}
+		byID[id] = e
+		byValue[e.Value] = id
+	}
+	return byValue, byID
+}
@@ -0,0 +1,37 @@
+package emoji
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMakeIDsCollisions(t *testing.T) {
+	emojis := map[string]Emoji{
+		"b": {Key: "b", Value: "B"},
+		"a": {Key: "a", Value: "A"},
+		"c": {Key: "c", Value: "C"},
+	}
+	// a and c collide; a has the smaller key and keeps the ID.
+	id := func(key string) string { return map[string]string{"a": "1", "b": "2", "c": "1"}[key] }
+	for i := 0; i < 10; i++ {
+		byValue, byID := makeIDs(emojis, id)
+		if want := map[string]string{"A": "1", "B": "2"}; !reflect.DeepEqual(byValue, want) {
+			t.Fatalf("IDs = %v, want %v", byValue, want)
+		}
+		if byID["1"].Value != "A" || byID["2"].Value != "B" || len(byID) != 2 {
+			t.Fatalf("emoji by ID = %v, want 1:A and 2:B", byID)
+		}
+	}
+}
+
+func TestIDRoundTrip(t *testing.T) {
+	for _, e := range Emojis {
+		id, ok := ID(e.Value)
+		if !ok {
+			continue
+		}
+		if got, ok := ByID(id); !ok || got.Value != e.Value {
+			t.Errorf("ByID(ID(%s)) = %s, %v", e.Value, got.Value, ok)
+		}
+	}
+}
@@ -0,0 +1,284 @@
+package emoji
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// githubAliases are the most common GitHub (gemoji) shortcodes. They take precedence over CLDR names.
+var githubAliases = map[string]string{
+	"+1":                           "👍",
+	"thumbsup":                     "👍",
+	"-1":                           "👎",
+	"thumbsdown":                   "👎",
+	"ok_hand":                      "👌",
+	"clap":                         "👏",
+	"wave":                         "👋",
+	"pray":                         "🙏",
+	"muscle":                       "💪",
+	"raised_hands":                 "🙌",
+	"point_up":                     "☝️",
+	"v":                            "✌️",
+	"facepunch":                    "👊",
+	"punch":                        "👊",
+	"fist":                         "✊",
+	"handshake":                    "🤝",
+	"eyes":                         "👀",
+	"brain":                        "🧠",
+	"smile":                        "😄",
+	"smiley":                       "😃",
+	"grinning":                     "😀",
+	"grin":                         "😁",
+	"laughing":                     "😆",
+	"satisfied":                    "😆",
+	"sweat_smile":                  "😅",
+	"joy":                          "😂",
+	"rofl":                         "🤣",
+	"slightly_smiling_face":        "🙂",
+	"upside_down_face":             "🙃",
+	"wink":                         "😉",
+	"blush":                        "😊",
+	"innocent":                     "😇",
+	"heart_eyes":                   "😍",
+	"star_struck":                  "🤩",
+	"kissing_heart":                "😘",
+	"yum":                          "😋",
+	"stuck_out_tongue":             "😛",
+	"stuck_out_tongue_winking_eye": "😜",
+	"thinking":                     "🤔",
+	"neutral_face":                 "😐",
+	"expressionless":               "😑",
+	"roll_eyes":                    "🙄",
+	"smirk":                        "😏",
+	"relieved":                     "😌",
+	"pensive":                      "😔",
+	"sleeping":                     "😴",
+	"sleepy":                       "😪",
+	"mask":                         "😷",
+	"nerd_face":                    "🤓",
+	"sunglasses":                   "😎",
+	"confused":                     "😕",
+	"worried":                      "😟",
+	"open_mouth":                   "😮",
+	"hushed":                       "😯",
+	"astonished":                   "😲",
+	"flushed":                      "😳",
+	"pleading_face":                "🥺",
+	"fearful":                      "😨",
+	"cold_sweat":                   "😰",
+	"cry":                          "😢",
+	"sob":                          "😭",
+	"scream":                       "😱",
+	"disappointed":                 "😞",
+	"weary":                        "😩",
+	"tired_face":                   "😫",
+	"triumph":                      "😤",
+	"rage":                         "😡",
+	"angry":                        "😠",
+	"skull":                        "💀",
+	"poop":                         "💩",
+	"hankey":                       "💩",
+	"clown_face":                   "🤡",
+	"ghost":                        "👻",
+	"alien":                        "👽",
+	"robot":                        "🤖",
+	"see_no_evil":                  "🙈",
+	"hear_no_evil":                 "🙉",
+	"speak_no_evil":                "🙊",
+	"facepalm":                     "🤦",
+	"shrug":                        "🤷",
+	"partying_face":                "🥳",
+	"exploding_head":               "🤯",
+	"heart":                        "❤️",
+	"orange_heart":                 "🧡",
+	"yellow_heart":                 "💛",
+	"green_heart":                  "💚",
+	"blue_heart":                   "💙",
+	"purple_heart":                 "💜",
+	"black_heart":                  "🖤",
+	"broken_heart":                 "💔",
+	"sparkling_heart":              "💖",
+	"two_hearts":                   "💕",
+	"100":                          "💯",
+	"fire":                         "🔥",
+	"sparkles":                     "✨",
+	"star":                         "⭐",
+	"star2":                        "🌟",
+	"zap":                          "⚡",
+	"boom":                         "💥",
+	"tada":                         "🎉",
+	"confetti_ball":                "🎊",
+	"balloon":                      "🎈",
+	"gift":                         "🎁",
+	"trophy":                       "🏆",
+	"medal_sports":                 "🏅",
+	"rocket":                       "🚀",
+	"coffee":                       "☕",
+	"beer":                         "🍺",
+	"beers":                        "🍻",
+	"pizza":                        "🍕",
+	"cake":                         "🍰",
+	"birthday":                     "🎂",
+	"white_check_mark":             "✅",
+	"heavy_check_mark":             "✔️",
+	"x":                            "❌",
+	"heavy_plus_sign":              "➕",
+	"heavy_minus_sign":             "➖",
+	"question":                     "❓",
+	"exclamation":                  "❗",
+	"warning":                      "⚠️",
+	"no_entry":                     "⛔",
+	"no_entry_sign":                "🚫",
+	"bulb":                         "💡",
+	"memo":                         "📝",
+	"pushpin":                      "📌",
+	"link":                         "🔗",
+	"lock":                         "🔒",
+	"bell":                         "🔔",
+	"hourglass":                    "⌛",
+	"moneybag":                     "💰",
+	"chart_with_upwards_trend":     "📈",
+	"chart_with_downwards_trend":   "📉",
+	"calendar":                     "📆",
+	"bug":                          "🐛",
+	"zzz":                          "💤",
+	"wave_dash":                    "〰️",
+	"sunny":                        "☀️",
+	"rainbow":                      "🌈",
+	"snowflake":                    "❄️",
+	"cat":                          "🐱",
+	"dog":                          "🐶",
+	"unicorn":                      "🦄",
+	"monkey_face":                  "🐵",
+	"ok":                           "🆗",
+	"new":                          "🆕",
+	"cool":                         "🆒",
+	"up":                           "🆙",
+	"sos":                          "🆘",
+	"point_right":                  "👉",
+	"point_left":                   "👈",
+	"point_down":                   "👇",
+	"crossed_fingers":              "🤞",
+	"metal":                        "🤘",
+	"call_me_hand":                 "🤙",
+	"raised_hand":                  "✋",
+	"writing_hand":                 "✍️",
+	"nail_care":                    "💅",
+	"dart":                         "🎯",
+	"game_die":                     "🎲",
+	"soccer":                       "⚽",
+	"basketball":                   "🏀",
+	"musical_note":                 "🎵",
+	"headphones":                   "🎧",
+	"camera":                       "📷",
+	"movie_camera":                 "🎥",
+	"tv":                           "📺",
+	"computer":                     "💻",
+	"iphone":                       "📱",
+	"email":                        "📧",
+	"mailbox":                      "📫",
+	"package":                      "📦",
+	"mag":                          "🔍",
+	"key":                          "🔑",
+	"hammer":                       "🔨",
+	"wrench":                       "🔧",
+	"gear":                         "⚙️",
+	"construction":                 "🚧",
+	"checkered_flag":               "🏁",
+	"triangular_flag_on_post":      "🚩",
+	"heavy_heart_exclamation":      "❣️",
+	"hugs":                         "🤗",
+	"shushing_face":                "🤫",
+	"zipper_mouth_face":            "🤐",
+	"money_mouth_face":             "🤑",
+	"cowboy_hat_face":              "🤠",
+	"nauseated_face":               "🤢",
+	"vomiting_face":                "🤮",
+	"sneezing_face":                "🤧",
+	"hot_face":                     "🥵",
+	"cold_face":                    "🥶",
+	"woozy_face":                   "🥴",
+	"yawning_face":                 "🥱",
+	"saluting_face":                "🫡",
+	"melting_face":                 "🫠",
+	"face_with_monocle":            "🧐",
+	"monocle_face":                 "🧐",
+	"slightly_frowning_face":       "🙁",
+	"frowning_face":                "☹️",
+	"grimacing":                    "😬",
+	"lying_face":                   "🤥",
+	"drooling_face":                "🤤",
+	"money_with_wings":             "💸",
+	"gem":                          "💎",
+	"crown":                        "👑",
+	"ring":                         "💍",
+	"kiss":                         "💋",
+	"pinching_hand":                "🤏",
+	"open_hands":                   "👐",
+	"palms_up_together":            "🤲",
+}
+
+var shortcodeRx = regexp.MustCompile(`:([A-Za-z0-9_+\-]+):`)
+
+var shortcodes struct {
+	once   sync.Once
+	byName map[string]string
+}
+
+// Shortcode returns the emoji value for a shortcode name without colons: either
+// a GitHub alias ("+1", "tada") or the emoji's CLDR name in snake case
+// ("thumbs_up", "thumbs_up_medium_skin_tone"). Names are case-insensitive.
+func Shortcode(name string) (string, bool) {
+	shortcodes.once.Do(buildShortcodes)
+	value, ok := shortcodes.byName[strings.ToLower(name)]
+	return value, ok
+}
+
+// ResolveShortcodes replaces the known `:shortcode:`s in s with their emoji (see Shortcode).
+// Unknown shortcodes are left as they are.
+func ResolveShortcodes(s string) string {
+	if !strings.Contains(s, ":") {
+		return s
+	}
+	return shortcodeRx.ReplaceAllStringFunc(s, func(match string) string {
+		if value, ok := Shortcode(match[1 : len(match)-1]); ok {
+			return value
+		}
+		return match
+	})
+}
+
+func buildShortcodes() {
+	shortcodes.byName = make(map[string]string, len(Emojis)+len(githubAliases))
+	ambiguous := make(map[string]bool)
+	for _, e := range Emojis {
+		if e.Status != StatusFullyQualified {
+			continue
+		}
+		name := cldrShortcode(e.Descriptor)
+		if name == "" {
+			continue
+		}
+		if _, ok := shortcodes.byName[name]; ok {
+			ambiguous[name] = true
+			continue
+		}
+		shortcodes.byName[name] = e.Value
+	}
+	for name := range ambiguous {
+		delete(shortcodes.byName, name)
+	}
+	for name, value := range githubAliases {
+		shortcodes.byName[name] = value
+	}
+}
+
+// cldrShortcode turns a descriptor like "Thumbs Up: Medium Skin Tone" into "thumbs_up_medium_skin_tone".
+func cldrShortcode(descriptor string) string {
+	words := strings.FieldsFunc(strings.ToLower(descriptor), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, "_")
+}
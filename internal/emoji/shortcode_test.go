@@ -0,0 +1,45 @@
+package emoji
+
+import "testing"
+
+func TestShortcode(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   string
+		wantOK bool
+	}{
+		{"+1", "👍", true},
+		{"tada", "🎉", true},
+		{"thumbs_up", "👍", true},
+		{"Thumbs_Up", "👍", true},
+		{"thumbs_up_medium_skin_tone", "👍🏽", true},
+		{"no_such_emoji", "", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		if got, ok := Shortcode(tt.name); got != tt.want || ok != tt.wantOK {
+			t.Errorf("Shortcode(%q) = %q, %v; want %q, %v", tt.name, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestResolveShortcodes(t *testing.T) {
+	tests := []struct {
+		s, want string
+	}{
+		{"", ""},
+		{"no codes here", "no codes here"},
+		{"time: 12:30", "time: 12:30"},
+		{":+1:", "👍"},
+		{"nice :tada: work", "nice 🎉 work"},
+		{":+1::tada:", "👍🎉"},
+		{":+1: :no_such_emoji: :joy:", "👍 :no_such_emoji: 😂"},
+		{":no_such_emoji:", ":no_such_emoji:"},
+		{"::", "::"},
+	}
+	for _, tt := range tests {
+		if got := ResolveShortcodes(tt.s); got != tt.want {
+			t.Errorf("ResolveShortcodes(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package emoji
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// trieNode is a node in the trie of Emojis keys, keyed by code point.
+type trieNode struct {
+	children map[rune]*trieNode
+	// key is the Emojis key ending at this node, if any.
+	key string
+	// size is the number of keys ending in this subtree.
+	size int
+	// prefixes aggregates the children by prefixes of their hex code points (see findAll).
+	prefixes map[string]trieMatches
+}
+
+// trieMatches counts the keys in a set of subtrees, and holds the key if there is exactly one.
+type trieMatches struct {
+	count int
+	key   string
+}
+
+var trie struct {
+	once sync.Once
+	root *trieNode
+}
+
+func buildTrie() {
+	trie.root = &trieNode{}
+	for key := range Emojis {
+		node := trie.root
+		node.size++
+		for _, part := range strings.Split(key, "-") {
+			r, err := strconv.ParseUint(part, 16, 32)
+			if err != nil {
+				break
+			}
+			child := node.children[rune(r)]
+			if child == nil {
+				child = &trieNode{}
+				if node.children == nil {
+					node.children = make(map[rune]*trieNode)
+				}
+				node.children[rune(r)] = child
+			}
+			node = child
+			node.size++
+		}
+		node.key = key
+	}
+	trie.root.index()
+}
+
+func (n *trieNode) index() {
+	if len(n.children) == 0 {
+		return
+	}
+	n.prefixes = make(map[string]trieMatches)
+	for r, child := range n.children {
+		child.index()
+		h := hexKey(r)
+		for i := 1; i <= len(h); i++ {
+			m := n.prefixes[h[:i]]
+			m.count += child.size
+			m.key = ""
+			if m.count == 1 {
+				m.key = child.onlyKey()
+			}
+			n.prefixes[h[:i]] = m
+		}
+	}
+}
+
+// onlyKey returns the key of a subtree of size 1.
+func (n *trieNode) onlyKey() string {
+	for n.key == "" {
+		for _, child := range n.children {
+			n = child
+		}
+	}
+	return n.key
+}
+
+func (n *trieNode) matches(r rune) trieMatches {
+	if n == nil {
+		return trieMatches{}
+	}
+	return n.prefixes[hexKey(r)]
+}
+
+func hexKey(r rune) string {
+	return strings.ToUpper(strconv.FormatUint(uint64(r), 16))
+}
+
+// findAll finds emoji the way the original FindAll did - by matching the hex key built from
+// the runes seen so far as a string prefix against all Emojis keys, adding one rune at a time
+// while there is more than one candidate - but looks candidates up in the trie instead of
+// scanning Emojis. Since candidates are string prefix matches, the last rune of a hex key
+// also matches code points whose hex form it is a prefix of, which is what trieNode.prefixes is for.
+func findAll(input string) (detectedEmojis SearchResults) {
+	trie.once.Do(buildTrie)
+	runes := []rune(input)
+	detectedModifiers := make([]bool, len(runes))
+	for index, r := range runes {
+		if detectedModifiers[index] || len(hexKey(r)) < 4 {
+			continue
+		}
+		var key string
+		node, last, next := trie.root, r, index+1
+		for {
+			m := node.matches(last)
+			if m.count == 1 {
+				key = m.key
+				break
+			}
+			if m.count == 0 {
+				// fall back to the runes before the last one; forget that they were consumed
+				if node != nil {
+					key = node.key
+				}
+				for i := index + 1; i < next; i++ {
+					detectedModifiers[i] = false
+				}
+				break
+			}
+			child := node.children[last]
+			if next == len(runes) {
+				if child != nil {
+					key = child.key
+				}
+				break
+			}
+			detectedModifiers[next] = true
+			node, last, next = child, runes[next], next+1
+		}
+		e, ok := Emojis[key]
+		if key == "" || !ok {
+			continue
+		}
+		emojiRuneLength := strings.Count(e.Key, "-") + 1
+		location := []int{index, index + emojiRuneLength}
+		if i := detectedEmojis.IndexOf(e); i != -1 {
+			detectedEmojis[i].Occurrences++
+			detectedEmojis[i].Locations = append(detectedEmojis[i].Locations, location)
+		} else {
+			detectedEmojis = append(detectedEmojis, SearchResult{
+				Match:       e,
+				Occurrences: 1,
+				Locations:   [][]int{location},
+			})
+		}
+	}
+	return detectedEmojis
+}
@@ -0,0 +1,78 @@
+package emoji
+
+import (
+	"github.com/tmdvs/Go-Emoji-Utils/utils"
+)
+
+const (
+	zeroWidthJoiner   = '\u200D'
+	variationSelector = '\uFE0F'
+	femaleSign        = '\u2640'
+	maleSign          = '\u2642'
+	man               = '\U0001F468'
+	woman             = '\U0001F469'
+	person            = '\U0001F9D1'
+)
+
+func isSkinTone(r rune) bool {
+	return r >= 0x1F3FB && r <= 0x1F3FF
+}
+
+func isGenderSign(r rune) bool {
+	return r == femaleSign || r == maleSign
+}
+
+// Base returns the emoji without its skin tone and gender, e.g. 👍 for 👍🏽, 🤷 for 🤷🏻‍♀️
+// and 🧑‍💻 for 👩‍💻. Skin tone modifiers and ZWJ gender signs are removed, and a leading
+// man or woman becomes a person. Each step is only kept if the result is in Emojis;
+// emoji without a base (including Base's results) are returned unchanged.
+func Base(value string) string {
+	runes := []rune(value)
+	out := make([]rune, 0, len(runes))
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case i > 0 && isSkinTone(r):
+			continue
+		case i > 0 && r == zeroWidthJoiner && i+1 < len(runes) && isGenderSign(runes[i+1]):
+			i++
+			if i+1 < len(runes) && runes[i+1] == variationSelector {
+				i++
+			}
+			continue
+		}
+		out = append(out, r)
+	}
+	base := value
+	if len(out) < len(runes) {
+		if s, ok := lookupQualified(out); ok {
+			base = s
+		}
+	}
+	if baseRunes := []rune(base); len(baseRunes) > 2 && (baseRunes[0] == man || baseRunes[0] == woman) && baseRunes[1] == zeroWidthJoiner {
+		baseRunes[0] = person
+		if s, ok := lookupQualified(baseRunes); ok {
+			base = s
+		}
+	}
+	return base
+}
+
+// lookupQualified returns the emoji value for runes, adding the variation selector after
+// the first rune if that is what makes it an emoji (as for ☝️ from ☝🏻).
+func lookupQualified(runes []rune) (string, bool) {
+	if len(runes) == 0 {
+		return "", false
+	}
+	if e, ok := Emojis[utils.RunesToHexKey(runes)]; ok {
+		return e.Value, true
+	}
+	if len(runes) > 1 && runes[1] == variationSelector {
+		return "", false
+	}
+	qualified := append([]rune{runes[0], variationSelector}, runes[1:]...)
+	if e, ok := Emojis[utils.RunesToHexKey(qualified)]; ok {
+		return e.Value, true
+	}
+	return "", false
+}
@@ -0,0 +1,25 @@
+package emoji
+
+import "testing"
+
+func TestBase(t *testing.T) {
+	tests := []struct {
+		value, want string
+	}{
+		{"👍", "👍"},
+		{"👍🏽", "👍"},
+		{"👮‍♀️", "👮"},
+		{"🤷🏻‍♀️", "🤷"},
+		{"👩🏽‍💻", "🧑‍💻"},
+		{"👨‍💻", "🧑‍💻"},
+		{"🧑‍💻", "🧑‍💻"},
+		{"👨‍👩‍👧", "👨‍👩‍👧"},
+		{"🏳️‍🌈", "🏳️‍🌈"},
+		{"x", "x"},
+	}
+	for _, tt := range tests {
+		if got := Base(tt.value); got != tt.want {
+			t.Errorf("Base(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
@@ -150,9 +150,14 @@ var (
 	cbackRx = regexp.MustCompile(`^\f(\w+)(\|(.+))?$`)
 )
 
+// handleCommand routes a "/command@bot" message to the handler for "/command".
+// Commands addressed to other bots are considered handled, so they are ignored.
 func (b *Bot) handleCommand(m *Message, cmdName, cmdBot string) bool {
+	if cmdBot != "" && !strings.EqualFold(b.Me.Username, cmdBot) {
+		return true
+	}
 
-	return false
+	return b.handle(cmdName, m)
 }
 
 // Start brings bot into motion by consuming incoming
@@ -207,11 +212,7 @@ func (b *Bot) incomingUpdate(upd *Update) {
 				command, botName := match[0][1], match[0][3]
 				m.Payload = match[0][5]
 
-				if botName != "" && !strings.EqualFold(b.Me.Username, botName) {
-					return
-				}
-
-				if b.handle(command, m) {
+				if b.handleCommand(m, command, botName) {
 					return
 				}
 			}
@@ -736,6 +737,32 @@ func (b *Bot) EditCaption(originalMsg Editable, caption string) (*Message, error
 	return extractMsgResponse(respJSON)
 }
 
+// EditReplyMarkup used to edit reply markup of already sent message.
+//
+// On success, returns edited message object
+func (b *Bot) EditReplyMarkup(message Editable, markup *ReplyMarkup) (*Message, error) {
+	messageID, chatID := message.MessageSig()
+
+	params := map[string]string{}
+
+	// if inline message
+	if chatID == 0 {
+		params["inline_message_id"] = messageID
+	} else {
+		params["chat_id"] = strconv.FormatInt(chatID, 10)
+		params["message_id"] = messageID
+	}
+
+	embedSendOptions(params, &SendOptions{ReplyMarkup: markup})
+
+	respJSON, err := b.Raw("editMessageReplyMarkup", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return extractMsgResponse(respJSON)
+}
+
 // EditMedia used to edit already sent media with known recepient and message id.
 //
 // Use cases:
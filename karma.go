@@ -0,0 +1,140 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+)
+
+const (
+	bucketKarma     = "karma"
+	bucketKarmaVote = "karma-vote"
+)
+
+const karmaTopN = 10
+
+// karmaVotes maps the replies that count as karma to their value.
+var karmaVotes = map[string]int{
+	"+1": 1,
+	"++": 1,
+	"-1": -1,
+	"--": -1,
+}
+
+func karmaVote(text string) (int, bool) {
+	delta, ok := karmaVotes[strings.TrimSpace(text)]
+	return delta, ok
+}
+
+func isKarmaVote(m *telegram.Message) bool {
+	_, ok := karmaVote(m.Text)
+	return ok
+}
+
+// karmaUser is a user's karma in a chat.
+type karmaUser struct {
+	Total int `json:"t"`
+}
+
+func karmaKey(chatID int64, userID int) string {
+	return fmt.Sprintf("%x:%x", chatID, userID)
+}
+
+func (bot *emojiReactionBot) KarmaRead(chatID int64, userID int) karmaUser {
+	var k karmaUser
+	bot.storeGet(bucketKarma, karmaKey(chatID, userID), &k)
+	return k
+}
+
+// addKarma handles a karma reply (see karmaVotes): it changes the karma of the replied-to message's author,
+// unless that is the voter themself, a bot, or someone the voter voted for within the cooldown.
+// The reply is deleted; rejected votes are answered with the reason instead.
+func (bot *emojiReactionBot) addKarma(m *telegram.Message, delta int) {
+	defer bot.Delete(m)
+	voter, author := m.Sender, m.ReplyTo.Sender
+	switch {
+	case voter.ID == author.ID:
+		bot.replyKarmaRejected(m, "you can't give yourself karma.")
+		return
+	case author.IsBot:
+		bot.replyKarmaRejected(m, "bots don't get karma.")
+		return
+	}
+	voteKey := fmt.Sprintf("%x:%x:%x", m.Chat.ID, voter.ID, author.ID)
+	bot.karmaMu.Lock()
+	var lastVote time.Time
+	if bot.storeGet(bucketKarmaVote, voteKey, &lastVote) && time.Since(lastVote) < config.KarmaCooldown {
+		bot.karmaMu.Unlock()
+		wait := (config.KarmaCooldown - time.Since(lastVote)).Round(time.Second)
+		bot.replyKarmaRejected(m, fmt.Sprintf("you can vote for %s again in %s.", displayName(author), wait))
+		return
+	}
+	bot.storePut(bucketKarmaVote, voteKey, time.Now())
+	k := bot.KarmaRead(m.Chat.ID, author.ID)
+	k.Total += delta
+	bot.storePut(bucketKarma, karmaKey(m.Chat.ID, author.ID), k)
+	bot.karmaMu.Unlock()
+	reply, err := bot.Reply(m.ReplyTo, fmt.Sprintf("%s has %d karma (%+d from %s)", displayName(author), k.Total, delta, displayName(voter)), telegram.Silent)
+	if err != nil {
+		log.Printf("karma %v: reply: %v", m.ID, err)
+		return
+	}
+	jsonOut.Encode(reply)
+}
+
+// replyKarmaRejected tells the voter why their vote did not count, like replyRejected does for reactions.
+func (bot *emojiReactionBot) replyKarmaRejected(m *telegram.Message, reason string) {
+	log.Printf("karma %v: %d: %s", m.ID, m.Sender.ID, reason)
+	reply, err := bot.Reply(m.ReplyTo, displayName(m.Sender)+": "+reason, telegram.Silent)
+	if err != nil {
+		log.Printf("karma %v: reply: %v", m.ID, err)
+		return
+	}
+	jsonOut.Encode(reply)
+}
+
+// handleKarma handles `/karma`: the karma of the replied-to message's author, or of the sender.
+func (bot *emojiReactionBot) handleKarma(m *telegram.Message) {
+	user := m.Sender
+	if m.IsReply() && m.ReplyTo.Sender != nil {
+		user = m.ReplyTo.Sender
+	}
+	if user == nil {
+		return
+	}
+	k := bot.KarmaRead(m.Chat.ID, user.ID)
+	bot.commandReply(m, fmt.Sprintf("%s has %d karma.", displayName(user), k.Total))
+}
+
+// handleKarmaTop handles `/karmatop`, which lists the users with the most karma in the chat.
+func (bot *emojiReactionBot) handleKarmaTop(m *telegram.Message) {
+	totals := make(map[string]int)
+	prefix := fmt.Sprintf("%x:", m.Chat.ID)
+	err := bot.Store.ForEach(bucketKarma, prefix, func(key string, value json.RawMessage) error {
+		var k karmaUser
+		if err := json.Unmarshal(value, &k); err != nil {
+			return fmt.Errorf("%s: %v", key, err)
+		}
+		totals[strings.TrimPrefix(key, prefix)] = k.Total
+		return nil
+	})
+	if err != nil {
+		log.Printf("karmatop %x: %v", m.Chat.ID, err)
+	}
+	top := topCounts(totals, karmaTopN)
+	if len(top) == 0 {
+		bot.commandReply(m, "Nobody has karma yet. Reply +1 or -1 to a message to give its author karma.")
+		return
+	}
+	lines := []string{"Most karma:"}
+	for i, c := range top {
+		userID, _ := strconv.ParseInt(c.key, 16, 64)
+		lines = append(lines, fmt.Sprintf("%d. %s %d", i+1, bot.UserName(int(userID)), c.n))
+	}
+	bot.commandReply(m, strings.Join(lines, "\n"))
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+)
+
+func TestKarmaVote(t *testing.T) {
+	tests := []struct {
+		text   string
+		want   int
+		wantOK bool
+	}{
+		{"+1", 1, true},
+		{"++", 1, true},
+		{"-1", -1, true},
+		{" -- ", -1, true},
+		{"+2", 0, false},
+		{"+", 0, false},
+		{"+1 thanks", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		if got, ok := karmaVote(tt.text); got != tt.want || ok != tt.wantOK {
+			t.Errorf("karmaVote(%q) = %d, %v; want %d, %v", tt.text, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestAddKarma(t *testing.T) {
+	bot, f := newFakeTelegramBot(t)
+	defer f.Close()
+	defer func(cooldown time.Duration) { config.KarmaCooldown = cooldown }(config.KarmaCooldown)
+	config.KarmaCooldown = time.Hour
+	chat := &telegram.Chat{ID: -100}
+	alice := &telegram.User{ID: 2, Username: "alice"}
+	bob := &telegram.User{ID: 3, Username: "bob"}
+	otherBot := &telegram.User{ID: 4, Username: "other_bot", IsBot: true}
+	vote := func(voter, author *telegram.User, text string) string {
+		delta, _ := karmaVote(text)
+		bot.addKarma(&telegram.Message{
+			ID:      20,
+			Chat:    chat,
+			Sender:  voter,
+			Text:    text,
+			ReplyTo: &telegram.Message{ID: 10, Chat: chat, Sender: author},
+		}, delta)
+		replies := f.callsTo("sendMessage")
+		return replies[len(replies)-1].Params["text"]
+	}
+	steps := []struct {
+		voter, author *telegram.User
+		text          string
+		wantReply     string
+		wantKarma     int
+	}{
+		{alice, alice, "+1", "@alice: you can't give yourself karma.", 0},
+		{alice, otherBot, "+1", "@alice: bots don't get karma.", 0},
+		{alice, bob, "+1", "@bob has 1 karma (+1 from @alice)", 1},
+		{alice, bob, "+1", "@alice: you can vote for @bob again in", 1},
+		{bob, alice, "-1", "@alice has -1 karma (-1 from @bob)", 1},
+	}
+	for i, step := range steps {
+		if reply := vote(step.voter, step.author, step.text); !strings.HasPrefix(reply, step.wantReply) {
+			t.Errorf("step %d: reply %q, want %q", i, reply, step.wantReply)
+		}
+		if got := bot.KarmaRead(chat.ID, bob.ID).Total; got != step.wantKarma {
+			t.Errorf("step %d: bob has %d karma, want %d", i, got, step.wantKarma)
+		}
+	}
+	if n := len(f.callsTo("deleteMessage")); n != len(steps) {
+		t.Errorf("deleted %d votes, want %d", n, len(steps))
+	}
+
+	// after the cooldown, alice may vote for bob again
+	voteKey := fmt.Sprintf("%x:%x:%x", chat.ID, alice.ID, bob.ID)
+	bot.storePut(bucketKarmaVote, voteKey, time.Now().Add(-config.KarmaCooldown-time.Second))
+	if reply, want := vote(alice, bob, "++"), "@bob has 2 karma (+1 from @alice)"; reply != want {
+		t.Errorf("vote after the cooldown: reply %q, want %q", reply, want)
+	}
+}
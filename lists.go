@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	emoji "github.com/sgreben/telegram-emoji-reactions-bot/internal/emoji"
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+	emojirx "github.com/sgreben/telegram-emoji-reactions-bot/pkg/reactions"
+)
+
+// allows reports whether the emoji may be used as a reaction in the chat.
+// Lists match skin tone and gender variants of the emoji they contain (see emoji.Base).
+func (s chatSettings) allows(e string) bool {
+	if len(s.Allow) > 0 && !listContains(s.Allow, e) {
+		return false
+	}
+	return !listContains(s.Block, e)
+}
+
+// filterAllowed returns the allowed emoji and the rejected ones.
+func (s chatSettings) filterAllowed(values []string) (allowed, rejected []string) {
+	for _, e := range values {
+		if s.allows(e) {
+			allowed = append(allowed, e)
+		} else {
+			rejected = append(rejected, e)
+		}
+	}
+	return allowed, rejected
+}
+
+// rejectedNotAllowed is the emojirx.Rejected reason for emoji the chat's lists do not allow.
+const rejectedNotAllowed = "not-allowed"
+
+// allowedOrReacted filters out the disallowed emoji, except those the user already reacted with, which they may remove.
+func allowedOrReacted(s chatSettings, reactions *emojirx.Set, userID int, values []string) (out []string, rejected []emojirx.Rejected) {
+	for _, e := range values {
+		if _, reacted := reactions.Variant(userID, e); reacted || s.allows(e) {
+			out = append(out, e)
+		} else {
+			rejected = append(rejected, emojirx.Rejected{Emoji: e, Reason: rejectedNotAllowed})
+		}
+	}
+	return out, rejected
+}
+
+// hasButton reports whether the set has a button for the emoji.
+func hasButton(reactions *emojirx.Set, e string) bool {
+	for _, r := range reactions.Slice {
+		if r.Emoji == e {
+			return true
+		}
+	}
+	return false
+}
+
+func listContains(list []string, e string) bool {
+	base := emoji.Base(e)
+	for _, x := range list {
+		if x == e || x == base {
+			return true
+		}
+	}
+	return false
+}
+
+// handleAllow handles `/allow [off|<emoji>...]`.
+func (bot *emojiReactionBot) handleAllow(m *telegram.Message) {
+	bot.handleList(m, "allow", func(c *chatConfig) *[]string { return &c.Allow })
+}
+
+// handleBlock handles `/block [off|<emoji>...]`.
+func (bot *emojiReactionBot) handleBlock(m *telegram.Message) {
+	bot.handleList(m, "block", func(c *chatConfig) *[]string { return &c.Block })
+}
+
+// handleList shows (no arguments), clears (`off`) or replaces (emoji arguments) one of the chat's emoji lists.
+func (bot *emojiReactionBot) handleList(m *telegram.Message, name string, list func(*chatConfig) *[]string) {
+	c := bot.ChatConfigRead(m.Chat.ID)
+	arg := strings.TrimSpace(m.Payload)
+	if arg != "" {
+		if !bot.isAdmin(m.Chat, m.Sender) {
+			bot.commandReply(m, "Only chat admins can change this.")
+			return
+		}
+		var values []string
+		if arg != "off" {
+			var rest string
+			values, rest = partitionEmoji(arg)
+			if len(values) == 0 || rest != "" {
+				bot.commandReply(m, fmt.Sprintf("Usage: /%s off|<emoji>...", name))
+				return
+			}
+		}
+		*list(&c) = uniqueStrings(values)
+		bot.ChatConfigWrite(m.Chat.ID, c)
+	}
+	values := *list(&c)
+	switch {
+	case len(values) == 0 && name == "allow":
+		bot.commandReply(m, "All emoji are allowed (except blocked ones).")
+	case len(values) == 0:
+		bot.commandReply(m, "No emoji are blocked.")
+	case name == "allow":
+		bot.commandReply(m, fmt.Sprintf("Only these emoji are allowed: %s", strings.Join(values, " ")))
+	default:
+		bot.commandReply(m, fmt.Sprintf("These emoji are blocked: %s", strings.Join(values, " ")))
+	}
+}
+
+func uniqueStrings(values []string) (out []string) {
+	seen := make(map[string]bool, len(values))
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func rejectedToast(rejected []string) string {
+	return fmt.Sprintf("%s is not allowed in this chat", strings.Join(rejected, ""))
+}
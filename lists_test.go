@@ -0,0 +1,58 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+	emojirx "github.com/sgreben/telegram-emoji-reactions-bot/pkg/reactions"
+)
+
+func TestAllowedOrReacted(t *testing.T) {
+	reactions := &emojirx.Set{To: &emojirx.To{}, Previous: &emojirx.Previous{}}
+	reactions.AddOrRemove(1, []string{"🖕"})
+	s := chatSettings{Block: []string{"🖕"}}
+	// a reaction added before the emoji was blocked can still be removed
+	if out, rejected := allowedOrReacted(s, reactions, 1, []string{"👍", "🖕"}); !reflect.DeepEqual(out, []string{"👍", "🖕"}) || len(rejected) > 0 {
+		t.Errorf("user 1: allowed %v, rejected %v; want both allowed", out, rejected)
+	}
+	out, rejected := allowedOrReacted(s, reactions, 2, []string{"👍", "🖕"})
+	if want := []emojirx.Rejected{{Emoji: "🖕", Reason: rejectedNotAllowed}}; !reflect.DeepEqual(out, []string{"👍"}) || !reflect.DeepEqual(rejected, want) {
+		t.Errorf("user 2: allowed %v, rejected %v; want [👍] and %v", out, rejected, want)
+	}
+}
+
+func TestReplyReactionNotAllowed(t *testing.T) {
+	bot, f := newFakeTelegramBot(t)
+	defer f.Close()
+	chat := &telegram.Chat{ID: -100, Type: telegram.ChatSuperGroup}
+	bot.ChatConfigWrite(chat.ID, chatConfig{Block: []string{"🖕"}})
+	to := &telegram.Message{ID: 10, Chat: chat, Sender: &telegram.User{ID: 3}}
+	for _, text := range []string{"🖕", "👍🖕"} {
+		bot.addReactionsMessageOrAddReactionOrIgnore(&telegram.Message{
+			ID:      11,
+			Chat:    chat,
+			Sender:  &telegram.User{ID: 2, FirstName: "Bob"},
+			ReplyTo: to,
+			Text:    text,
+		})
+	}
+	var replies []string
+	for _, c := range f.callsTo("sendMessage") {
+		if strings.Contains(c.Params["text"], "not allowed") {
+			replies = append(replies, c.Params["text"])
+		}
+	}
+	if len(replies) != 2 {
+		t.Fatalf("rejection replies = %q, want one per reaction", replies)
+	}
+	for _, text := range replies {
+		if !strings.Contains(text, "Bob") || !strings.Contains(text, "🖕 is not allowed") {
+			t.Errorf("rejection reply %q, want Bob's 🖕 to be not allowed", text)
+		}
+	}
+	if n := len(f.callsTo("deleteMessage")); n != 2 {
+		t.Errorf("deleted %d reactions, want 2", n)
+	}
+}
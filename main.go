@@ -11,6 +11,7 @@ import (
 	"time"
 
 	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+	emojirx "github.com/sgreben/telegram-emoji-reactions-bot/pkg/reactions"
 )
 
 var config struct {
@@ -19,12 +20,34 @@ var config struct {
 	ButtonRowLength    int
 	ButtonRowMinLength int
 	Verbose            bool
+	StoreFile          string
+	CacheMaxEntries    int
+	CacheMaxAge        time.Duration
+	ReplayLog          string
+	OverflowPolicy     emojirx.OverflowPolicy
+	WhoButton          bool
+	NotifyWindow       time.Duration
+	DigestWindow       time.Duration
+	ChannelMode        string
+	ChannelPalette     []string
+	InlinePalette      []string
+	KarmaCooldown      time.Duration
 }
 
 var name = "emoji-reactions-bot"
 var version = "dev"
 var jsonOut = json.NewEncoder(os.Stdout)
 
+// flagValues holds the flags that parseFlags parses into config.
+var flagValues struct {
+	OverflowPolicy string
+	ChannelPalette string
+	InlinePalette  string
+}
+
+// defaultPalette is the default set of emoji buttons for channel posts and inline messages.
+const defaultPalette = "👍 🔥 😂 😢 🎉"
+
 func init() {
 	log.SetOutput(os.Stderr)
 	log.SetFlags(0)
@@ -33,6 +56,14 @@ func init() {
 	config.Timeout = 2 * time.Second
 	config.ButtonRowLength = 5
 	config.ButtonRowMinLength = 2
+	config.CacheMaxEntries = 10000
+	config.CacheMaxAge = 30 * 24 * time.Hour
+	config.OverflowPolicy = emojirx.OverflowDropOldest
+	config.WhoButton = true
+	config.NotifyWindow = 30 * time.Second
+	config.DigestWindow = time.Hour
+	config.ChannelMode = channelModeOff
+	config.KarmaCooldown = time.Hour
 
 	flag.DurationVar(&config.Timeout, "timeout", config.Timeout, "")
 	flag.StringVar(&config.Token, "token", config.Token, "")
@@ -40,14 +71,45 @@ func init() {
 	flag.BoolVar(&config.Verbose, "verbose", config.Verbose, "")
 	flag.BoolVar(&config.Verbose, "v", config.Verbose, "(alas for -verbose)")
 	flag.IntVar(&config.ButtonRowMinLength, "button-row-min-length", config.ButtonRowMinLength, "")
+	flag.StringVar(&config.StoreFile, "store-file", config.StoreFile, "persist bot state to this file (default: in-memory only)")
+	flag.IntVar(&config.CacheMaxEntries, "cache-max-entries", config.CacheMaxEntries, "max. entries per message cache (0: unbounded)")
+	flag.DurationVar(&config.CacheMaxAge, "cache-max-age", config.CacheMaxAge, "evict message cache entries unused for this long (0: never)")
+	flag.StringVar(&config.ReplayLog, "replay-log", config.ReplayLog, "restore message caches from a -verbose JSON log at startup")
+	flag.StringVar(&flagValues.OverflowPolicy, "overflow-policy", string(config.OverflowPolicy), "what to do when reaction state exceeds the message length (oldest|fewest|spill)")
+	flag.BoolVar(&config.WhoButton, "who-button", config.WhoButton, "add a button that shows who reacted")
+	flag.DurationVar(&config.NotifyWindow, "notify-window", config.NotifyWindow, "send one notification for the reactions to a message within this window (0: one per reaction)")
+	flag.DurationVar(&config.DigestWindow, "digest-window", config.DigestWindow, "notification window for users who chose /notifications digest")
+	flag.StringVar(&config.ChannelMode, "channel-mode", config.ChannelMode, "attach a reaction keyboard to new posts in channels the bot is an admin of (edit|companion|off)")
+	flag.StringVar(&flagValues.ChannelPalette, "channel-palette", defaultPalette, "the emoji of the keyboard for channel posts")
+	flag.StringVar(&flagValues.InlinePalette, "inline-palette", defaultPalette, "the emoji of the keyboard for messages posted with inline queries")
+	flag.DurationVar(&config.KarmaCooldown, "karma-cooldown", config.KarmaCooldown, "min. time between karma votes of one user for another")
+}
+
+// parseFlags parses the command line into config. It is not part of init, so that tests can run with their own flags.
+func parseFlags() {
 	flag.Parse()
 
+	var err error
+	if config.OverflowPolicy, err = emojirx.ParseOverflowPolicy(flagValues.OverflowPolicy); err != nil {
+		log.Fatal(err)
+	}
+	if config.ChannelMode, err = parseChannelMode(config.ChannelMode); err != nil {
+		log.Fatal(err)
+	}
+	if config.ChannelPalette, err = parsePalette(flagValues.ChannelPalette); err != nil {
+		log.Fatal(err)
+	}
+	if config.InlinePalette, err = parsePalette(flagValues.InlinePalette); err != nil {
+		log.Fatal(err)
+	}
+
 	if !config.Verbose {
 		jsonOut = json.NewEncoder(ioutil.Discard)
 	}
 }
 
 func main() {
+	parseFlags()
 	botAPI, err := telegram.NewBot(telegram.Settings{
 		Token:  config.Token,
 		Poller: &telegram.LongPoller{Timeout: config.Timeout},
@@ -56,14 +118,31 @@ func main() {
 		log.Fatal(err)
 		return
 	}
+	memoryStore := newMemoryStore()
+	memoryStore.OnEvict = func(e storeEviction) { jsonOut.Encode(e) }
+	cacheLimit := storeLimit{MaxEntries: config.CacheMaxEntries, MaxAge: config.CacheMaxAge}
+	for _, bucket := range []string{bucketReactionMessageID, bucketMessage, bucketForwarded, bucketUser, bucketDigest, bucketKarmaVote} {
+		memoryStore.Limit(bucket, cacheLimit)
+	}
+	var store Store = memoryStore
+	if config.StoreFile != "" {
+		store, err = openFileStore(config.StoreFile, memoryStore)
+		if err != nil {
+			log.Fatal(err)
+			return
+		}
+	}
 	bot := &emojiReactionBot{
-		Bot: botAPI,
-		emojiReactionBotCaches: &emojiReactionBotCaches{
-			ReactionMessageIDFor: make(map[string]int),
-			MessageFor:           make(map[string]*telegram.Message),
-			ForwardedFor:         make(map[string]*telegram.Message),
-		},
+		Bot:   botAPI,
+		Store: store,
+	}
+	if config.ReplayLog != "" {
+		if err := bot.replayLog(config.ReplayLog); err != nil {
+			log.Fatal(err)
+			return
+		}
 	}
 	bot.init()
+	bot.schedulePollClosings()
 	bot.Start()
 }
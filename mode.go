@@ -0,0 +1,91 @@
+package main
+
+import (
+	"log"
+	"strings"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+	emojirx "github.com/sgreben/telegram-emoji-reactions-bot/pkg/reactions"
+)
+
+// reactionsMessageFor returns the bot's reactions message for m: m itself if the bot sent it,
+// or the reactions message replying to m.
+func (bot *emojiReactionBot) reactionsMessageFor(m *telegram.Message) (*telegram.Message, bool) {
+	if m.Sender != nil && m.Sender.ID == bot.Me.ID {
+		if stored, ok := bot.MessageForRead(m.Chat.ID, m.ID); ok {
+			return stored, true
+		}
+		return m, len(m.ReplyMarkup.InlineKeyboard) > 0
+	}
+	if id, ok := bot.ReactionMessageIDForRead(m.Chat.ID, m.ID); ok {
+		return bot.MessageForRead(m.Chat.ID, id)
+	}
+	return nil, false
+}
+
+// handleExclusive handles `/exclusive [on|off]`. As a reply, it switches the replied-to reactions
+// message (or the reactions message for the replied-to message) to one reaction per user;
+// otherwise it sets the chat's default for new reactions messages.
+func (bot *emojiReactionBot) handleExclusive(m *telegram.Message) {
+	arg := strings.TrimSpace(m.Payload)
+	if arg != "" && arg != "on" && arg != "off" {
+		bot.commandReply(m, "Usage: /exclusive [on|off]")
+		return
+	}
+	if !m.IsReply() {
+		c := bot.ChatConfigRead(m.Chat.ID)
+		if arg != "" {
+			if !bot.isAdmin(m.Chat, m.Sender) {
+				bot.commandReply(m, "Only chat admins can change this.")
+				return
+			}
+			c.Exclusive = arg == "on"
+			bot.ChatConfigWrite(m.Chat.ID, c)
+		}
+		if c.Exclusive {
+			bot.commandReply(m, "New reactions messages allow one reaction per user. Reply with /exclusive off to a reactions message to change it for that message.")
+		} else {
+			bot.commandReply(m, "New reactions messages allow any number of reactions per user. Reply with /exclusive on to a reactions message to change it for that message.")
+		}
+		return
+	}
+	reactionsMessage, ok := bot.reactionsMessageFor(m.ReplyTo)
+	if !ok {
+		bot.commandReply(m, "There are no reactions on this message yet.")
+		return
+	}
+	reactions := bot.newReactionSet(bot.ChatSettings(m.Chat.ID))
+	if err := reactions.ParseMessage(reactionsMessage); err != nil {
+		log.Printf("exclusive %v: %v", m.ID, err)
+	}
+	if m.Sender == nil || (m.Sender.ID != reactions.To.UserID && !bot.isAdmin(m.Chat, m.Sender)) {
+		bot.commandReply(m, "Only the message's author and chat admins can change this.")
+		return
+	}
+	exclusive := reactions.Mode&emojirx.ModeExclusive == 0
+	if arg != "" {
+		exclusive = arg == "on"
+	}
+	if exclusive {
+		reactions.Mode |= emojirx.ModeExclusive
+	} else {
+		reactions.Mode &^= emojirx.ModeExclusive
+	}
+	jsonOut.Encode(reactions)
+	edited, err := bot.editReactionsMessage(reactionsMessage, reactions)
+	if err == errPollClosed {
+		bot.commandReply(m, "Sorry, this poll is closed.")
+		return
+	}
+	if err != nil {
+		log.Printf("exclusive %v: edit: %v", m.ID, err)
+		bot.commandReply(m, "Sorry, the reactions message could not be changed.")
+		return
+	}
+	jsonOut.Encode(edited)
+	if exclusive {
+		bot.commandReply(m, "One reaction per user on this message.")
+	} else {
+		bot.commandReply(m, "Any number of reactions per user on this message.")
+	}
+}
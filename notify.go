@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+)
+
+const bucketNotify = "notify"
+
+// Notification preferences.
+const (
+	notifyOn     = "on"
+	notifyOff    = "off"
+	notifyDigest = "digest"
+)
+
+// maxNotifyFailures is the number of failed notifications in a row after which the bot stops
+// notifying a user, until they send /start or /notifications again.
+const maxNotifyFailures = 3
+
+// notifyUser is a user's registration for reaction notifications.
+// Users who never sent /start to the bot are not registered, and are not notified.
+type notifyUser struct {
+	Mode        string    `json:"m"`
+	Failures    int       `json:"f,omitempty"`
+	LastError   string    `json:"e,omitempty"`
+	LastFailure time.Time `json:"t,omitempty"`
+}
+
+func (bot *emojiReactionBot) NotifyUserRead(userID int) (notifyUser, bool) {
+	var u notifyUser
+	ok := bot.storeGet(bucketNotify, fmt.Sprintf("%x", userID), &u)
+	return u, ok
+}
+
+func (bot *emojiReactionBot) NotifyUserWrite(userID int, u notifyUser) {
+	bot.storePut(bucketNotify, fmt.Sprintf("%x", userID), u)
+}
+
+// notifyMode returns the user's notification preference, or notifyOff if they are not
+// registered or notifications to them keep failing.
+func (bot *emojiReactionBot) notifyMode(userID int) string {
+	u, ok := bot.NotifyUserRead(userID)
+	if !ok || u.Failures >= maxNotifyFailures {
+		return notifyOff
+	}
+	return u.Mode
+}
+
+func (bot *emojiReactionBot) notificationFailed(userID int, err error) {
+	u, ok := bot.NotifyUserRead(userID)
+	if !ok {
+		return
+	}
+	u.Failures++
+	u.LastError = err.Error()
+	u.LastFailure = time.Now()
+	if u.Failures == maxNotifyFailures {
+		log.Printf("notify: %d failures in a row for user %d, pausing notifications", u.Failures, userID)
+	}
+	bot.NotifyUserWrite(userID, u)
+}
+
+func (bot *emojiReactionBot) notificationSent(userID int) {
+	u, ok := bot.NotifyUserRead(userID)
+	if !ok || u.Failures == 0 {
+		return
+	}
+	u.Failures = 0
+	bot.NotifyUserWrite(userID, u)
+}
+
+// handleStart handles `/start` in a private chat, which registers the user for notifications.
+func (bot *emojiReactionBot) handleStart(m *telegram.Message) {
+	if !m.Private() || m.Sender == nil {
+		return
+	}
+	u, ok := bot.NotifyUserRead(m.Sender.ID)
+	if !ok {
+		u.Mode = notifyOn
+	}
+	u.Failures = 0
+	bot.NotifyUserWrite(m.Sender.ID, u)
+	bot.commandReply(m, "Hi! I'll let you know here when someone reacts to your messages.\n\n"+notifyModeText(u.Mode)+"\n\n"+notifyUsage)
+}
+
+const notifyUsage = "Change this with /notifications on|off|digest."
+
+func notifyModeText(mode string) string {
+	switch mode {
+	case notifyOff:
+		return "Reaction notifications are off."
+	case notifyDigest:
+		return "You get reaction notifications as a digest."
+	}
+	return "You get a notification for each reaction."
+}
+
+// handleNotifications handles `/notifications [on|off|digest]` in a private chat.
+func (bot *emojiReactionBot) handleNotifications(m *telegram.Message) {
+	if m.Sender == nil {
+		return
+	}
+	if !m.Private() {
+		bot.commandReply(m, fmt.Sprintf("Send me /start in a private chat (@%s) to set up notifications.", bot.Me.Username))
+		return
+	}
+	u, _ := bot.NotifyUserRead(m.Sender.ID)
+	switch arg := strings.TrimSpace(m.Payload); arg {
+	case "":
+		if u.Mode == "" {
+			u.Mode = notifyOff
+		}
+	case notifyOn, notifyOff, notifyDigest:
+		u.Mode = arg
+		u.Failures = 0
+		bot.NotifyUserWrite(m.Sender.ID, u)
+	default:
+		bot.commandReply(m, notifyUsage)
+		return
+	}
+	bot.commandReply(m, notifyModeText(u.Mode)+" "+notifyUsage)
+}
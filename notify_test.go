@@ -0,0 +1,74 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+)
+
+func TestNotificationFailures(t *testing.T) {
+	bot, f := newFakeTelegramBot(t)
+	defer f.Close()
+	defer func(window time.Duration) { config.NotifyWindow = window }(config.NotifyWindow)
+	config.NotifyWindow = time.Hour
+	const chatID, messageID, recipientID = -100, 10, 5
+	bot.NotifyUserWrite(recipientID, notifyUser{Mode: notifyOn})
+	reactor := &telegram.User{ID: 2}
+	// notify queues a reaction and sends the notification, as the end of the notification window would.
+	notify := func() bool {
+		bot.notifyOfReaction("👍", reactor, messageID, chatID, recipientID)
+		key := digestKey(recipientID, chatID, messageID)
+		bot.digests.mu.Lock()
+		_, queued := bot.digests.pending[key]
+		bot.digests.mu.Unlock()
+		bot.flushDigest(key, chatID, messageID, recipientID)
+		bot.storeDelete(bucketDigest, key)
+		return queued
+	}
+	failures := func() int {
+		u, _ := bot.NotifyUserRead(recipientID)
+		return u.Failures
+	}
+
+	blocked := func(on bool) {
+		for _, method := range []string{"forwardMessage", "sendMessage"} {
+			if on {
+				f.fail[method] = "Forbidden: bot was blocked by the user"
+			} else {
+				delete(f.fail, method)
+			}
+		}
+	}
+	blocked(true)
+	for i := 1; i < maxNotifyFailures; i++ {
+		notify()
+		if got := failures(); got != i {
+			t.Fatalf("after %d failed notifications, %d failures are counted", i, got)
+		}
+	}
+	// a notification that is sent starts the count over
+	blocked(false)
+	notify()
+	if got := failures(); got != 0 {
+		t.Fatalf("after a sent notification, %d failures are counted, want 0", got)
+	}
+
+	blocked(true)
+	for i := 0; i < maxNotifyFailures; i++ {
+		notify()
+	}
+	if mode := bot.notifyMode(recipientID); mode != notifyOff {
+		t.Errorf("after %d failed notifications in a row, notify mode is %q, want %q", maxNotifyFailures, mode, notifyOff)
+	}
+	if u, _ := bot.NotifyUserRead(recipientID); u.LastError == "" || u.LastFailure.IsZero() {
+		t.Errorf("last failure not recorded: %+v", u)
+	}
+	sent := len(f.callsTo("sendMessage"))
+	if notify() {
+		t.Error("queued a notification for a user whose notifications are paused")
+	}
+	if got := len(f.callsTo("sendMessage")); got != sent {
+		t.Error("sent a notification to a user whose notifications are paused")
+	}
+}
@@ -0,0 +1,228 @@
+package reactions
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// The state link points to a fake URL that carries a Set's hidden state in its query.
+const (
+	stateHost = "example.com"
+	stateURL  = "http://" + stateHost
+)
+
+// Codec encodes a Set's hidden state as link query parameters, and decodes it back.
+//
+// Every format that was ever written to a chat must stay decodable, so a changed
+// format gets a new Codec with a new version; old codecs are never modified.
+type Codec interface {
+	Version() int
+	Encode(e *Set) url.Values
+	Decode(query url.Values, e *Set) error
+}
+
+const versionParam = "v"
+
+var codecs = map[int]Codec{}
+
+// LatestCodec is the codec used to encode state.
+var LatestCodec Codec = codecV6{}
+
+// RegisterCodec makes a codec available for decoding.
+func RegisterCodec(c Codec) {
+	codecs[c.Version()] = c
+}
+
+func init() {
+	RegisterCodec(codecV0{})
+	RegisterCodec(codecV1{})
+	RegisterCodec(codecV2{})
+	RegisterCodec(codecV3{})
+	RegisterCodec(codecV4{})
+	RegisterCodec(codecV5{})
+	RegisterCodec(codecV6{})
+}
+
+// codecFor returns the codec that wrote the given query.
+// Versions before 2 carried no version tag and are recognised by their parameters.
+func codecFor(query url.Values) (Codec, error) {
+	version := 1
+	switch {
+	case query.Get(versionParam) != "":
+		v, err := strconv.Atoi(query.Get(versionParam))
+		if err != nil {
+			return nil, fmt.Errorf("parse version: %v", err)
+		}
+		version = v
+	case query.Get("data") != "":
+		version = 0
+	}
+	codec, ok := codecs[version]
+	if !ok {
+		return nil, fmt.Errorf("unknown state version %d", version)
+	}
+	return codec, nil
+}
+
+// codecV0 is the legacy format: `data=<to>` with an optional `p=<previous>`.
+type codecV0 struct{}
+
+func (codecV0) Version() int { return 0 }
+
+func (codecV0) Encode(e *Set) url.Values {
+	return url.Values{
+		"data": {e.To.encode()},
+		"p":    {e.Previous.encode()},
+	}
+}
+
+func (codecV0) Decode(query url.Values, e *Set) error {
+	if err := e.To.Parse([]byte(query.Get("data"))); err != nil {
+		return err
+	}
+	if query.Get("p") == "" {
+		return nil
+	}
+	return e.Previous.Parse([]byte(query.Get("p")))
+}
+
+// codecV1 is `t=<to>&p=<previous>`, where <to> is JSON with hex IDs and <previous> is gzipped JSON.
+type codecV1 struct{}
+
+func (codecV1) Version() int { return 1 }
+
+func (codecV1) Encode(e *Set) url.Values {
+	return url.Values{
+		"t": {e.To.encode()},
+		"p": {e.Previous.encode()},
+	}
+}
+
+func (codecV1) Decode(query url.Values, e *Set) error {
+	if err := e.To.Parse([]byte(query.Get("t"))); err != nil {
+		return err
+	}
+	return e.Previous.Parse([]byte(query.Get("p")))
+}
+
+// codecV2 is codecV1 with an explicit version tag.
+type codecV2 struct{}
+
+func (codecV2) Version() int { return 2 }
+
+func (codecV2) Encode(e *Set) url.Values {
+	query := codecV1{}.Encode(e)
+	query.Set(versionParam, "2")
+	return query
+}
+
+func (codecV2) Decode(query url.Values, e *Set) error {
+	return codecV1{}.Decode(query, e)
+}
+
+// codecV3 is codecV2 with <previous> in a compact binary form (see Previous.encodeBinary), base64url-encoded.
+type codecV3 struct{}
+
+func (codecV3) Version() int { return 3 }
+
+func (codecV3) Encode(e *Set) url.Values {
+	return url.Values{
+		versionParam: {"3"},
+		"t":          {e.To.encode()},
+		"p":          {base64.RawURLEncoding.EncodeToString(e.Previous.encodeBinary(e.Slice))},
+	}
+}
+
+func (codecV3) Decode(query url.Values, e *Set) error {
+	if err := e.To.Parse([]byte(query.Get("t"))); err != nil {
+		return err
+	}
+	data, err := base64.RawURLEncoding.DecodeString(query.Get("p"))
+	if err != nil {
+		return err
+	}
+	return e.Previous.parseBinary(data, e.Slice)
+}
+
+// codecV4 is codecV3 with the Set's Mode as `m=<mode>` (base 36), omitted if zero.
+type codecV4 struct{}
+
+func (codecV4) Version() int { return 4 }
+
+func (codecV4) Encode(e *Set) url.Values {
+	query := codecV3{}.Encode(e)
+	query.Set(versionParam, "4")
+	if e.Mode != 0 {
+		query.Set("m", strconv.FormatUint(uint64(e.Mode), 36))
+	}
+	return query
+}
+
+func (codecV4) Decode(query url.Values, e *Set) error {
+	if m := query.Get("m"); m != "" {
+		mode, err := strconv.ParseUint(m, 36, 32)
+		if err != nil {
+			return fmt.Errorf("parse mode: %v", err)
+		}
+		e.Mode = Mode(mode)
+	}
+	return codecV3{}.Decode(query, e)
+}
+
+// codecV5 is codecV4 with the Set's Title as `q=<title>`, omitted if empty.
+type codecV5 struct{}
+
+func (codecV5) Version() int { return 5 }
+
+func (codecV5) Encode(e *Set) url.Values {
+	query := codecV4{}.Encode(e)
+	query.Set(versionParam, "5")
+	if e.Title != "" {
+		query.Set("q", e.Title)
+	}
+	return query
+}
+
+func (codecV5) Decode(query url.Values, e *Set) error {
+	e.Title = query.Get("q")
+	return codecV4{}.Decode(query, e)
+}
+
+// codecV6 is codecV5 where state that did not fit into the message is stored in the Spill
+// and referenced by `r=<ref>` instead of `p`.
+type codecV6 struct{}
+
+func (codecV6) Version() int { return 6 }
+
+func (codecV6) Encode(e *Set) url.Values {
+	query := codecV5{}.Encode(e)
+	query.Set(versionParam, "6")
+	if e.ref != "" {
+		query.Del("p")
+		query.Set("r", e.ref)
+	}
+	return query
+}
+
+func (codecV6) Decode(query url.Values, e *Set) error {
+	ref := query.Get("r")
+	if ref == "" {
+		return codecV5{}.Decode(query, e)
+	}
+	if e.Config.Spill == nil {
+		return fmt.Errorf("spilled state %s: no spill store configured", ref)
+	}
+	data, err := e.Config.Spill.Get(ref)
+	if err != nil {
+		return fmt.Errorf("spilled state %s: %v", ref, err)
+	}
+	unspilled := url.Values{}
+	for k, v := range query {
+		unspilled[k] = v
+	}
+	unspilled.Del("r")
+	unspilled.Set("p", base64.RawURLEncoding.EncodeToString(data))
+	return codecV5{}.Decode(unspilled, e)
+}
@@ -0,0 +1,165 @@
+package reactions
+
+import (
+	"fmt"
+	"math/rand"
+	"net/url"
+	"reflect"
+	"sort"
+	"testing"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+)
+
+var benchmarkEmoji = []string{"👍", "👎", "😂", "❤️", "🔥", "🎉", "👩‍👩‍👧‍👦", "🏳️‍🌈"}
+
+// benchmarkSet returns a set with n distinct reactors with realistic (9-10 digit) user IDs,
+// each of which reacted with one to three emoji.
+func benchmarkSet(n int) *Set {
+	rng := rand.New(rand.NewSource(1))
+	e := &Set{
+		To:       &To{UserID: 123456789, ChatID: -1001234567890, ID: 12345},
+		Previous: &Previous{},
+	}
+	for i := 0; i < n; i++ {
+		userID := 100000000 + rng.Intn(1900000000)
+		var emoji []string
+		for _, j := range rng.Perm(len(benchmarkEmoji))[:1+rng.Intn(3)] {
+			emoji = append(emoji, benchmarkEmoji[j])
+		}
+		e.AddOrRemove(userID, emoji)
+	}
+	return e
+}
+
+func benchmarkCodecs() (out []Codec) {
+	for _, codec := range codecs {
+		out = append(out, codec)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Version() < out[j].Version() })
+	return out
+}
+
+// BenchmarkCodecCapacity reports how many distinct reactors fit into one message per codec.
+func BenchmarkCodecCapacity(b *testing.B) {
+	for _, codec := range benchmarkCodecs() {
+		codec := codec
+		b.Run(fmt.Sprintf("v%d", codec.Version()), func(b *testing.B) {
+			var capacity int
+			for i := 0; i < b.N; i++ {
+				capacity = sort.Search(maxMessageLength, func(n int) bool {
+					return len(benchmarkSet(n+1).stateLink(codec)) > maxMessageLength
+				})
+			}
+			b.ReportMetric(float64(capacity), "reactors/msg")
+		})
+	}
+}
+
+func BenchmarkCodecEncode(b *testing.B) {
+	for _, codec := range benchmarkCodecs() {
+		codec := codec
+		b.Run(fmt.Sprintf("v%d", codec.Version()), func(b *testing.B) {
+			e := benchmarkSet(100)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				codec.Encode(e).Encode()
+			}
+		})
+	}
+}
+
+func BenchmarkCodecDecode(b *testing.B) {
+	for _, codec := range benchmarkCodecs() {
+		codec := codec
+		b.Run(fmt.Sprintf("v%d", codec.Version()), func(b *testing.B) {
+			e := benchmarkSet(100)
+			query := codec.Encode(e)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				out := &Set{Slice: e.Slice, To: &To{}, Previous: &Previous{}}
+				if err := codec.Decode(query, out); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// legacyTo and legacyCount are the state of the legacy links in TestCodecLegacyLinks,
+// whose parameters are as written by the versions before codecs (see codecV0 and codecV1).
+var (
+	legacyTo    = To{UserID: 123456789, ChatID: -1001234567890, ID: 4321}
+	legacyCount = map[string]map[string]int{
+		"1f": {"👍": 1, "🔥": 1},
+		"2a": {"👍": 1},
+	}
+	legacyToParam       = "%7B%22H%22%3A%2275bcd15%22%2C%22I%22%3A%2210e1%22%2C%22C%22%3A%22-e91e3b12d2%22%7D"
+	legacyPreviousParam = "%1F%8B%08%00%00%00%00%00%00%FF%00%2A%00%D5%FF%7B%221f%22%3A%7B%22%F0%9F%91%8D%22%3A1%2C%22%F0%9F%94%A5%22%3A1%7D%2C%222a%22%3A%7B%22%F0%9F%91%8D%22%3A1%7D%7D%03%00a%CA%B7%1F%2A%00%00%00"
+)
+
+func TestCodecLegacyLinks(t *testing.T) {
+	tests := []struct {
+		name      string
+		url       string
+		wantCount map[string]map[string]int
+	}{
+		{"data", "http://example.com?data=" + legacyToParam, nil},
+		{"data with p", "http://example.com?data=" + legacyToParam + "&p=" + legacyPreviousParam, legacyCount},
+		{"t", "http://example.com?t=" + legacyToParam + "&p=" + legacyPreviousParam, legacyCount},
+		{"v=2", "http://example.com?p=" + legacyPreviousParam + "&t=" + legacyToParam + "&v=2", legacyCount},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &Set{}
+			m := &telegram.Message{Entities: []telegram.MessageEntity{{Type: telegram.EntityTextLink, URL: tt.url}}}
+			if err := e.ParseMessage(m); err != nil {
+				t.Fatal(err)
+			}
+			if *e.To != legacyTo {
+				t.Errorf("To = %+v, want %+v", *e.To, legacyTo)
+			}
+			if len(e.Previous.Count)+len(tt.wantCount) > 0 && !reflect.DeepEqual(e.Previous.Count, tt.wantCount) {
+				t.Errorf("Previous.Count = %v, want %v", e.Previous.Count, tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestCodecRoundTrip(t *testing.T) {
+	e := &Set{
+		To:       &To{UserID: 123456789, ChatID: -1001234567890, ID: 4321},
+		Previous: &Previous{},
+		Mode:     ModeKeep | ModeFixed,
+		Title:    "Where to lunch? 🍕 & 🍣",
+	}
+	e.AddButtons([]string{"🍕", "🍣", "🌮"})
+	e.AddOrRemove(0x1f, []string{"🍕", "🌮"})
+	e.AddOrRemove(0x2a, []string{"🍣"})
+	e.AddOrRemove(0x3b, []string{"🍕"})
+
+	query, err := url.ParseQuery(LatestCodec.Encode(e).Encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	codec, err := codecFor(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if codec.Version() != LatestCodec.Version() {
+		t.Errorf("decoding with v%d, want v%d", codec.Version(), LatestCodec.Version())
+	}
+	out := &Set{Slice: e.Slice, To: &To{}, Previous: &Previous{}}
+	if err := codec.Decode(query, out); err != nil {
+		t.Fatal(err)
+	}
+	if *out.To != *e.To {
+		t.Errorf("To = %+v, want %+v", *out.To, *e.To)
+	}
+	if !reflect.DeepEqual(out.Previous.Count, e.Previous.Count) {
+		t.Errorf("Previous.Count = %v, want %v", out.Previous.Count, e.Previous.Count)
+	}
+	if out.Mode != e.Mode || out.Title != e.Title {
+		t.Errorf("Mode, Title = %v, %q; want %v, %q", out.Mode, out.Title, e.Mode, e.Title)
+	}
+}
@@ -0,0 +1,97 @@
+package reactions
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// OverflowPolicy decides what happens to a Set's state when it no longer fits into a message.
+type OverflowPolicy string
+
+const (
+	// OverflowDropOldest forgets the users who reacted first until the state fits.
+	OverflowDropOldest OverflowPolicy = "oldest"
+	// OverflowDropFewest forgets the users with the fewest reactions (oldest first among equals) until the state fits.
+	OverflowDropFewest OverflowPolicy = "fewest"
+	// OverflowSpill moves the state to Config.Spill and links to it by reference.
+	// If that fails, it falls back to OverflowDropOldest.
+	OverflowSpill OverflowPolicy = "spill"
+)
+
+// Spill stores state that does not fit into a message.
+type Spill interface {
+	Put(ref string, data []byte) error
+	Get(ref string) ([]byte, error)
+}
+
+// Overflow describes state that was shed (or spilled) to make a Set fit into a message.
+type Overflow struct {
+	Policy  OverflowPolicy
+	To      *To
+	UserIDs []int  `json:",omitempty"`
+	Ref     string `json:",omitempty"`
+	Err     string `json:",omitempty"`
+}
+
+// ParseOverflowPolicy parses an OverflowPolicy name.
+func ParseOverflowPolicy(s string) (OverflowPolicy, error) {
+	switch p := OverflowPolicy(s); p {
+	case OverflowDropOldest, OverflowDropFewest, OverflowSpill:
+		return p, nil
+	}
+	return "", fmt.Errorf("unknown overflow policy %q", s)
+}
+
+func (e *Set) spillRef() string {
+	return fmt.Sprintf("%x:%x", e.To.ChatID, e.To.ID)
+}
+
+// spill moves the state to Config.Spill, returning the link text referencing it.
+func (e *Set) spill(codec Codec) (string, error) {
+	if e.Config.Spill == nil {
+		return "", fmt.Errorf("no spill store configured")
+	}
+	ref := e.spillRef()
+	if err := e.Config.Spill.Put(ref, e.Previous.encodeBinary(e.Slice)); err != nil {
+		return "", err
+	}
+	e.ref = ref
+	return e.text(codec), nil
+}
+
+// shed drops users from the state until its link text fits, returning the dropped users.
+func (e *Set) shed(codec Codec, policy OverflowPolicy) (string, []int) {
+	var dropped []int
+	text := e.text(codec)
+	for len(text) > maxMessageLength && len(e.Previous.Count) > 0 {
+		userIDHex := e.Previous.shedCandidate(policy)
+		e.Previous.removeUser(userIDHex)
+		if userID, err := strconv.ParseInt(userIDHex, 16, 64); err == nil {
+			dropped = append(dropped, int(userID))
+		}
+		text = e.text(codec)
+	}
+	return text, dropped
+}
+
+func (e *Previous) shedCandidate(policy OverflowPolicy) string {
+	users := e.users()
+	if policy != OverflowDropFewest {
+		return users[0]
+	}
+	total := func(userIDHex string) (n int) {
+		for _, c := range e.Count[userIDHex] {
+			if c > 0 {
+				n += c
+			}
+		}
+		return n
+	}
+	fewest, fewestTotal := users[0], total(users[0])
+	for _, u := range users[1:] {
+		if n := total(u); n < fewestTotal {
+			fewest, fewestTotal = u, n
+		}
+	}
+	return fewest
+}
@@ -0,0 +1,152 @@
+package reactions
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestShedCandidate(t *testing.T) {
+	e := &Previous{
+		Count: map[string]map[string]int{
+			"a": {"👍": 2, "🔥": 1},
+			"b": {"👍": 1},
+			"c": {"🔥": 1},
+			"d": {"😂": 1},
+			"e": {"😂": 2},
+		},
+		// d and e are missing from Order, so they come last, in ascending order.
+		Order: []string{"a", "c", "b"},
+	}
+	tests := []struct {
+		policy OverflowPolicy
+		want   []string
+	}{
+		{OverflowDropOldest, []string{"a", "c", "b", "d", "e"}},
+		{OverflowDropFewest, []string{"c", "b", "d", "e", "a"}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				p := &Previous{Count: map[string]map[string]int{}, Order: append([]string(nil), e.Order...)}
+				for u, c := range e.Count {
+					p.Count[u] = c
+				}
+				var got []string
+				for len(p.Count) > 0 {
+					u := p.shedCandidate(tt.policy)
+					got = append(got, u)
+					p.removeUser(u)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Fatalf("shed order = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+// overflowingSet returns a set whose state does not fit into a message.
+func overflowingSet(policy OverflowPolicy, spill Spill) (*Set, *Overflow) {
+	e := benchmarkSet(600)
+	e.Config.OverflowPolicy = policy
+	e.Config.Spill = spill
+	var overflow Overflow
+	e.Config.OnOverflow = func(o Overflow) { overflow = o }
+	return e, &overflow
+}
+
+func TestShedDeterministic(t *testing.T) {
+	for _, policy := range []OverflowPolicy{OverflowDropOldest, OverflowDropFewest} {
+		t.Run(string(policy), func(t *testing.T) {
+			e, overflow := overflowingSet(policy, nil)
+			users := e.Previous.users()
+			text := e.MessageText()
+			if len(text) > maxMessageLength {
+				t.Fatalf("text has %d bytes, want at most %d", len(text), maxMessageLength)
+			}
+			if len(overflow.UserIDs) == 0 {
+				t.Fatal("no users were shed")
+			}
+			if policy == OverflowDropOldest {
+				for i, userID := range overflow.UserIDs {
+					if want := users[i]; fmt.Sprintf("%x", userID) != want {
+						t.Fatalf("shed user %d is %x, want %s", i, userID, want)
+					}
+				}
+			}
+			for i := 0; i < 2; i++ {
+				again, againOverflow := overflowingSet(policy, nil)
+				if againText := again.MessageText(); againText != text {
+					t.Fatal("shedding the same state twice gave different texts")
+				}
+				if !reflect.DeepEqual(againOverflow.UserIDs, overflow.UserIDs) {
+					t.Fatal("shedding the same state twice dropped different users")
+				}
+			}
+		})
+	}
+}
+
+type mapSpill map[string][]byte
+
+func (s mapSpill) Put(ref string, data []byte) error {
+	s[ref] = data
+	return nil
+}
+
+func (s mapSpill) Get(ref string) ([]byte, error) {
+	data, ok := s[ref]
+	if !ok {
+		return nil, fmt.Errorf("not found")
+	}
+	return data, nil
+}
+
+func TestSpill(t *testing.T) {
+	spill := mapSpill{}
+	e, overflow := overflowingSet(OverflowSpill, spill)
+	text := e.MessageText()
+	if overflow.Ref == "" || len(overflow.UserIDs) > 0 {
+		t.Fatalf("overflow = %+v, want a spill reference and no shed users", overflow)
+	}
+	link := text[strings.Index(text, `href="`)+len(`href="`):]
+	link = link[:strings.Index(link, `"`)]
+	u, err := url.Parse(link)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := u.Query()
+	if query.Get("r") != overflow.Ref || query.Get("p") != "" {
+		t.Errorf("spilled state link %s, want r=%s and no p", link, overflow.Ref)
+	}
+	out := &Set{Slice: e.Slice, To: &To{}, Previous: &Previous{}}
+	out.Config.Spill = spill
+	codec, err := codecFor(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := codec.Decode(query, out); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(out.Previous.Count, e.Previous.Count) {
+		t.Error("spilled state decodes to a different state")
+	}
+}
+
+func TestSpillFallsBackToOldest(t *testing.T) {
+	e, overflow := overflowingSet(OverflowSpill, nil)
+	text := e.MessageText()
+	if overflow.Err == "" || overflow.Ref != "" {
+		t.Errorf("overflow = %+v, want an error and no spill reference", overflow)
+	}
+	oldest, oldestOverflow := overflowingSet(OverflowDropOldest, nil)
+	if oldestText := oldest.MessageText(); text != oldestText {
+		t.Error("text differs from OverflowDropOldest")
+	}
+	if !reflect.DeepEqual(overflow.UserIDs, oldestOverflow.UserIDs) {
+		t.Errorf("shed users %v, want %v as for OverflowDropOldest", overflow.UserIDs, oldestOverflow.UserIDs)
+	}
+}
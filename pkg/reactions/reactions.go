@@ -4,13 +4,19 @@ import (
 	"bytes"
 	"compress/gzip"
 	"crypto/md5"
+	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"html"
+	"io"
 	"io/ioutil"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 
+	emoji "github.com/sgreben/telegram-emoji-reactions-bot/internal/emoji"
 	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
 )
 
@@ -19,30 +25,126 @@ var spaceString = string([]byte{0xc2, 0xad, 0xc2, 0xad})
 type Single struct {
 	Emoji string `json:"E"`
 	Count int64  `json:"C"`
+	// position is set instead of Emoji for button data that refers to the button's position.
+	position int
+}
+
+// MaxCallbackDataLength is Telegram's limit for the callback data of a button, in bytes.
+const MaxCallbackDataLength = 64
+
+// Button data payloads, after the `\f<unique>|` prefix. Counts and positions are base 36.
+const (
+	payloadEmojiID  = 'e' // e<count>.<emoji.ID>
+	payloadRaw      = 'r' // r<count>.<emoji>
+	payloadPosition = 'p' // p<count>.<position>, the emoji is the button's label
+)
+
+// errUnknownEmojiID is returned for an emoji ID that is not (or no longer) known, see emoji.ID.
+// The buttons of a message still show their emoji, so a set reads those from the label instead.
+var errUnknownEmojiID = errors.New("unknown emoji id")
+
+// buttonPayload returns the button data after the `\f<unique>|` prefix.
+func buttonPayload(data string) string {
+	return data[strings.IndexRune(data, '|')+1:]
 }
 
 func (e *Single) ParseButtonData(data string) error {
-	i := strings.IndexRune(data, '|')
-	dataBytes := []byte(data[i+1:])
-	err := json.Unmarshal(dataBytes, e)
+	data = buttonPayload(data)
+	if err := e.parsePayload(data); err != nil {
+		return fmt.Errorf("parse data %s: %v", data, err)
+	}
+	return nil
+}
+
+func (e *Single) parsePayload(data string) error {
+	if strings.HasPrefix(data, "{") {
+		return json.Unmarshal([]byte(data), e)
+	}
+	if data == "" {
+		return fmt.Errorf("empty")
+	}
+	i := strings.IndexRune(data, '.')
+	switch {
+	case i < 0:
+		return fmt.Errorf("missing '.'")
+	case i < 1:
+		return fmt.Errorf("missing payload type")
+	}
+	count, err := strconv.ParseInt(data[1:i], 36, 64)
 	if err != nil {
-		return fmt.Errorf("parse data %s: %v", dataBytes, err)
+		return err
+	}
+	e.Count = count
+	e.position = -1
+	arg := data[i+1:]
+	switch data[0] {
+	case payloadEmojiID:
+		r, ok := emoji.ByID(arg)
+		if !ok {
+			e.Emoji = ""
+			return errUnknownEmojiID
+		}
+		e.Emoji = r.Value
+	case payloadRaw:
+		e.Emoji = arg
+	case payloadPosition:
+		position, err := strconv.ParseInt(arg, 36, 0)
+		if err != nil {
+			return err
+		}
+		e.Emoji = ""
+		e.position = int(position)
+	default:
+		return fmt.Errorf("unknown payload type %q", data[0])
 	}
 	return nil
 }
 
-func (e *Single) Button(id string, f func(*telegram.Callback)) telegram.InlineButton {
-	label := e.Emoji
+func (e *Single) label() string {
 	if e.Count > 1 {
-		label = fmt.Sprintf("%d %s", e.Count, e.Emoji)
+		return fmt.Sprintf("%d %s", e.Count, e.Emoji)
 	}
-	dataBytes, _ := json.Marshal(e)
-	data := string(dataBytes)
+	return e.Emoji
+}
+
+// countLabel is the label of a button that always shows its count, even 0 and 1.
+func (e *Single) countLabel() string {
+	return fmt.Sprintf("%d %s", e.Count, e.Emoji)
+}
+
+// parseLabel sets the emoji from a button label written by label() or countLabel().
+func (e *Single) parseLabel(label string) {
+	e.Emoji = strings.TrimPrefix(label, fmt.Sprintf("%d ", e.Count))
+}
+
+// The who-reacted button.
+const (
+	WhoButtonUnique = "who"
+	WhoButtonLabel  = "👀"
+)
+
+func isWhoButton(b telegram.InlineButton) bool {
+	return b.Data == "\f"+WhoButtonUnique || strings.HasPrefix(b.Data, "\f"+WhoButtonUnique+"|")
+}
+
+// Button returns the button for the reaction at the given position in its keyboard.
+// Its callback data has the emoji's ID or the emoji itself, whichever is shorter, and
+// falls back to the position to always stay within MaxCallbackDataLength.
+func (e *Single) Button(id string, position int, f func(*telegram.Callback)) telegram.InlineButton {
 	idBytes := md5.Sum([]byte(id + e.Emoji))
+	unique := fmt.Sprintf("%x", idBytes[:8])
+	count := strconv.FormatInt(e.Count, 36)
+	data := fmt.Sprintf("%c%s.%s", payloadRaw, count, e.Emoji)
+	if emojiID, ok := emoji.ID(e.Emoji); ok && len(emojiID) < len(e.Emoji) {
+		data = fmt.Sprintf("%c%s.%s", payloadEmojiID, count, emojiID)
+	}
+	if len("\f"+unique+"|"+data) > MaxCallbackDataLength {
+		data = fmt.Sprintf("%c%s.%s", payloadPosition, count, strconv.FormatInt(int64(position), 36))
+	}
 	return telegram.InlineButton{
-		Unique: fmt.Sprintf("%x", idBytes[:8]),
-		Data:   string(data),
-		Text:   label,
+		Unique: unique,
+		Data:   data,
+		Text:   e.label(),
 		Action: f,
 	}
 }
@@ -98,11 +200,13 @@ const maxMessageLength = 4096
 
 func (e *To) encode() string {
 	dataBytes, _ := json.Marshal(e)
-	return url.QueryEscape(string(dataBytes))
+	return string(dataBytes)
 }
 
 type Previous struct {
 	Count map[string]map[string]int
+	// Order lists the users in Count by when they first reacted, oldest first.
+	Order []string
 }
 
 func (e *Previous) Get(userID int, emoji string) int {
@@ -112,40 +216,69 @@ func (e *Previous) Get(userID int, emoji string) int {
 
 func (e *Previous) Remove(userID int, emoji string) {
 	userIDHex := fmt.Sprintf("%x", userID)
-	if n, ok := e.Count[userIDHex][emoji]; ok {
-		if n <= 1 {
-			delete(e.Count[userIDHex], userIDHex)
-		}
+	n, ok := e.Count[userIDHex][emoji]
+	if !ok {
+		return
+	}
+	if n > 1 {
 		e.Count[userIDHex][emoji] = n - 1
+		return
+	}
+	delete(e.Count[userIDHex], emoji)
+	if len(e.Count[userIDHex]) == 0 {
+		e.removeUser(userIDHex)
 	}
 }
 
 func (e *Previous) Add(userID int, emoji string) {
 	userIDHex := fmt.Sprintf("%x", userID)
 	if e.Count == nil {
-		e.Count = map[string]map[string]int{
-			userIDHex: {
-				emoji: 1,
-			},
-		}
-		return
+		e.Count = map[string]map[string]int{}
 	}
 	if e.Count[userIDHex] == nil {
-		e.Count[userIDHex] = map[string]int{
-			emoji: 1,
-		}
-		return
+		e.Count[userIDHex] = map[string]int{}
+		e.Order = append(e.Order, userIDHex)
 	}
 	e.Count[userIDHex][emoji]++
 }
 
+func (e *Previous) removeUser(userIDHex string) {
+	delete(e.Count, userIDHex)
+	for i, u := range e.Order {
+		if u == userIDHex {
+			e.Order = append(e.Order[:i:i], e.Order[i+1:]...)
+			break
+		}
+	}
+}
+
+// users returns the users in Order, followed by those missing from Order in ascending order.
+func (e *Previous) users() []string {
+	out := make([]string, 0, len(e.Count))
+	seen := make(map[string]bool, len(e.Count))
+	for _, u := range e.Order {
+		if _, ok := e.Count[u]; ok && !seen[u] {
+			out = append(out, u)
+			seen[u] = true
+		}
+	}
+	var missing []string
+	for u := range e.Count {
+		if !seen[u] {
+			missing = append(missing, u)
+		}
+	}
+	sort.Strings(missing)
+	return append(out, missing...)
+}
+
 func (e *Previous) encode() string {
 	dataBytes, _ := json.Marshal(e.Count)
 	var buf bytes.Buffer
 	w := gzip.NewWriter(&buf)
 	w.Write(dataBytes)
 	w.Close()
-	return url.QueryEscape(buf.String())
+	return buf.String()
 }
 
 func (e *Previous) Parse(data []byte) error {
@@ -157,22 +290,170 @@ func (e *Previous) Parse(data []byte) error {
 	if err != nil {
 		return err
 	}
-	return json.Unmarshal(dataBytes, &e.Count)
+	if err := json.Unmarshal(dataBytes, &e.Count); err != nil {
+		return err
+	}
+	e.Order = e.users()
+	return nil
 }
 
+// encodeBinary writes, for each user in Order,
+//
+//	uvarint(userID) uvarint(#emoji) { uvarint(ref<<1 | multiple) [uvarint(count)] }...
+//
+// where ref is 1 + the emoji's index in slice, or 0 followed by uvarint(len) and the
+// emoji's bytes if it is not in slice, and count is only written if it is not 1.
+func (e *Previous) encodeBinary(slice []Single) []byte {
+	index := make(map[string]uint64, len(slice))
+	for i, r := range slice {
+		index[r.Emoji] = uint64(i) + 1
+	}
+	var buf []byte
+	for _, userIDHex := range e.users() {
+		userID, err := strconv.ParseUint(userIDHex, 16, 64)
+		if err != nil {
+			continue
+		}
+		counts := e.Count[userIDHex]
+		emoji := make([]string, 0, len(counts))
+		for s, n := range counts {
+			if n > 0 {
+				emoji = append(emoji, s)
+			}
+		}
+		if len(emoji) == 0 {
+			continue
+		}
+		sort.Strings(emoji)
+		buf = appendUvarint(buf, userID)
+		buf = appendUvarint(buf, uint64(len(emoji)))
+		for _, s := range emoji {
+			n := counts[s]
+			var multiple uint64
+			if n != 1 {
+				multiple = 1
+			}
+			ref := index[s]
+			buf = appendUvarint(buf, ref<<1|multiple)
+			if ref == 0 {
+				buf = appendUvarint(buf, uint64(len(s)))
+				buf = append(buf, s...)
+			}
+			if multiple == 1 {
+				buf = appendUvarint(buf, uint64(n))
+			}
+		}
+	}
+	return buf
+}
+
+func (e *Previous) parseBinary(data []byte, slice []Single) error {
+	r := bytes.NewReader(data)
+	e.Count = make(map[string]map[string]int)
+	e.Order = nil
+	for r.Len() > 0 {
+		userID, err := binary.ReadUvarint(r)
+		if err != nil {
+			return err
+		}
+		n, err := binary.ReadUvarint(r)
+		if err != nil {
+			return err
+		}
+		counts := make(map[string]int)
+		for i := uint64(0); i < n; i++ {
+			x, err := binary.ReadUvarint(r)
+			if err != nil {
+				return err
+			}
+			var emoji string
+			switch ref := x >> 1; {
+			case ref == 0:
+				length, err := binary.ReadUvarint(r)
+				if err != nil {
+					return err
+				}
+				if length > uint64(r.Len()) {
+					return io.ErrUnexpectedEOF
+				}
+				emojiBytes := make([]byte, length)
+				r.Read(emojiBytes)
+				emoji = string(emojiBytes)
+			case ref <= uint64(len(slice)):
+				emoji = slice[ref-1].Emoji
+			default:
+				return fmt.Errorf("emoji index %d out of range", ref-1)
+			}
+			count := uint64(1)
+			if x&1 == 1 {
+				if count, err = binary.ReadUvarint(r); err != nil {
+					return err
+				}
+			}
+			counts[emoji] = int(count)
+		}
+		userIDHex := fmt.Sprintf("%x", userID)
+		e.Count[userIDHex] = counts
+		e.Order = append(e.Order, userIDHex)
+	}
+	return nil
+}
+
+func appendUvarint(buf []byte, x uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], x)
+	return append(buf, tmp[:n]...)
+}
+
+// Mode is a set of flags changing how a Set reacts. It is stored with the Set's state.
+type Mode uint
+
+const (
+	// ModeExclusive lets each user hold at most one reaction, like radio buttons (see Choose).
+	ModeExclusive Mode = 1 << iota
+	// ModeKeep keeps the buttons nobody reacts with anymore, e.g. for a preset palette (see AddButtons).
+	ModeKeep
+	// ModeFixed only allows reactions with the emoji the Set has buttons for, e.g. a poll's options.
+	// Its buttons always show their counts.
+	ModeFixed
+	// ModeClosed rejects all reactions (see React), and shows the final counts in the message text.
+	ModeClosed
+	// ModeNormalize counts reactions under their base emoji (see emoji.Base), while
+	// Previous keeps the variant each user reacted with.
+	ModeNormalize
+)
+
 type Set struct {
 	Slice    []Single
 	To       *To
 	Previous *Previous
-	Config   struct {
+	Mode     Mode `json:",omitempty"`
+	// Title is shown in the message text, above the state link; e.g. a poll's question.
+	Title  string `json:",omitempty"`
+	Config struct {
 		ButtonRowLength    int
 		ButtonRowMinLength int
+		OverflowPolicy     OverflowPolicy
+		Spill              Spill
+		OnOverflow         func(Overflow)
+		// WhoButton adds a WhoButtonLabel button (with unique WhoButtonUnique) after the reactions.
+		WhoButton bool
+		// MaxEmojiPerUser and MaxEmojiPerMessage limit the number of different emoji
+		// each user may react with, and the number of different emoji on the message. Zero means no limit.
+		MaxEmojiPerUser    int
+		MaxEmojiPerMessage int
 	} `json:"-"`
+	// unknownIDs maps the button data payloads with unknown emoji IDs to their buttons' emoji.
+	unknownIDs map[string]string
+	// ref is the Spill reference of the state, if it was spilled.
+	ref string
 }
 
 func (e *Set) ParseMessage(m *telegram.Message) error {
 	e.To = &To{}
 	e.Previous = &Previous{}
+	e.Mode = 0
+	e.Title = ""
 	if err := e.parseButtons(m.ReplyMarkup.InlineKeyboard); err != nil {
 		return fmt.Errorf("parse message: %v", err)
 	}
@@ -184,6 +465,32 @@ func (e *Set) ParseMessage(m *telegram.Message) error {
 	return nil
 }
 
+// State returns the Set's hidden state (as written to its state link) as a query string,
+// for messages whose text the Set cannot keep its state in.
+func (e *Set) State() string {
+	e.ref = ""
+	return LatestCodec.Encode(e).Encode()
+}
+
+// ParseState parses state returned by State. The Set's buttons must have been parsed already (see ParseMessage).
+// Like ParseMessage, it replaces the Set's Mode and Title with the state's.
+func (e *Set) ParseState(state string) error {
+	e.Mode = 0
+	e.Title = ""
+	query, err := url.ParseQuery(state)
+	if err != nil {
+		return fmt.Errorf("parse state: %v", err)
+	}
+	codec, err := codecFor(query)
+	if err != nil {
+		return fmt.Errorf("parse state: %v", err)
+	}
+	if err := codec.Decode(query, e); err != nil {
+		return fmt.Errorf("parse state: decode v%d: %v", codec.Version(), err)
+	}
+	return nil
+}
+
 func (e *Set) parseLinks(entities []telegram.MessageEntity) error {
 	for _, entity := range entities {
 		if entity.Type != telegram.EntityTextLink {
@@ -193,49 +500,97 @@ func (e *Set) parseLinks(entities []telegram.MessageEntity) error {
 		if err != nil {
 			return err
 		}
-		to := entityURL.Query().Get("data") // TODO: DEPRECATE
-		if to == "" {
-			to = entityURL.Query().Get("t")
+		if entityURL.Host != stateHost {
+			continue
 		}
-		if err := e.To.Parse([]byte(to)); err != nil {
+		query := entityURL.Query()
+		codec, err := codecFor(query)
+		if err != nil {
 			return err
 		}
-		previous := entityURL.Query().Get("p")
-		if err := e.Previous.Parse([]byte(previous)); err != nil {
-			return err
+		if err := codec.Decode(query, e); err != nil {
+			return fmt.Errorf("decode v%d: %v", codec.Version(), err)
 		}
 	}
 	return nil
 }
+
 func (e *Set) parseButtons(rows [][]telegram.InlineButton) error {
-	var errors []error
+	var errs []error
 	e.Slice = e.Slice[:0]
+	e.unknownIDs = nil
 	for _, buttons := range rows {
 		for _, b := range buttons {
+			if isWhoButton(b) {
+				continue
+			}
 			var r Single
-			if err := r.ParseButtonData(b.Data); err != nil {
-				errors = append(errors, err)
+			payload := buttonPayload(b.Data)
+			err := r.parsePayload(payload)
+			switch {
+			case err == errUnknownEmojiID:
+				r.parseLabel(b.Text)
+				if e.unknownIDs == nil {
+					e.unknownIDs = make(map[string]string)
+				}
+				e.unknownIDs[payload] = r.Emoji
+			case err != nil:
+				errs = append(errs, fmt.Errorf("parse data %s: %v", payload, err))
 				continue
+			case r.Emoji == "":
+				r.parseLabel(b.Text)
 			}
 			e.Slice = append(e.Slice, r)
 		}
 	}
-	if len(errors) > 0 {
-		return fmt.Errorf("parse buttons: %v", errors)
+	if len(errs) > 0 {
+		return fmt.Errorf("parse buttons: %v", errs)
 	}
 	return nil
 }
 
+// ParseButtonData parses the callback data of one of the set's buttons.
+// The set's buttons must have been parsed already (see ParseMessage).
+func (e *Set) ParseButtonData(data string) (Single, error) {
+	var r Single
+	payload := buttonPayload(data)
+	if err := r.parsePayload(payload); err == errUnknownEmojiID && e.unknownIDs[payload] != "" {
+		r.Emoji = e.unknownIDs[payload]
+		return r, nil
+	} else if err != nil {
+		return r, fmt.Errorf("parse data %s: %v", payload, err)
+	}
+	if r.Emoji == "" {
+		if r.position < 0 || r.position >= len(e.Slice) {
+			return r, fmt.Errorf("parse data %s: button position %d out of range", data, r.position)
+		}
+		r.Emoji = e.Slice[r.position].Emoji
+	}
+	return r, nil
+}
+
 func (e *Set) buttons(id string, f func(*telegram.Callback)) (out [][]telegram.InlineButton) {
 	var row []telegram.InlineButton
 	for i, r := range e.Slice {
-		row = append(row, r.Button(id, f))
+		b := r.Button(id, i, f)
+		if e.Mode&ModeFixed != 0 {
+			b.Text = r.countLabel()
+		}
+		row = append(row, b)
 		remaining := len(e.Slice) - i
 		if len(row) >= e.Config.ButtonRowLength && remaining >= e.Config.ButtonRowMinLength {
 			out = append(out, row)
 			row = nil
 		}
 	}
+	if e.Config.WhoButton && len(e.Slice) > 0 {
+		who := telegram.InlineButton{Unique: WhoButtonUnique, Text: WhoButtonLabel}
+		if len(row) >= e.Config.ButtonRowLength {
+			out = append(out, row)
+			row = nil
+		}
+		row = append(row, who)
+	}
 	if len(row) > 0 {
 		out = append(out, row)
 	}
@@ -243,45 +598,276 @@ func (e *Set) buttons(id string, f func(*telegram.Callback)) (out [][]telegram.I
 }
 
 func (e *Set) MessageText() string {
-	to := e.To.encode()
-	previous := e.Previous.encode()
-	encode := func() string {
-		return fmt.Sprintf(`<a href="http://example.com?t=%s&p=%s">%s</a>`, to, previous, spaceString)
-	}
-	text := encode()
-	for len(text) > maxMessageLength && len(e.Previous.Count) > 0 {
-		for k := range e.Previous.Count {
-			delete(e.Previous.Count, k)
-			break
+	return e.messageText(LatestCodec)
+}
+
+func (e *Set) messageText(codec Codec) string {
+	e.ref = ""
+	text := e.text(codec)
+	if len(text) <= maxMessageLength {
+		return text
+	}
+	policy := e.Config.OverflowPolicy
+	overflow := Overflow{Policy: policy, To: e.To}
+	if policy == OverflowSpill {
+		spilled, err := e.spill(codec)
+		if err == nil {
+			overflow.Ref = e.ref
+			e.onOverflow(overflow)
+			return spilled
 		}
-		text = encode()
+		e.ref = ""
+		overflow.Err = err.Error()
+		policy = OverflowDropOldest
 	}
+	text, overflow.UserIDs = e.shed(codec, policy)
+	e.onOverflow(overflow)
 	return text
 }
 
+func (e *Set) onOverflow(overflow Overflow) {
+	if e.Config.OnOverflow != nil {
+		e.Config.OnOverflow(overflow)
+	}
+}
+
+// text returns the message text: the title and, in ModeClosed, the final counts, followed by the state link.
+func (e *Set) text(codec Codec) string {
+	var b strings.Builder
+	if e.Title != "" {
+		b.WriteString(html.EscapeString(e.Title))
+		b.WriteString("\n")
+	}
+	if e.Mode&ModeClosed != 0 {
+		b.WriteString("\n")
+		for _, r := range e.Slice {
+			fmt.Fprintf(&b, "%s %d\n", html.EscapeString(r.Emoji), r.Count)
+		}
+		b.WriteString("<i>Closed.</i>\n")
+	}
+	b.WriteString(e.stateLink(codec))
+	return b.String()
+}
+
+func (e *Set) stateLink(codec Codec) string {
+	return fmt.Sprintf(`<a href="%s?%s">%s</a>`, stateURL, codec.Encode(e).Encode(), spaceString)
+}
+
 func (e *Set) ReplyMarkup(id string, f func(*telegram.Callback)) *telegram.ReplyMarkup {
 	return &telegram.ReplyMarkup{
 		InlineKeyboard: e.buttons(id, f),
 	}
 }
 
-func (e *Set) AddOrRemove(userID int, emoji []string) (added, removed int) {
+// Reasons for rejecting a reaction.
+const (
+	// RejectedUserLimit means the user already reacted with Config.MaxEmojiPerUser emoji.
+	RejectedUserLimit = "user-limit"
+	// RejectedMessageLimit means the message already has Config.MaxEmojiPerMessage emoji.
+	RejectedMessageLimit = "message-limit"
+	// RejectedFixed means the Set is in ModeFixed and has no button for the emoji.
+	RejectedFixed = "fixed"
+	// RejectedClosed means the Set is in ModeClosed.
+	RejectedClosed = "closed"
+)
+
+// Rejected is a reaction AddOrRemove did not add.
+type Rejected struct {
+	Emoji  string
+	Reason string
+}
+
+// Result describes what AddOrRemove did. Added and Removed hold the emoji as the user reacted with them.
+type Result struct {
+	Added    []string   `json:",omitempty"`
+	Removed  []string   `json:",omitempty"`
+	Rejected []Rejected `json:",omitempty"`
+}
+
+// AddOrRemove toggles the user's reactions with the given emoji: those the user already
+// reacted with (see Variant) are removed, the others are added unless that exceeds a limit.
+func (e *Set) AddOrRemove(userID int, emoji []string) (result Result) {
 	var toAdd []string
-	var toRemove []string
 	for _, s := range emoji {
-		if e.Previous.Get(userID, s) > 0 {
-			toRemove = append(toRemove, s)
+		counted := e.countedAs(s)
+		if variant, ok := e.Variant(userID, counted); ok {
+			result.Removed = append(result.Removed, variant)
+			continue
+		}
+		if reason := e.limitReached(userID, counted, toAdd); reason != "" {
+			result.Rejected = append(result.Rejected, Rejected{Emoji: s, Reason: reason})
 			continue
 		}
 		e.Previous.Add(userID, s)
-		toAdd = append(toAdd, s)
+		toAdd = append(toAdd, counted)
+		result.Added = append(result.Added, s)
 	}
-	for _, s := range toRemove {
+	for _, s := range result.Removed {
 		e.Previous.Remove(userID, s)
-		e.remove(s)
+		e.remove(e.countedAs(s))
 	}
 	e.add(toAdd)
-	return len(toAdd), len(toRemove)
+	return result
+}
+
+// limitReached returns why the user may not add a reaction counted as the given emoji, if they may not.
+// pending are the emoji (as counted) about to be added.
+func (e *Set) limitReached(userID int, counted string, pending []string) string {
+	if e.fixedRejects(counted) {
+		return RejectedFixed
+	}
+	if max := e.Config.MaxEmojiPerUser; max > 0 && len(e.reactionsOf(userID)) >= max {
+		return RejectedUserLimit
+	}
+	if e.messageLimitReached(counted, pending) {
+		return RejectedMessageLimit
+	}
+	return ""
+}
+
+func (e *Set) messageLimitReached(counted string, pending []string) bool {
+	max := e.Config.MaxEmojiPerMessage
+	if max <= 0 {
+		return false
+	}
+	distinct := make(map[string]bool, len(e.Slice)+len(pending))
+	for _, r := range e.Slice {
+		distinct[r.Emoji] = true
+	}
+	for _, s := range pending {
+		distinct[s] = true
+	}
+	return !distinct[counted] && len(distinct) >= max
+}
+
+// fixedRejects reports whether the Set is in ModeFixed and has no button for the emoji.
+func (e *Set) fixedRejects(counted string) bool {
+	if e.Mode&ModeFixed == 0 {
+		return false
+	}
+	for _, r := range e.Slice {
+		if r.Emoji == counted {
+			return false
+		}
+	}
+	return true
+}
+
+// reactionsOf returns the emoji the user reacted with, sorted.
+func (e *Set) reactionsOf(userID int) []string {
+	var out []string
+	for s, n := range e.Previous.Count[fmt.Sprintf("%x", userID)] {
+		if n > 0 {
+			out = append(out, s)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Choose makes the emoji the user's only reaction, removing their others, unless it already
+// is their reaction, in which case it is removed.
+func (e *Set) Choose(userID int, s string) (result Result) {
+	counted := e.countedAs(s)
+	if variant, ok := e.Variant(userID, counted); ok {
+		result.Removed = []string{variant}
+	} else if e.fixedRejects(counted) {
+		result.Rejected = []Rejected{{Emoji: s, Reason: RejectedFixed}}
+		return result
+	} else if e.messageLimitReached(counted, nil) {
+		result.Rejected = []Rejected{{Emoji: s, Reason: RejectedMessageLimit}}
+		return result
+	} else {
+		result.Removed = e.reactionsOf(userID)
+		result.Added = []string{s}
+	}
+	for _, r := range result.Removed {
+		for n := e.Previous.Get(userID, r); n > 0; n-- {
+			e.Previous.Remove(userID, r)
+			e.remove(e.countedAs(r))
+		}
+	}
+	if len(result.Added) > 0 {
+		e.Previous.Add(userID, s)
+		e.add([]string{counted})
+	}
+	return result
+}
+
+// React adds or removes the user's reactions with the given emoji: with AddOrRemove,
+// or, in ModeExclusive, by choosing the last of them. In ModeClosed, it rejects them all.
+func (e *Set) React(userID int, emoji []string) (result Result) {
+	if e.Mode&ModeClosed != 0 {
+		for _, s := range emoji {
+			result.Rejected = append(result.Rejected, Rejected{Emoji: s, Reason: RejectedClosed})
+		}
+		return result
+	}
+	if e.Mode&ModeExclusive != 0 && len(emoji) > 0 {
+		return e.Choose(userID, emoji[len(emoji)-1])
+	}
+	return e.AddOrRemove(userID, emoji)
+}
+
+// Users returns the users who reacted with the given emoji (see Variant), in the order they first reacted.
+func (e *Set) Users(counted string) []int {
+	var out []int
+	for _, userIDHex := range e.Previous.users() {
+		userID, err := strconv.ParseInt(userIDHex, 16, 64)
+		if err != nil {
+			continue
+		}
+		if _, ok := e.Variant(int(userID), counted); ok {
+			out = append(out, int(userID))
+		}
+	}
+	return out
+}
+
+// countedAs returns the emoji whose button counts a reaction with s.
+func (e *Set) countedAs(s string) string {
+	if e.Mode&ModeNormalize != 0 {
+		return emoji.Base(s)
+	}
+	return s
+}
+
+// Variant returns the emoji the user reacted with that is counted on the same button as the given emoji,
+// e.g. 👍🏽 for 👍 (or 👍🏿) in ModeNormalize.
+func (e *Set) Variant(userID int, s string) (string, bool) {
+	counted := e.countedAs(s)
+	if e.Previous.Get(userID, counted) > 0 {
+		return counted, true
+	}
+	if e.Mode&ModeNormalize == 0 {
+		return "", false
+	}
+	userIDHex := fmt.Sprintf("%x", userID)
+	var variants []string
+	for s, n := range e.Previous.Count[userIDHex] {
+		if n > 0 && emoji.Base(s) == counted {
+			variants = append(variants, s)
+		}
+	}
+	if len(variants) == 0 {
+		return "", false
+	}
+	sort.Strings(variants)
+	return variants[0], true
+}
+
+// AddButtons adds a button without reactions for each of the given emoji the Set does not have yet.
+// Unless the Set is in ModeKeep, the buttons disappear again once they had reactions and lost them.
+func (e *Set) AddButtons(emoji []string) {
+adding:
+	for _, add := range emoji {
+		for _, r := range e.Slice {
+			if add == r.Emoji {
+				continue adding
+			}
+		}
+		e.Slice = append(e.Slice, Single{Emoji: add})
+	}
 }
 
 func (e *Set) add(emoji []string) {
@@ -307,7 +893,7 @@ func (e *Set) remove(emoji string) {
 	for i, r := range e.Slice {
 		if emoji == r.Emoji {
 			e.Slice[i].Count--
-			if e.Slice[i].Count == 0 {
+			if e.Slice[i].Count == 0 && e.Mode&ModeKeep == 0 {
 				continue
 			}
 		}
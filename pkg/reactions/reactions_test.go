@@ -0,0 +1,302 @@
+package reactions
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	emoji "github.com/sgreben/telegram-emoji-reactions-bot/internal/emoji"
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+)
+
+func TestButtonDataLength(t *testing.T) {
+	const position = 99
+	for _, em := range emoji.Emojis {
+		r := Single{Emoji: em.Value, Count: math.MaxInt64}
+		b := r.Button("-1001234567890", position, nil)
+		data := "\f" + b.Unique + "|" + b.Data
+		if len(data) > MaxCallbackDataLength {
+			t.Errorf("%s: callback data %q has %d bytes, want at most %d", em.Value, data, len(data), MaxCallbackDataLength)
+			continue
+		}
+		var out Single
+		if err := out.ParseButtonData(data); err != nil {
+			t.Errorf("%s: %v", em.Value, err)
+			continue
+		}
+		if out.Count != r.Count || (out.Emoji != r.Emoji && out.position != position) {
+			t.Errorf("%s: callback data %q parses to %+v", em.Value, data, out)
+		}
+	}
+}
+
+func TestParseButtonDataErrors(t *testing.T) {
+	for _, data := range []string{
+		"\fabc|",
+		"\fabc|.x",
+		"\fabc|e1",
+		"\fabc|e.x",
+		"\fabc|x1.a",
+		"\fabc|e1.?",
+		"\fabc|p1.?",
+		"\fabc|{",
+	} {
+		var r Single
+		if err := r.ParseButtonData(data); err == nil {
+			t.Errorf("ParseButtonData(%q) = %+v, want an error", data, r)
+		}
+	}
+}
+
+func TestParseUnknownEmojiID(t *testing.T) {
+	// zzzzzzz is larger than any 32-bit ID, so no emoji has it.
+	const data = "\fabc|e2.zzzzzzz"
+	e := newTestSet()
+	err := e.parseButtons([][]telegram.InlineButton{{
+		{Text: "👍", Data: "\fdef|e1." + mustID(t, "👍")},
+		{Text: "2 🦄", Data: data},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := e.Slice[1]; got.Emoji != "🦄" || got.Count != 2 {
+		t.Errorf("button with an unknown ID parses to %+v, want 2 🦄 from its label", got)
+	}
+	r, err := e.ParseButtonData(data)
+	if err != nil || r.Emoji != "🦄" {
+		t.Errorf("ParseButtonData(%q) = %+v, %v; want 🦄", data, r, err)
+	}
+	if r, err := newTestSet().ParseButtonData(data); err == nil {
+		t.Errorf("ParseButtonData(%q) without the button = %+v, want an error", data, r)
+	}
+}
+
+func mustID(t *testing.T, value string) string {
+	id, ok := emoji.ID(value)
+	if !ok {
+		t.Fatalf("%s has no ID", value)
+	}
+	return id
+}
+
+func newTestSet() *Set {
+	return &Set{To: &To{}, Previous: &Previous{}}
+}
+
+func TestAddOrRemoveLimits(t *testing.T) {
+	type reaction struct {
+		userID int
+		emoji  []string
+		want   Result
+	}
+	tests := []struct {
+		name        string
+		maxPerUser  int
+		maxPerMsg   int
+		reactions   []reaction
+		wantButtons map[string]int64
+	}{
+		{
+			name:       "user limit",
+			maxPerUser: 2,
+			reactions: []reaction{
+				{1, []string{"👍", "🔥", "😂"}, Result{
+					Added:    []string{"👍", "🔥"},
+					Rejected: []Rejected{{Emoji: "😂", Reason: RejectedUserLimit}},
+				}},
+				{2, []string{"👍", "🔥", "😂"}, Result{
+					Added:    []string{"👍", "🔥"},
+					Rejected: []Rejected{{Emoji: "😂", Reason: RejectedUserLimit}},
+				}},
+				// removing one makes room for another
+				{1, []string{"🔥"}, Result{Removed: []string{"🔥"}}},
+				{1, []string{"😂"}, Result{Added: []string{"😂"}}},
+			},
+			wantButtons: map[string]int64{"👍": 2, "🔥": 1, "😂": 1},
+		},
+		{
+			name:      "message limit",
+			maxPerMsg: 2,
+			reactions: []reaction{
+				{1, []string{"👍", "🔥", "😂"}, Result{
+					Added:    []string{"👍", "🔥"},
+					Rejected: []Rejected{{Emoji: "😂", Reason: RejectedMessageLimit}},
+				}},
+				{2, []string{"😂"}, Result{Rejected: []Rejected{{Emoji: "😂", Reason: RejectedMessageLimit}}}},
+				// emoji already on the message are still fine
+				{2, []string{"🔥"}, Result{Added: []string{"🔥"}}},
+				// an emoji whose last reaction is removed makes room for another
+				{1, []string{"👍"}, Result{Removed: []string{"👍"}}},
+				{2, []string{"😂"}, Result{Added: []string{"😂"}}},
+			},
+			wantButtons: map[string]int64{"🔥": 2, "😂": 1},
+		},
+		{
+			name: "no limits",
+			reactions: []reaction{
+				{1, []string{"👍", "🔥", "😂"}, Result{Added: []string{"👍", "🔥", "😂"}}},
+				{1, []string{"👍", "🎉"}, Result{Added: []string{"🎉"}, Removed: []string{"👍"}}},
+			},
+			wantButtons: map[string]int64{"🔥": 1, "😂": 1, "🎉": 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newTestSet()
+			e.Config.MaxEmojiPerUser = tt.maxPerUser
+			e.Config.MaxEmojiPerMessage = tt.maxPerMsg
+			for i, r := range tt.reactions {
+				if got := e.AddOrRemove(r.userID, r.emoji); !reflect.DeepEqual(got, r.want) {
+					t.Errorf("reaction %d: AddOrRemove(%d, %v) = %+v, want %+v", i, r.userID, r.emoji, got, r.want)
+				}
+			}
+			buttons := make(map[string]int64)
+			for _, r := range e.Slice {
+				buttons[r.Emoji] = r.Count
+			}
+			if !reflect.DeepEqual(buttons, tt.wantButtons) {
+				t.Errorf("buttons = %v, want %v", buttons, tt.wantButtons)
+			}
+		})
+	}
+}
+
+func TestReactRejected(t *testing.T) {
+	e := newTestSet()
+	e.Mode = ModeFixed | ModeKeep
+	e.AddButtons([]string{"👍", "👎"})
+	want := Result{
+		Added:    []string{"👍"},
+		Rejected: []Rejected{{Emoji: "🔥", Reason: RejectedFixed}},
+	}
+	if got := e.React(1, []string{"👍", "🔥"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("React in ModeFixed = %+v, want %+v", got, want)
+	}
+	e.Mode |= ModeClosed
+	want = Result{Rejected: []Rejected{
+		{Emoji: "👍", Reason: RejectedClosed},
+		{Emoji: "👎", Reason: RejectedClosed},
+	}}
+	if got := e.React(1, []string{"👍", "👎"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("React in ModeClosed = %+v, want %+v", got, want)
+	}
+	if got := e.Previous.Get(1, "👍"); got != 1 {
+		t.Errorf("closed set changed the user's reactions to %d 👍", got)
+	}
+}
+
+func TestChoose(t *testing.T) {
+	e := newTestSet()
+	e.Mode = ModeExclusive
+	steps := []struct {
+		userID      int
+		emoji       string
+		want        Result
+		wantButtons map[string]int64
+	}{
+		{1, "👍", Result{Added: []string{"👍"}}, map[string]int64{"👍": 1}},
+		{2, "👍", Result{Added: []string{"👍"}}, map[string]int64{"👍": 2}},
+		// moving to another choice removes the previous one
+		{1, "🔥", Result{Added: []string{"🔥"}, Removed: []string{"👍"}}, map[string]int64{"👍": 1, "🔥": 1}},
+		{1, "😂", Result{Added: []string{"😂"}, Removed: []string{"🔥"}}, map[string]int64{"👍": 1, "😂": 1}},
+		// choosing the same emoji again removes it
+		{1, "😂", Result{Removed: []string{"😂"}}, map[string]int64{"👍": 1}},
+		{1, "😂", Result{Added: []string{"😂"}}, map[string]int64{"👍": 1, "😂": 1}},
+	}
+	for i, step := range steps {
+		if got := e.React(step.userID, []string{step.emoji}); !reflect.DeepEqual(got, step.want) {
+			t.Errorf("step %d: React(%d, %s) = %+v, want %+v", i, step.userID, step.emoji, got, step.want)
+		}
+		buttons := make(map[string]int64)
+		for _, r := range e.Slice {
+			buttons[r.Emoji] = r.Count
+		}
+		if !reflect.DeepEqual(buttons, step.wantButtons) {
+			t.Errorf("step %d: buttons = %v, want %v", i, buttons, step.wantButtons)
+		}
+	}
+}
+
+func TestChooseRemovesEarlierReactions(t *testing.T) {
+	e := newTestSet()
+	e.AddOrRemove(1, []string{"👍", "🔥"})
+	e.Mode = ModeExclusive
+	want := Result{Added: []string{"😂"}, Removed: []string{"👍", "🔥"}}
+	if got := e.React(1, []string{"👎", "😂"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("React = %+v, want %+v", got, want)
+	}
+	if got, want := e.reactionsOf(1), []string{"😂"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("reactions = %v, want %v", got, want)
+	}
+}
+
+func TestDecodeMode(t *testing.T) {
+	tests := []struct {
+		m       string
+		want    Mode
+		wantErr bool
+	}{
+		{"", 0, false},
+		{"1", ModeExclusive, false},
+		{"3", ModeExclusive | ModeKeep, false},
+		{"f", ModeExclusive | ModeKeep | ModeFixed | ModeClosed, false},
+		{"-1", 0, true},
+		{"?", 0, true},
+	}
+	for _, tt := range tests {
+		query := codecV4{}.Encode(newTestSet())
+		query.Set("m", tt.m)
+		e := newTestSet()
+		err := codecV4{}.Decode(query, e)
+		switch {
+		case tt.wantErr && err == nil:
+			t.Errorf("m=%s: decoded to %v, want an error", tt.m, e.Mode)
+		case !tt.wantErr && err != nil:
+			t.Errorf("m=%s: %v", tt.m, err)
+		case !tt.wantErr && e.Mode != tt.want:
+			t.Errorf("m=%s: Mode = %v, want %v", tt.m, e.Mode, tt.want)
+		}
+	}
+}
+
+func TestNormalizeOnlyNewSets(t *testing.T) {
+	e := newTestSet()
+	e.AddOrRemove(1, []string{"👍🏽"})
+	state := e.State()
+	buttons := append([]Single(nil), e.Slice...)
+
+	// reparse the set as the bot does after normalization was turned on for the chat
+	parse := func() *Set {
+		out := newTestSet()
+		out.Mode = ModeNormalize
+		out.Slice = append([]Single(nil), buttons...)
+		if err := out.ParseState(state); err != nil {
+			t.Fatal(err)
+		}
+		return out
+	}
+	out := parse()
+	if out.Mode&ModeNormalize != 0 {
+		t.Fatalf("existing set parsed with Mode %v, want no ModeNormalize", out.Mode)
+	}
+	out.AddOrRemove(2, []string{"👍🏽"})
+	out.AddOrRemove(1, []string{"👍🏽"})
+	if want := []Single{{Emoji: "👍🏽", Count: 1}}; !reflect.DeepEqual(out.Slice, want) {
+		t.Errorf("buttons = %+v, want %+v", out.Slice, want)
+	}
+	if got := out.Previous.Get(2, "👍🏽"); got != 1 {
+		t.Errorf("user 2 has %d 👍🏽, want 1", got)
+	}
+
+	n := newTestSet()
+	n.Mode = ModeNormalize
+	n.AddOrRemove(1, []string{"👍🏽"})
+	n.AddOrRemove(2, []string{"👍"})
+	if want := []Single{{Emoji: "👍", Count: 2}}; !reflect.DeepEqual(n.Slice, want) {
+		t.Errorf("new set's buttons = %+v, want %+v", n.Slice, want)
+	}
+	state, buttons = n.State(), n.Slice
+	if out := parse(); out.Mode&ModeNormalize == 0 {
+		t.Errorf("new set parsed with Mode %v, want ModeNormalize", out.Mode)
+	}
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+	emojirx "github.com/sgreben/telegram-emoji-reactions-bot/pkg/reactions"
+)
+
+const bucketPoll = "poll"
+
+const (
+	maxPollOptions        = 10
+	maxPollQuestionLength = 300
+	maxPollDuration       = 7 * 24 * time.Hour
+)
+
+const (
+	// pollCloseRetry is the wait before retrying to close a poll, times the attempts so far.
+	pollCloseRetry       = time.Minute
+	maxPollCloseAttempts = 5
+	// pollClosedKeep is how long the pollClosing of a closed poll is kept, to reject the edits
+	// of reactions that were made to the poll's state before it closed.
+	pollClosedKeep = 10 * time.Minute
+)
+
+// errPollClosed is returned for an edit of a poll that closed after its message was parsed.
+var errPollClosed = errors.New("poll is closed")
+
+const pollUsage = "Usage: /poll [multi] [<duration>, e.g. 2h] <question> <emoji>...\nExample: /poll Where to lunch? 🍕 🍣 🌮"
+
+// pollSpec is a poll as given to /poll.
+type pollSpec struct {
+	Question string
+	Options  []string
+	// Multi lets users vote for several options, instead of one.
+	Multi bool
+	// Duration, if set, closes the poll after this long.
+	Duration time.Duration
+}
+
+// parsePoll parses the arguments of `/poll [multi] [<duration>] <question> <emoji>...`.
+// "multi" and the duration are only recognised as the first words, and only if a question follows them.
+// If the arguments are not a valid poll, it returns a message for the user saying why.
+func parsePoll(payload string) (pollSpec, string) {
+	var p pollSpec
+	fields := strings.Fields(payload)
+	end := len(fields)
+	var options []string
+	for ; end > 0; end-- {
+		emoji, rest := partitionEmoji(fields[end-1])
+		if len(emoji) == 0 || rest != "" {
+			break
+		}
+		options = append(emoji, options...)
+	}
+	question := fields[:end]
+	if len(question) > 1 && question[0] == "multi" {
+		p.Multi = true
+		question = question[1:]
+	}
+	if len(question) > 1 {
+		if d, ok := parsePollDuration(question[0]); ok {
+			if d < time.Minute || d > maxPollDuration {
+				return p, fmt.Sprintf("A poll can be open for 1m to %d days.", maxPollDuration/(24*time.Hour))
+			}
+			p.Duration = d
+			question = question[1:]
+		}
+	}
+	p.Question = strings.Join(question, " ")
+	p.Options = uniqueStrings(options)
+	switch {
+	case p.Question == "" || len(p.Options) < 2:
+		return p, "A poll needs a question and at least two emoji options."
+	case len(p.Options) > maxPollOptions:
+		return p, fmt.Sprintf("A poll can have at most %d options.", maxPollOptions)
+	case utf8.RuneCountInString(p.Question) > maxPollQuestionLength:
+		return p, fmt.Sprintf("A poll's question can have at most %d characters.", maxPollQuestionLength)
+	}
+	return p, ""
+}
+
+// parsePollDuration parses a duration with a unit, such as 90m or 2h; a plain number is not a duration.
+func parsePollDuration(s string) (time.Duration, bool) {
+	if strings.TrimLeft(s, "0123456789.") == "" {
+		return 0, false
+	}
+	d, err := time.ParseDuration(s)
+	return d, err == nil
+}
+
+// handlePoll handles `/poll`, which posts a question with a fixed set of emoji options.
+func (bot *emojiReactionBot) handlePoll(m *telegram.Message) {
+	if m.Sender == nil {
+		return
+	}
+	p, problem := parsePoll(m.Payload)
+	if problem != "" {
+		bot.commandReply(m, problem+"\n\n"+pollUsage)
+		return
+	}
+	if p.Duration > 0 && config.StoreFile == "" {
+		bot.commandReply(m, "This bot can't close polls: it runs without -store-file, so it would forget them when it restarts.")
+		return
+	}
+	settings := bot.ChatSettings(m.Chat.ID)
+	if _, rejected := settings.filterAllowed(p.Options); len(rejected) > 0 {
+		bot.commandReply(m, rejectedToast(rejected))
+		return
+	}
+	reactions := bot.newReactionSet(settings)
+	reactions.Mode = emojirx.ModeKeep | emojirx.ModeFixed
+	if !p.Multi {
+		reactions.Mode |= emojirx.ModeExclusive
+	}
+	reactions.Title = p.Question
+	reactions.To = &emojirx.To{
+		UserID: m.Sender.ID,
+		ChatID: m.Chat.ID,
+		ID:     m.ID,
+	}
+	reactions.AddButtons(p.Options)
+	pollMessage, err := bot.postReactionsMessage(m, reactions)
+	if err != nil {
+		log.Printf("poll %v: %v", m.ID, err)
+		return
+	}
+	if p.Duration > 0 {
+		bot.schedulePollClosing(pollClosing{
+			ChatID:    pollMessage.Chat.ID,
+			MessageID: pollMessage.ID,
+			Closes:    time.Now().Add(p.Duration),
+			Buttons:   reactions.Slice,
+			State:     reactions.State(),
+		})
+	}
+}
+
+// pollClosing is a poll that closes at a set time. Polls keep their state in their message,
+// as any reactions message does, but pollClosing has a copy of it (see PollStateWrite),
+// so that the poll can be closed even when the message is no longer in the message cache.
+type pollClosing struct {
+	ChatID    int64            `json:"c"`
+	MessageID int              `json:"m"`
+	Closes    time.Time        `json:"t"`
+	Buttons   []emojirx.Single `json:"b"`
+	State     string           `json:"s"`
+	// Attempts counts the failed attempts to close the poll.
+	Attempts int `json:"a,omitempty"`
+	// Closed is set once closePoll closed the poll.
+	Closed bool `json:"x,omitempty"`
+}
+
+func (bot *emojiReactionBot) PollClosingRead(chatID int64, messageID int) (pollClosing, bool) {
+	var p pollClosing
+	return p, bot.storeGet(bucketPoll, globalMessageID(chatID, messageID), &p)
+}
+
+func (bot *emojiReactionBot) PollClosingWrite(p pollClosing) {
+	bot.storePut(bucketPoll, globalMessageID(p.ChatID, p.MessageID), p)
+}
+
+// PollStateWrite updates the copy of a poll's state in its pollClosing, if it has one.
+// The caller holds the lock of lockPoll.
+func (bot *emojiReactionBot) PollStateWrite(chatID int64, messageID int, reactions *emojirx.Set) {
+	p, ok := bot.PollClosingRead(chatID, messageID)
+	if !ok {
+		return
+	}
+	p.Buttons = reactions.Slice
+	p.State = reactions.State()
+	bot.PollClosingWrite(p)
+}
+
+// lockPoll serializes the edits of a timed poll's message with closePoll, and reports whether the poll is closed.
+// Messages other than timed polls' are not locked.
+func (bot *emojiReactionBot) lockPoll(chatID int64, messageID int) (closed bool, unlock func()) {
+	bot.pollMu.Lock()
+	p, ok := bot.PollClosingRead(chatID, messageID)
+	if !ok {
+		bot.pollMu.Unlock()
+		return false, func() {}
+	}
+	return p.Closed, bot.pollMu.Unlock
+}
+
+func (bot *emojiReactionBot) schedulePollClosing(p pollClosing) {
+	bot.PollClosingWrite(p)
+	time.AfterFunc(time.Until(p.Closes), func() { bot.closePoll(p.ChatID, p.MessageID) })
+}
+
+// schedulePollClosings schedules the closing of the polls in the store, e.g. after a restart.
+// Polls whose closing time has passed are closed right away.
+func (bot *emojiReactionBot) schedulePollClosings() {
+	var closed []string
+	err := bot.Store.ForEach(bucketPoll, "", func(key string, value json.RawMessage) error {
+		var p pollClosing
+		if err := json.Unmarshal(value, &p); err != nil {
+			return fmt.Errorf("%s: %v", key, err)
+		}
+		if p.Closed {
+			closed = append(closed, key)
+			return nil
+		}
+		time.AfterFunc(time.Until(p.Closes), func() { bot.closePoll(p.ChatID, p.MessageID) })
+		return nil
+	})
+	if err != nil {
+		log.Printf("poll: %v", err)
+	}
+	for _, key := range closed {
+		bot.storeDelete(bucketPoll, key)
+	}
+}
+
+// closePoll freezes a poll's keyboard and writes the final counts into its text.
+// If that fails, it tries again later, up to maxPollCloseAttempts times.
+func (bot *emojiReactionBot) closePoll(chatID int64, messageID int) {
+	bot.pollMu.Lock()
+	defer bot.pollMu.Unlock()
+	p, ok := bot.PollClosingRead(chatID, messageID)
+	if !ok || p.Closed {
+		return
+	}
+	reactions := bot.newReactionSet(bot.ChatSettings(chatID))
+	reactions.To = &emojirx.To{}
+	reactions.Previous = &emojirx.Previous{}
+	reactions.Slice = p.Buttons
+	if err := reactions.ParseState(p.State); err != nil {
+		log.Printf("poll %x:%x: %v", chatID, messageID, err)
+	}
+	reactions.Mode |= emojirx.ModeClosed
+	jsonOut.Encode(reactions)
+	pollMessage := &telegram.StoredMessage{MessageID: fmt.Sprint(messageID), ChatID: chatID}
+	edited, err := bot.Edit(pollMessage, reactions.MessageText(), reactions.ReplyMarkup(fmt.Sprint(messageID), bot.handleCallback), telegram.ModeHTML)
+	if err != nil {
+		p.Attempts++
+		if p.Attempts >= maxPollCloseAttempts {
+			log.Printf("poll %x:%x: edit: %v; giving up after %d attempts", chatID, messageID, err, p.Attempts)
+			bot.storeDelete(bucketPoll, globalMessageID(chatID, messageID))
+			return
+		}
+		log.Printf("poll %x:%x: edit: %v; retrying", chatID, messageID, err)
+		bot.PollClosingWrite(p)
+		time.AfterFunc(time.Duration(p.Attempts)*pollCloseRetry, func() { bot.closePoll(chatID, messageID) })
+		return
+	}
+	p.Closed = true
+	p.State = reactions.State()
+	bot.PollClosingWrite(p)
+	time.AfterFunc(pollClosedKeep, func() {
+		bot.pollMu.Lock()
+		defer bot.pollMu.Unlock()
+		bot.storeDelete(bucketPoll, globalMessageID(chatID, messageID))
+	})
+	jsonOut.Encode(edited)
+	bot.MessageForWrite(edited)
+}
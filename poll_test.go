@@ -0,0 +1,185 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+	emojirx "github.com/sgreben/telegram-emoji-reactions-bot/pkg/reactions"
+)
+
+func TestParsePoll(t *testing.T) {
+	tests := []struct {
+		payload string
+		want    pollSpec
+		wantErr bool
+	}{
+		{
+			payload: "Where to lunch? 🍕 🍣 🌮",
+			want:    pollSpec{Question: "Where to lunch?", Options: []string{"🍕", "🍣", "🌮"}},
+		},
+		{
+			payload: "multi 2h Where to lunch? 🍕🍣 🌮",
+			want:    pollSpec{Question: "Where to lunch?", Options: []string{"🍕", "🍣", "🌮"}, Multi: true, Duration: 2 * time.Hour},
+		},
+		{
+			payload: "90m Coffee? 👍 👎",
+			want:    pollSpec{Question: "Coffee?", Options: []string{"👍", "👎"}, Duration: 90 * time.Minute},
+		},
+		{
+			payload: "Lunch :pizza: :sushi: 🍕",
+			want:    pollSpec{Question: "Lunch", Options: []string{"🍕", "🍣"}},
+		},
+		{
+			// "multi" and durations are only options as the first words
+			payload: "Which is best: 2h or multi? 👍 👎",
+			want:    pollSpec{Question: "Which is best: 2h or multi?", Options: []string{"👍", "👎"}},
+		},
+		{
+			// a plain number is part of the question
+			payload: "0 or 1? 👍 👎",
+			want:    pollSpec{Question: "0 or 1?", Options: []string{"👍", "👎"}},
+		},
+		{
+			// without a question following it, "multi" is the question
+			payload: "multi 👍 👎",
+			want:    pollSpec{Question: "multi", Options: []string{"👍", "👎"}},
+		},
+		{
+			payload: "multi 2h 👍 👎",
+			want:    pollSpec{Question: "2h", Options: []string{"👍", "👎"}, Multi: true},
+		},
+		{payload: "", wantErr: true},
+		{payload: "Question without options", wantErr: true},
+		{payload: "One option? 👍", wantErr: true},
+		{payload: "👍 👎", wantErr: true},
+		{payload: "30s Too short? 👍 👎", wantErr: true},
+		{payload: "200h Too long? 👍 👎", wantErr: true},
+		{payload: "Too many? 😀 😃 😄 😁 😆 😅 🤣 😂 🙂 🙃 😉", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, problem := parsePoll(tt.payload)
+		switch {
+		case tt.wantErr && problem == "":
+			t.Errorf("parsePoll(%q) = %+v, want a problem", tt.payload, got)
+		case !tt.wantErr && problem != "":
+			t.Errorf("parsePoll(%q): %s", tt.payload, problem)
+		case !tt.wantErr && !reflect.DeepEqual(got, tt.want):
+			t.Errorf("parsePoll(%q) = %+v, want %+v", tt.payload, got, tt.want)
+		}
+	}
+}
+
+// newTestPoll returns the message of a timed poll, and stores its pollClosing.
+func newTestPoll(bot *emojiReactionBot) *telegram.Message {
+	reactions := &emojirx.Set{
+		To:       &emojirx.To{UserID: 2, ChatID: -100, ID: 10},
+		Previous: &emojirx.Previous{},
+		Mode:     emojirx.ModeKeep | emojirx.ModeFixed,
+		Title:    "Coffee?",
+	}
+	reactions.AddButtons([]string{"👍", "👎"})
+	m := testMessageOf(reactions, -100, 11)
+	bot.PollClosingWrite(pollClosing{
+		ChatID:    m.Chat.ID,
+		MessageID: m.ID,
+		Closes:    time.Now().Add(time.Hour),
+		Buttons:   reactions.Slice,
+		State:     reactions.State(),
+	})
+	return m
+}
+
+func testVote(bot *emojiReactionBot, poll *telegram.Message, userID int) {
+	bot.handleCallback(&telegram.Callback{
+		ID:      fmt.Sprint(userID),
+		Sender:  &telegram.User{ID: userID},
+		Message: poll,
+		Data:    poll.ReplyMarkup.InlineKeyboard[0][0].Data,
+	})
+}
+
+// pollState returns the poll's state as stored in its pollClosing.
+func pollState(t *testing.T, bot *emojiReactionBot, poll *telegram.Message) (pollClosing, *emojirx.Set) {
+	p, ok := bot.PollClosingRead(poll.Chat.ID, poll.ID)
+	if !ok {
+		t.Fatal("poll record not found")
+	}
+	reactions := &emojirx.Set{To: &emojirx.To{}, Previous: &emojirx.Previous{}, Slice: p.Buttons}
+	if err := reactions.ParseState(p.State); err != nil {
+		t.Fatal(err)
+	}
+	return p, reactions
+}
+
+func TestClosePoll(t *testing.T) {
+	bot, f := newFakeTelegramBot(t)
+	defer f.Close()
+	poll := newTestPoll(bot)
+	testVote(bot, poll, 3)
+	if _, reactions := pollState(t, bot, poll); reactions.Previous.Get(3, "👍") != 1 {
+		t.Fatal("vote before closing is not in the poll's stored state")
+	}
+
+	bot.closePoll(poll.Chat.ID, poll.ID)
+	edits := f.callsTo("editMessageText")
+	if len(edits) != 2 {
+		t.Fatalf("poll edited %d times, want once for the vote and once for closing", len(edits))
+	}
+	p, reactions := pollState(t, bot, poll)
+	if !p.Closed || reactions.Mode&emojirx.ModeClosed == 0 || reactions.Previous.Get(3, "👍") != 1 {
+		t.Errorf("closed poll %+v in mode %v, want it closed with the vote", p, reactions.Mode)
+	}
+	if !strings.Contains(edits[1].Params["text"], "Coffee?") {
+		t.Errorf("closed poll text %q, want the question", edits[1].Params["text"])
+	}
+
+	// a vote on the poll's message as it was before closing must not reopen it
+	testVote(bot, poll, 4)
+	if n := len(f.callsTo("editMessageText")); n != 2 {
+		t.Errorf("a vote after closing edited the poll")
+	}
+	answers := f.callsTo("answerCallbackQuery")
+	if got := answers[len(answers)-1].Params["text"]; got != "Sorry, this poll is closed." {
+		t.Errorf("vote after closing answered %q, want the poll to be closed", got)
+	}
+	if _, reactions := pollState(t, bot, poll); reactions.Previous.Get(4, "👍") != 0 {
+		t.Error("a vote after closing is in the poll's stored state")
+	}
+
+	bot.closePoll(poll.Chat.ID, poll.ID)
+	if n := len(f.callsTo("editMessageText")); n != 2 {
+		t.Errorf("closing a closed poll edited it again")
+	}
+}
+
+func TestClosePollRetry(t *testing.T) {
+	bot, f := newFakeTelegramBot(t)
+	defer f.Close()
+	poll := newTestPoll(bot)
+
+	f.fail["editMessageText"] = "Too Many Requests: retry after 5"
+	bot.closePoll(poll.Chat.ID, poll.ID)
+	if p, _ := pollState(t, bot, poll); p.Closed || p.Attempts != 1 {
+		t.Fatalf("after a failed closing, poll record %+v, want it open after 1 attempt", p)
+	}
+	delete(f.fail, "editMessageText")
+	bot.closePoll(poll.Chat.ID, poll.ID)
+	if p, _ := pollState(t, bot, poll); !p.Closed {
+		t.Errorf("after a retried closing, poll record %+v, want it closed", p)
+	}
+
+	// after maxPollCloseAttempts, the poll is given up on
+	poll = newTestPoll(bot)
+	p, _ := pollState(t, bot, poll)
+	p.Attempts = maxPollCloseAttempts - 1
+	bot.PollClosingWrite(p)
+	f.fail["editMessageText"] = "Bad Request: message to edit not found"
+	bot.closePoll(poll.Chat.ID, poll.ID)
+	if p, ok := bot.PollClosingRead(poll.Chat.ID, poll.ID); ok {
+		t.Errorf("after %d failed closings, poll record %+v is kept", maxPollCloseAttempts, p)
+	}
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+)
+
+const replayMaxLineLength = 16 * 1024 * 1024
+
+// notificationForward is the log record written when a message is forwarded for a reaction notification.
+type notificationForward struct {
+	ChatID    int64             `json:"forwarded_for_chat_id"`
+	MessageID int               `json:"forwarded_for_message_id"`
+	Forwarded *telegram.Message `json:"forwarded"`
+}
+
+// replayLog restores the message caches from a JSON event log written with -verbose.
+// Values the store already had before the replay are newer than the log's, and are kept.
+func (bot *emojiReactionBot) replayLog(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("replay %s: %v", path, err)
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, replayMaxLineLength)
+	var lines, restored int
+	replayed := make(replayedKeys)
+	for scanner.Scan() {
+		lines++
+		if bot.replayRecord(scanner.Bytes(), replayed) {
+			restored++
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("replay %s: line %d: %v", path, lines+1, err)
+	}
+	log.Printf("replay %s: restored %d of %d records", path, restored, lines)
+	return nil
+}
+
+// replayedKeys are the store keys written by a replay, as "<bucket>/<key>".
+type replayedKeys map[string]bool
+
+// replayable reports whether a replay may write the key: if the store does not have it,
+// or has it from an earlier record of the same replay.
+func (bot *emojiReactionBot) replayable(replayed replayedKeys, bucket, key string) bool {
+	id := bucket + "/" + key
+	if replayed[id] {
+		return true
+	}
+	var value json.RawMessage
+	if bot.storeGet(bucket, key, &value) {
+		return false
+	}
+	replayed[id] = true
+	return true
+}
+
+func (bot *emojiReactionBot) replayRecord(data []byte, replayed replayedKeys) bool {
+	var probe struct {
+		MessageID *int              `json:"message_id"`
+		Forwarded *telegram.Message `json:"forwarded"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return false
+	}
+	switch {
+	case probe.Forwarded != nil:
+		var r notificationForward
+		if err := json.Unmarshal(data, &r); err != nil {
+			return false
+		}
+		if !bot.replayable(replayed, bucketForwarded, globalMessageID(r.ChatID, r.MessageID)) {
+			return false
+		}
+		bot.NotificationForwardCacheWrite(r.ChatID, r.MessageID, r.Forwarded)
+		return true
+	case probe.MessageID != nil:
+		var m telegram.Message
+		if err := json.Unmarshal(data, &m); err != nil {
+			return false
+		}
+		return bot.replayReactionsMessage(&m, replayed)
+	}
+	return false
+}
+
+func (bot *emojiReactionBot) replayReactionsMessage(m *telegram.Message, replayed replayedKeys) bool {
+	if m.Sender == nil || m.Sender.ID != bot.Me.ID || m.Chat == nil || len(m.ReplyMarkup.InlineKeyboard) == 0 {
+		return false
+	}
+	reactions := bot.newReactionSet(bot.ChatSettings(m.Chat.ID))
+	if err := reactions.ParseMessage(m); err != nil || reactions.To.ID == 0 {
+		return false
+	}
+	messageOK := bot.replayable(replayed, bucketMessage, globalMessageID(m.Chat.ID, m.ID))
+	if messageOK {
+		bot.MessageForWrite(m)
+	}
+	idOK := bot.replayable(replayed, bucketReactionMessageID, globalMessageID(reactions.To.ChatID, reactions.To.ID))
+	if idOK {
+		bot.ReactionMessageIDForWrite(reactions.To.ChatID, reactions.To.ID, m.ID)
+	}
+	return messageOK || idOK
+}
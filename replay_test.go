@@ -0,0 +1,143 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+	emojirx "github.com/sgreben/telegram-emoji-reactions-bot/pkg/reactions"
+)
+
+// testReactionsMessage returns the bot's reactions message with the given ID, for the message toID in chatID,
+// as it is logged with -verbose.
+func testReactionsMessage(chatID int64, id, toID int, emoji ...string) *telegram.Message {
+	reactions := &emojirx.Set{
+		To:       &emojirx.To{UserID: 2, ChatID: chatID, ID: toID},
+		Previous: &emojirx.Previous{},
+	}
+	reactions.AddOrRemove(2, emoji)
+	return testMessageOf(reactions, chatID, id)
+}
+
+// testMessageOf returns the bot's message with the given ID showing the reactions, as Telegram sends it.
+func testMessageOf(reactions *emojirx.Set, chatID int64, id int) *telegram.Message {
+	reactions.Config.ButtonRowLength = 4
+	reactions.Config.ButtonRowMinLength = 2
+	m := &telegram.Message{
+		ID:     id,
+		Sender: &telegram.User{ID: fakeBotID, IsBot: true},
+		Chat:   &telegram.Chat{ID: chatID},
+		Entities: []telegram.MessageEntity{{
+			Type: telegram.EntityTextLink,
+			URL:  "http://example.com?" + reactions.State(),
+		}},
+	}
+	for _, row := range reactions.ReplyMarkup(fmt.Sprint(id), nil).InlineKeyboard {
+		var buttons []telegram.InlineButton
+		for _, b := range row {
+			buttons = append(buttons, telegram.InlineButton{Text: b.Text, Data: "\f" + b.Unique + "|" + b.Data})
+		}
+		m.ReplyMarkup.InlineKeyboard = append(m.ReplyMarkup.InlineKeyboard, buttons)
+	}
+	return m
+}
+
+func writeTestLog(t *testing.T, dir string, records ...interface{}) string {
+	path := filepath.Join(dir, "log.json")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	enc := json.NewEncoder(f)
+	for _, r := range records {
+		if err := enc.Encode(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return path
+}
+
+func newReplayTestBot() *emojiReactionBot {
+	return &emojiReactionBot{
+		Bot:   &telegram.Bot{Me: &telegram.User{ID: fakeBotID}},
+		Store: newMemoryStore(),
+	}
+}
+
+func reactionsMessageText(t *testing.T, bot *emojiReactionBot, chatID int64, toID int) []string {
+	id, ok := bot.ReactionMessageIDForRead(chatID, toID)
+	if !ok {
+		t.Fatalf("no reactions message for %d", toID)
+	}
+	m, ok := bot.MessageForRead(chatID, id)
+	if !ok {
+		t.Fatalf("reactions message %d not found", id)
+	}
+	var labels []string
+	for _, row := range m.ReplyMarkup.InlineKeyboard {
+		for _, b := range row {
+			labels = append(labels, b.Text)
+		}
+	}
+	return labels
+}
+
+func TestReplayLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "replay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	forwarded := &telegram.Message{ID: 50, Chat: &telegram.Chat{ID: 2}}
+	path := writeTestLog(t, dir,
+		testReactionsMessage(-100, 11, 10, "👍"),
+		map[string]string{"unrelated": "record"},
+		&telegram.Message{ID: 12, Sender: &telegram.User{ID: 3}, Chat: &telegram.Chat{ID: -100}, Text: "not the bot's"},
+		// a later edit of the same reactions message replaces the earlier one
+		testReactionsMessage(-100, 11, 10, "👍", "🔥"),
+		notificationForward{ChatID: -100, MessageID: 10, Forwarded: forwarded},
+	)
+	bot := newReplayTestBot()
+	if err := bot.replayLog(path); err != nil {
+		t.Fatal(err)
+	}
+	if got := reactionsMessageText(t, bot, -100, 10); fmt.Sprint(got) != "[👍 🔥]" {
+		t.Errorf("replayed buttons = %v, want [👍 🔥]", got)
+	}
+	if m, ok := bot.NotificationForwardCacheRead(-100, 10); !ok || m.ID != forwarded.ID {
+		t.Errorf("replayed forward = %+v, %v; want message %d", m, ok, forwarded.ID)
+	}
+	if _, ok := bot.ReactionMessageIDForRead(-100, 12); ok {
+		t.Error("replayed a message that is not the bot's")
+	}
+}
+
+func TestReplayLogKeepsNewerValues(t *testing.T) {
+	dir, err := ioutil.TempDir("", "replay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := writeTestLog(t, dir,
+		testReactionsMessage(-100, 11, 10, "👍"),
+		testReactionsMessage(-100, 21, 20, "😂"),
+	)
+	bot := newReplayTestBot()
+	newer := testReactionsMessage(-100, 11, 10, "👍", "🎉")
+	bot.MessageForWrite(newer)
+	bot.ReactionMessageIDForWrite(-100, 10, newer.ID)
+	if err := bot.replayLog(path); err != nil {
+		t.Fatal(err)
+	}
+	if got := reactionsMessageText(t, bot, -100, 10); fmt.Sprint(got) != "[👍 🎉]" {
+		t.Errorf("buttons after replay = %v, want the store's [👍 🎉]", got)
+	}
+	if got := reactionsMessageText(t, bot, -100, 20); fmt.Sprint(got) != "[😂]" {
+		t.Errorf("replayed buttons = %v, want [😂]", got)
+	}
+}
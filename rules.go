@@ -0,0 +1,179 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+	emojirx "github.com/sgreben/telegram-emoji-reactions-bot/pkg/reactions"
+)
+
+// maxRules is the number of rules a chat may have.
+const maxRules = 20
+
+// chatRule makes the bot post a reactions message with the palette's emoji (at zero reactions)
+// for each new message of the chat that matches.
+//
+// Match is one of:
+//   - a kind of message: photo, video, document, audio, voice or sticker
+//   - a #hashtag in the message's text or caption (ignoring case)
+//   - an @username the message is from, or was forwarded from (a user, bot or channel)
+//
+// Telegram does not deliver other bots' messages to bots, so an @username of a bot only matches
+// messages forwarded from it, never the bot's own messages in the chat.
+type chatRule struct {
+	Match   string   `json:"m"`
+	Palette []string `json:"p"`
+}
+
+var ruleKinds = map[string]func(*telegram.Message) bool{
+	"photo":    func(m *telegram.Message) bool { return m.Photo != nil },
+	"video":    func(m *telegram.Message) bool { return m.Video != nil },
+	"document": func(m *telegram.Message) bool { return m.Document != nil },
+	"audio":    func(m *telegram.Message) bool { return m.Audio != nil },
+	"voice":    func(m *telegram.Message) bool { return m.Voice != nil },
+	"sticker":  func(m *telegram.Message) bool { return m.Sticker != nil },
+}
+
+var hashtagRx = regexp.MustCompile(`#[\p{L}\p{N}_]+`)
+
+func parseRuleMatch(s string) (string, error) {
+	s = strings.ToLower(s)
+	switch {
+	case ruleKinds[s] != nil:
+	case strings.HasPrefix(s, "#") && hashtagRx.FindString(s) == s:
+	case strings.HasPrefix(s, "@") && len(s) > 1:
+	default:
+		return "", fmt.Errorf("unknown rule match %q", s)
+	}
+	return s, nil
+}
+
+func (r chatRule) matches(m *telegram.Message) bool {
+	switch {
+	case strings.HasPrefix(r.Match, "#"):
+		for _, text := range []string{m.Text, m.Caption} {
+			for _, tag := range hashtagRx.FindAllString(text, -1) {
+				if strings.ToLower(tag) == r.Match {
+					return true
+				}
+			}
+		}
+		return false
+	case strings.HasPrefix(r.Match, "@"):
+		username := r.Match[1:]
+		for _, u := range []*telegram.User{m.Sender, m.OriginalSender} {
+			if u != nil && strings.ToLower(u.Username) == username {
+				return true
+			}
+		}
+		return m.OriginalChat != nil && strings.ToLower(m.OriginalChat.Username) == username
+	}
+	kind := ruleKinds[r.Match]
+	return kind != nil && kind(m)
+}
+
+// matchingRule returns the chat's first rule matching m.
+func (bot *emojiReactionBot) matchingRule(m *telegram.Message) (chatRule, bool) {
+	for _, r := range bot.ChatConfigRead(m.Chat.ID).Rules {
+		if r.matches(m) {
+			return r, true
+		}
+	}
+	return chatRule{}, false
+}
+
+// addReactionsMessageByRule posts a reactions message with the palette of the first rule matching m, if any.
+func (bot *emojiReactionBot) addReactionsMessageByRule(m *telegram.Message) {
+	rule, ok := bot.matchingRule(m)
+	if !ok {
+		return
+	}
+	settings := bot.ChatSettings(m.Chat.ID)
+	palette, _ := settings.filterAllowed(rule.Palette)
+	if len(palette) == 0 {
+		return
+	}
+	if _, ok := bot.ReactionMessageIDForRead(m.Chat.ID, m.ID); ok {
+		return
+	}
+	reactions := bot.newReactionSet(settings)
+	reactions.Mode |= emojirx.ModeKeep
+	reactions.To = &emojirx.To{
+		UserID: m.Sender.ID,
+		ChatID: m.Chat.ID,
+		ID:     m.ID,
+	}
+	reactions.AddButtons(palette)
+	if _, err := bot.postReactionsMessage(m, reactions); err != nil {
+		log.Printf("rule %s: %v: %v", rule.Match, m.ID, err)
+	}
+}
+
+const ruleUsage = "Usage: /rule add photo|video|document|audio|voice|sticker|#hashtag|@username <emoji>... | /rule list | /rule del <number>"
+
+// handleRule handles `/rule add <match> <emoji>...`, `/rule list` and `/rule del <number>`.
+func (bot *emojiReactionBot) handleRule(m *telegram.Message) {
+	args := strings.Fields(m.Payload)
+	if len(args) == 0 {
+		args = []string{"list"}
+	}
+	c := bot.ChatConfigRead(m.Chat.ID)
+	switch args[0] {
+	case "list":
+		bot.commandReply(m, rulesText(c.Rules))
+		return
+	case "add", "del":
+	default:
+		bot.commandReply(m, ruleUsage)
+		return
+	}
+	if !bot.isAdmin(m.Chat, m.Sender) {
+		bot.commandReply(m, "Only chat admins can change this.")
+		return
+	}
+	switch {
+	case args[0] == "add" && len(args) >= 3:
+		match, err := parseRuleMatch(args[1])
+		if err != nil {
+			bot.commandReply(m, ruleUsage)
+			return
+		}
+		palette, rest := partitionEmoji(strings.Join(args[2:], " "))
+		if len(palette) == 0 || rest != "" {
+			bot.commandReply(m, ruleUsage)
+			return
+		}
+		if len(c.Rules) >= maxRules {
+			bot.commandReply(m, fmt.Sprintf("This chat already has %d rules. Remove one with /rule del first.", maxRules))
+			return
+		}
+		c.Rules = append(c.Rules, chatRule{Match: match, Palette: uniqueStrings(palette)})
+	case args[0] == "del" && len(args) == 2:
+		i, err := strconv.Atoi(args[1])
+		if err != nil || i < 1 || i > len(c.Rules) {
+			bot.commandReply(m, fmt.Sprintf("There is no rule %s. See /rule list.", args[1]))
+			return
+		}
+		c.Rules = append(c.Rules[:i-1], c.Rules[i:]...)
+	default:
+		bot.commandReply(m, ruleUsage)
+		return
+	}
+	bot.ChatConfigWrite(m.Chat.ID, c)
+	bot.commandReply(m, rulesText(c.Rules))
+}
+
+func rulesText(rules []chatRule) string {
+	if len(rules) == 0 {
+		return "No rules. Add one with /rule add, e.g. /rule add photo 👍 😂"
+	}
+	lines := []string{"Reactions are added to:"}
+	for i, r := range rules {
+		lines = append(lines, fmt.Sprintf("%d. %s %s", i+1, r.Match, strings.Join(r.Palette, " ")))
+	}
+	return strings.Join(lines, "\n")
+}
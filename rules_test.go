@@ -0,0 +1,62 @@
+package main
+
+import (
+	"testing"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+)
+
+func TestParseRuleMatch(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{"photo", "photo", false},
+		{"Sticker", "sticker", false},
+		{"#Proposal", "#proposal", false},
+		{"#año_2020", "#año_2020", false},
+		{"@News_Bot", "@news_bot", false},
+		{"", "", true},
+		{"foo", "", true},
+		{"#", "", true},
+		{"#a b", "", true},
+		{"#a#b", "", true},
+		{"@", "", true},
+	}
+	for _, tt := range tests {
+		got, err := parseRuleMatch(tt.s)
+		switch {
+		case tt.wantErr && err == nil:
+			t.Errorf("parseRuleMatch(%q) = %q, want an error", tt.s, got)
+		case !tt.wantErr && (err != nil || got != tt.want):
+			t.Errorf("parseRuleMatch(%q) = %q, %v; want %q", tt.s, got, err, tt.want)
+		}
+	}
+}
+
+func TestChatRuleMatches(t *testing.T) {
+	tests := []struct {
+		match string
+		m     *telegram.Message
+		want  bool
+	}{
+		{"photo", &telegram.Message{Photo: &telegram.Photo{}}, true},
+		{"photo", &telegram.Message{Text: "photo"}, false},
+		{"sticker", &telegram.Message{Sticker: &telegram.Sticker{}}, true},
+		{"#proposal", &telegram.Message{Text: "new #Proposal: lunch"}, true},
+		{"#proposal", &telegram.Message{Caption: "#PROPOSAL"}, true},
+		{"#proposal", &telegram.Message{Text: "#proposals"}, false},
+		{"#proposal", &telegram.Message{Text: "proposal"}, false},
+		{"@alice", &telegram.Message{Sender: &telegram.User{Username: "Alice"}}, true},
+		{"@alice", &telegram.Message{Sender: &telegram.User{Username: "bob"}, OriginalSender: &telegram.User{Username: "alice"}}, true},
+		{"@news", &telegram.Message{Sender: &telegram.User{Username: "bob"}, OriginalChat: &telegram.Chat{Username: "News"}}, true},
+		{"@alice", &telegram.Message{Sender: &telegram.User{Username: "bob"}}, false},
+		{"@alice", &telegram.Message{}, false},
+	}
+	for _, tt := range tests {
+		if got := (chatRule{Match: tt.match}).matches(tt.m); got != tt.want {
+			t.Errorf("rule %s matches %+v = %v, want %v", tt.match, tt.m, got, tt.want)
+		}
+	}
+}
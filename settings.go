@@ -0,0 +1,207 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+)
+
+const settingsUnique = "settings"
+
+// Telegram shows at most 8 buttons per row.
+const maxButtonRowLength = 8
+
+// maxEmojiLimit is the highest emoji limit the settings menu offers before "no limit".
+const maxEmojiLimit = 20
+
+// Settings menu actions (the callback data of its buttons).
+const (
+	settingsRowLengthDown    = "rl-"
+	settingsRowLengthUp      = "rl+"
+	settingsRowMinLengthDown = "rm-"
+	settingsRowMinLengthUp   = "rm+"
+	settingsUserLimitDown    = "lu-"
+	settingsUserLimitUp      = "lu+"
+	settingsMessageLimitDown = "lm-"
+	settingsMessageLimitUp   = "lm+"
+	settingsExclusive        = "x"
+	settingsNormalize        = "n"
+	settingsWhoButton        = "w"
+	settingsNotify           = "t"
+	settingsReset            = "reset"
+	settingsDone             = "done"
+	settingsNoop             = "-"
+)
+
+// handleSettings handles `/settings`, which opens the chat's settings menu.
+func (bot *emojiReactionBot) handleSettings(m *telegram.Message) {
+	if !bot.isAdmin(m.Chat, m.Sender) {
+		bot.commandReply(m, "Only chat admins can change the settings.")
+		return
+	}
+	settings := bot.ChatSettings(m.Chat.ID)
+	reply, err := bot.Reply(m, settingsText, settingsMarkup(settings), telegram.Silent)
+	if err != nil {
+		log.Printf("settings %x: reply: %v", m.Chat.ID, err)
+		return
+	}
+	jsonOut.Encode(reply)
+}
+
+const settingsText = "Settings for this chat:"
+
+func settingsMarkup(s chatSettings) *telegram.ReplyMarkup {
+	button := func(text, action string) telegram.InlineButton {
+		return telegram.InlineButton{Unique: settingsUnique, Text: text, Data: action}
+	}
+	toggle := func(text string, on bool, action string) []telegram.InlineButton {
+		state := "off"
+		if on {
+			state = "on"
+		}
+		return []telegram.InlineButton{button(fmt.Sprintf("%s: %s", text, state), action)}
+	}
+	return &telegram.ReplyMarkup{InlineKeyboard: [][]telegram.InlineButton{
+		{
+			button("−", settingsRowLengthDown),
+			button(fmt.Sprintf("Buttons per row: %d", s.ButtonRowLength), settingsNoop),
+			button("+", settingsRowLengthUp),
+		},
+		{
+			button("−", settingsRowMinLengthDown),
+			button(fmt.Sprintf("Min. buttons in last row: %d", s.ButtonRowMinLength), settingsNoop),
+			button("+", settingsRowMinLengthUp),
+		},
+		{
+			button("−", settingsUserLimitDown),
+			button(fmt.Sprintf("Max. emoji per user: %s", limitText(s.MaxEmojiPerUser)), settingsNoop),
+			button("+", settingsUserLimitUp),
+		},
+		{
+			button("−", settingsMessageLimitDown),
+			button(fmt.Sprintf("Max. emoji per message: %s", limitText(s.MaxEmojiPerMessage)), settingsNoop),
+			button("+", settingsMessageLimitUp),
+		},
+		toggle("One reaction per user (new messages)", s.Exclusive, settingsExclusive),
+		toggle("Count variants (👍🏽) as base emoji", s.Normalize, settingsNormalize),
+		toggle("Who-reacted button", s.WhoButton, settingsWhoButton),
+		toggle("Reaction notifications", s.Notify, settingsNotify),
+		{
+			button("Reset", settingsReset),
+			button("Done", settingsDone),
+		},
+	}}
+}
+
+func limitText(n int) string {
+	if n <= 0 {
+		return "∞"
+	}
+	return fmt.Sprint(n)
+}
+
+// stepLimit steps a limit (0: no limit) down from ∞ to 1, or up from 1 to ∞.
+func stepLimit(n int, up bool) (int, bool) {
+	switch {
+	case up && n == 0, !up && n == 1:
+		return n, false
+	case up && n >= maxEmojiLimit:
+		return 0, true
+	case up:
+		return n + 1, true
+	case n == 0:
+		return maxEmojiLimit, true
+	}
+	return n - 1, true
+}
+
+// applySetting applies a settings menu action to the chat's config.
+func applySetting(c *chatConfig, action string) bool {
+	s := c.settings()
+	switch action {
+	case settingsRowLengthDown, settingsRowLengthUp:
+		n := s.ButtonRowLength + 1
+		if action == settingsRowLengthDown {
+			n = s.ButtonRowLength - 1
+		}
+		if n < 1 || n > maxButtonRowLength {
+			return false
+		}
+		c.ButtonRowLength = &n
+		if s.ButtonRowMinLength > n {
+			c.ButtonRowMinLength = &n
+		}
+	case settingsRowMinLengthDown, settingsRowMinLengthUp:
+		n := s.ButtonRowMinLength + 1
+		if action == settingsRowMinLengthDown {
+			n = s.ButtonRowMinLength - 1
+		}
+		if n < 1 || n > s.ButtonRowLength {
+			return false
+		}
+		c.ButtonRowMinLength = &n
+	case settingsUserLimitDown, settingsUserLimitUp:
+		n, ok := stepLimit(s.MaxEmojiPerUser, action == settingsUserLimitUp)
+		if !ok {
+			return false
+		}
+		c.MaxEmojiPerUser = n
+	case settingsMessageLimitDown, settingsMessageLimitUp:
+		n, ok := stepLimit(s.MaxEmojiPerMessage, action == settingsMessageLimitUp)
+		if !ok {
+			return false
+		}
+		c.MaxEmojiPerMessage = n
+	case settingsExclusive:
+		c.Exclusive = !s.Exclusive
+	case settingsNormalize:
+		c.Normalize = !s.Normalize
+	case settingsWhoButton:
+		on := !s.WhoButton
+		c.WhoButton = &on
+	case settingsNotify:
+		on := !s.Notify
+		c.Notify = &on
+	case settingsReset:
+		// only what the menu shows; the /allow and /block lists and the /rule rules stay
+		*c = chatConfig{Allow: c.Allow, Block: c.Block, Rules: c.Rules}
+	default:
+		return false
+	}
+	return true
+}
+
+func (bot *emojiReactionBot) handleSettingsCallback(c *telegram.Callback) {
+	jsonOut.Encode(c)
+	bot.UserWrite(c.Sender)
+	if c.Message == nil {
+		bot.respond(c, "")
+		return
+	}
+	chat := c.Message.Chat
+	if !bot.isAdmin(chat, c.Sender) {
+		bot.respond(c, "Only chat admins can change the settings.")
+		return
+	}
+	if c.Data == settingsDone {
+		if _, err := bot.Edit(c.Message, "Settings saved."); err != nil {
+			log.Printf("settings %x: edit: %v", chat.ID, err)
+		}
+		bot.respond(c, "")
+		return
+	}
+	cfg := bot.ChatConfigRead(chat.ID)
+	if !applySetting(&cfg, c.Data) {
+		bot.respond(c, "")
+		return
+	}
+	bot.ChatConfigWrite(chat.ID, cfg)
+	edited, err := bot.Edit(c.Message, settingsText, settingsMarkup(cfg.settings()))
+	if err != nil {
+		log.Printf("settings %x: edit: %v", chat.ID, err)
+	} else {
+		jsonOut.Encode(edited)
+	}
+	bot.respond(c, "Saved. Applies to reactions from now on.")
+}
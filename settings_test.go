@@ -0,0 +1,145 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+)
+
+func TestApplySettingReset(t *testing.T) {
+	n, on := 3, true
+	c := chatConfig{
+		Normalize:          true,
+		ButtonRowLength:    &n,
+		ButtonRowMinLength: &n,
+		WhoButton:          &on,
+		Notify:             &on,
+		Exclusive:          true,
+		MaxEmojiPerUser:    2,
+		MaxEmojiPerMessage: 5,
+		Allow:              []string{"👍", "👎"},
+		Block:              []string{"🖕"},
+		Rules:              []chatRule{{Match: "photo", Palette: []string{"👍"}}},
+	}
+	if !applySetting(&c, settingsReset) {
+		t.Fatal("reset not applied")
+	}
+	want := chatConfig{
+		Allow: []string{"👍", "👎"},
+		Block: []string{"🖕"},
+		Rules: []chatRule{{Match: "photo", Palette: []string{"👍"}}},
+	}
+	if !reflect.DeepEqual(c, want) {
+		t.Errorf("reset config = %+v, want %+v", c, want)
+	}
+}
+
+func TestApplySettingToggles(t *testing.T) {
+	tests := []struct {
+		action string
+		get    func(chatSettings) bool
+	}{
+		{settingsExclusive, func(s chatSettings) bool { return s.Exclusive }},
+		{settingsNormalize, func(s chatSettings) bool { return s.Normalize }},
+		{settingsWhoButton, func(s chatSettings) bool { return s.WhoButton }},
+		{settingsNotify, func(s chatSettings) bool { return s.Notify }},
+	}
+	for _, tt := range tests {
+		var c chatConfig
+		before := tt.get(c.settings())
+		for i := 1; i <= 2; i++ {
+			if !applySetting(&c, tt.action) {
+				t.Fatalf("%s: not applied", tt.action)
+			}
+			if got, want := tt.get(c.settings()), before != (i%2 == 1); got != want {
+				t.Errorf("%s: after %d toggles, setting is %v, want %v", tt.action, i, got, want)
+			}
+		}
+	}
+}
+
+func TestStepLimit(t *testing.T) {
+	tests := []struct {
+		n      int
+		up     bool
+		want   int
+		wantOK bool
+	}{
+		{0, true, 0, false},
+		{1, false, 1, false},
+		{0, false, maxEmojiLimit, true},
+		{maxEmojiLimit, true, 0, true},
+		{1, true, 2, true},
+		{2, false, 1, true},
+	}
+	for _, tt := range tests {
+		if got, ok := stepLimit(tt.n, tt.up); got != tt.want || ok != tt.wantOK {
+			t.Errorf("stepLimit(%d, %v) = %d, %v; want %d, %v", tt.n, tt.up, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestApplySettingRowLengthBounds(t *testing.T) {
+	one, max := 1, maxButtonRowLength
+	tests := []struct {
+		name         string
+		rowLength    *int
+		rowMinLength *int
+		action       string
+		want         bool
+		wantLength   int
+		wantMin      int
+	}{
+		{"length below 1", &one, &one, settingsRowLengthDown, false, 1, 1},
+		{"length above max", &max, &one, settingsRowLengthUp, false, max, 1},
+		{"min length below 1", &max, &one, settingsRowMinLengthDown, false, max, 1},
+		{"min length above length", &one, &one, settingsRowMinLengthUp, false, 1, 1},
+		{"min length follows length down", &max, &max, settingsRowLengthDown, true, max - 1, max - 1},
+		{"length up", &one, &one, settingsRowLengthUp, true, 2, 1},
+	}
+	for _, tt := range tests {
+		c := chatConfig{ButtonRowLength: tt.rowLength, ButtonRowMinLength: tt.rowMinLength}
+		if got := applySetting(&c, tt.action); got != tt.want {
+			t.Errorf("%s: applied = %v, want %v", tt.name, got, tt.want)
+		}
+		s := c.settings()
+		if s.ButtonRowLength != tt.wantLength || s.ButtonRowMinLength != tt.wantMin {
+			t.Errorf("%s: row length %d, min %d; want %d, %d", tt.name, s.ButtonRowLength, s.ButtonRowMinLength, tt.wantLength, tt.wantMin)
+		}
+	}
+	if c := (chatConfig{}); applySetting(&c, "?") {
+		t.Error("applied an unknown action")
+	}
+}
+
+func TestSettingsCallbackNonAdmin(t *testing.T) {
+	bot, f := newFakeTelegramBot(t)
+	defer f.Close()
+	f.admins = []int{2}
+	chat := &telegram.Chat{ID: -100, Type: telegram.ChatSuperGroup}
+	callback := func(userID int) *telegram.Callback {
+		return &telegram.Callback{
+			ID:      "c",
+			Sender:  &telegram.User{ID: userID},
+			Message: &telegram.Message{ID: 5, Chat: chat},
+			Data:    settingsExclusive,
+		}
+	}
+	bot.handleSettingsCallback(callback(3))
+	if bot.ChatSettings(chat.ID).Exclusive {
+		t.Error("a non-admin changed a setting")
+	}
+	if n := len(f.callsTo("editMessageText")); n != 0 {
+		t.Errorf("a non-admin's callback edited the menu %d times", n)
+	}
+	answers := f.callsTo("answerCallbackQuery")
+	if len(answers) != 1 || answers[0].Params["text"] != "Only chat admins can change the settings." {
+		t.Errorf("answers = %+v, want the admins-only answer", answers)
+	}
+
+	bot.handleSettingsCallback(callback(2))
+	if !bot.ChatSettings(chat.ID).Exclusive {
+		t.Error("an admin could not change a setting")
+	}
+}
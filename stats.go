@@ -0,0 +1,231 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+	emojirx "github.com/sgreben/telegram-emoji-reactions-bot/pkg/reactions"
+)
+
+const bucketStats = "stats"
+
+const statsTopN = 5
+
+// reactionEvent is the log record written for each reaction added (Delta 1) or removed (Delta -1).
+type reactionEvent struct {
+	Time      time.Time `json:"reaction_time"`
+	ChatID    int64     `json:"reaction_chat_id"`
+	MessageID int       `json:"reaction_message_id"`
+	UserID    int       `json:"reaction_user_id"`
+	Emoji     string    `json:"reaction_emoji"`
+	Delta     int       `json:"reaction_delta"`
+}
+
+// statsDay sums up a chat's reactionEvents of one (UTC) day.
+type statsDay struct {
+	Total int `json:"t"`
+	// Emoji, Users and Messages are keyed by emoji, hex user ID and hex message ID (of the message reacted to).
+	Emoji    map[string]int `json:"e,omitempty"`
+	Users    map[string]int `json:"u,omitempty"`
+	Messages map[string]int `json:"m,omitempty"`
+}
+
+func statsKey(chatID int64, day time.Time) string {
+	return fmt.Sprintf("%x:%s", chatID, day.UTC().Format("20060102"))
+}
+
+func (s *statsDay) add(o statsDay) {
+	s.Total += o.Total
+	s.Emoji = addCounts(s.Emoji, o.Emoji)
+	s.Users = addCounts(s.Users, o.Users)
+	s.Messages = addCounts(s.Messages, o.Messages)
+}
+
+func addCounts(m, o map[string]int) map[string]int {
+	if m == nil {
+		m = make(map[string]int, len(o))
+	}
+	for k, n := range o {
+		m[k] += n
+		if m[k] == 0 {
+			delete(m, k)
+		}
+	}
+	return m
+}
+
+// recordReaction logs a reaction event and adds it to the chat's stats.
+func (bot *emojiReactionBot) recordReaction(chatID int64, messageID int, userID int, emoji string, delta int) {
+	event := reactionEvent{
+		Time:      time.Now(),
+		ChatID:    chatID,
+		MessageID: messageID,
+		UserID:    userID,
+		Emoji:     emoji,
+		Delta:     delta,
+	}
+	jsonOut.Encode(event)
+	bot.statsMu.Lock()
+	defer bot.statsMu.Unlock()
+	key := statsKey(chatID, event.Time)
+	var day statsDay
+	bot.storeGet(bucketStats, key, &day)
+	day.add(statsDay{
+		Total:    delta,
+		Emoji:    map[string]int{emoji: delta},
+		Users:    map[string]int{fmt.Sprintf("%x", userID): delta},
+		Messages: map[string]int{fmt.Sprintf("%x", messageID): delta},
+	})
+	bot.storePut(bucketStats, key, day)
+}
+
+// recordReactions records the reactions a user added and removed with AddOrRemove.
+func (bot *emojiReactionBot) recordReactions(chatID int64, messageID int, userID int, result emojirx.Result) {
+	for _, e := range result.Added {
+		bot.recordReaction(chatID, messageID, userID, e, 1)
+	}
+	for _, e := range result.Removed {
+		bot.recordReaction(chatID, messageID, userID, e, -1)
+	}
+}
+
+// chatStats sums up the chat's stats for the given window: "day" (today), "week" (the last 7 days) or "all".
+func (bot *emojiReactionBot) chatStats(chatID int64, window string, now time.Time) (statsDay, error) {
+	var out statsDay
+	switch window {
+	case "day", "week":
+		days := 1
+		if window == "week" {
+			days = 7
+		}
+		for i := 0; i < days; i++ {
+			var day statsDay
+			if bot.storeGet(bucketStats, statsKey(chatID, now.AddDate(0, 0, -i)), &day) {
+				out.add(day)
+			}
+		}
+	case "all":
+		err := bot.Store.ForEach(bucketStats, fmt.Sprintf("%x:", chatID), func(key string, value json.RawMessage) error {
+			var day statsDay
+			if err := json.Unmarshal(value, &day); err != nil {
+				return fmt.Errorf("%s: %v", key, err)
+			}
+			out.add(day)
+			return nil
+		})
+		if err != nil {
+			return out, err
+		}
+	default:
+		return out, fmt.Errorf("unknown window %q", window)
+	}
+	return out, nil
+}
+
+var statsWindowTitles = map[string]string{
+	"day":  "today",
+	"week": "in the last 7 days",
+	"all":  "so far",
+}
+
+// handleStats handles `/stats [day|week|all]`.
+func (bot *emojiReactionBot) handleStats(m *telegram.Message) {
+	window := strings.TrimSpace(m.Payload)
+	if window == "" {
+		window = "week"
+	}
+	if _, ok := statsWindowTitles[window]; !ok {
+		bot.commandReply(m, "Usage: /stats [day|week|all]")
+		return
+	}
+	stats, err := bot.chatStats(m.Chat.ID, window, time.Now())
+	if err != nil {
+		log.Printf("stats %x: %v", m.Chat.ID, err)
+	}
+	reply, err := bot.Reply(m, bot.statsText(m.Chat, window, stats), telegram.Silent, telegram.ModeHTML, telegram.NoPreview)
+	if err != nil {
+		log.Printf("stats %x: reply: %v", m.Chat.ID, err)
+		return
+	}
+	jsonOut.Encode(reply)
+}
+
+func (bot *emojiReactionBot) statsText(chat *telegram.Chat, window string, stats statsDay) string {
+	if stats.Total <= 0 {
+		return fmt.Sprintf("No reactions %s.", statsWindowTitles[window])
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "<b>%d reactions %s</b> from %d people on %d messages\n", stats.Total, statsWindowTitles[window], len(topCounts(stats.Users, 0)), len(topCounts(stats.Messages, 0)))
+	b.WriteString("\nTop emoji: ")
+	for i, c := range topCounts(stats.Emoji, statsTopN) {
+		if i > 0 {
+			b.WriteString(" · ")
+		}
+		fmt.Fprintf(&b, "%s %d", html.EscapeString(c.key), c.n)
+	}
+	b.WriteString("\nTop reactors: ")
+	for i, c := range topCounts(stats.Users, statsTopN) {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		userID, _ := strconv.ParseInt(c.key, 16, 64)
+		fmt.Fprintf(&b, "%s %d", html.EscapeString(bot.UserName(int(userID))), c.n)
+	}
+	b.WriteString("\nMost reacted: ")
+	for i, c := range topCounts(stats.Messages, statsTopN) {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		messageID, _ := strconv.ParseInt(c.key, 16, 64)
+		if link := messageLink(chat, int(messageID)); link != "" {
+			fmt.Fprintf(&b, `<a href="%s">#%d</a> %d`, link, messageID, c.n)
+		} else {
+			fmt.Fprintf(&b, "#%d %d", messageID, c.n)
+		}
+	}
+	return b.String()
+}
+
+type keyCount struct {
+	key string
+	n   int
+}
+
+// topCounts returns the n (or, for n <= 0, all) keys with the highest positive counts, highest first.
+func topCounts(m map[string]int, n int) []keyCount {
+	var out []keyCount
+	for k, c := range m {
+		if c > 0 {
+			out = append(out, keyCount{k, c})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].n != out[j].n {
+			return out[i].n > out[j].n
+		}
+		return out[i].key < out[j].key
+	})
+	if n > 0 && len(out) > n {
+		out = out[:n]
+	}
+	return out
+}
+
+// messageLink returns the t.me link to a message in a public chat or supergroup, or "" if there is none.
+func messageLink(chat *telegram.Chat, messageID int) string {
+	if chat.Username != "" {
+		return fmt.Sprintf("https://t.me/%s/%d", chat.Username, messageID)
+	}
+	const supergroupIDOffset = -1000000000000
+	if chat.ID < supergroupIDOffset {
+		return fmt.Sprintf("https://t.me/c/%d/%d", supergroupIDOffset-chat.ID, messageID)
+	}
+	return ""
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+)
+
+func TestChatStatsWindows(t *testing.T) {
+	const chatID = -100
+	now := time.Date(2020, 6, 15, 12, 0, 0, 0, time.UTC)
+	bot := &emojiReactionBot{Store: newMemoryStore()}
+	for _, d := range []struct {
+		chatID  int64
+		daysAgo int
+		emoji   string
+		n       int
+	}{
+		{chatID, 0, "👍", 1},
+		{chatID, 1, "🔥", 2},
+		{chatID, 6, "😂", 4},
+		{chatID, 7, "🎉", 8},
+		{chatID, 100, "👍", 16},
+		{chatID - 1, 0, "👍", 32},
+	} {
+		day := statsDay{Total: d.n, Emoji: map[string]int{d.emoji: d.n}}
+		bot.storePut(bucketStats, statsKey(d.chatID, now.AddDate(0, 0, -d.daysAgo)), day)
+	}
+	tests := []struct {
+		window    string
+		wantTotal int
+		wantEmoji map[string]int
+	}{
+		{"day", 1, map[string]int{"👍": 1}},
+		{"week", 7, map[string]int{"👍": 1, "🔥": 2, "😂": 4}},
+		{"all", 31, map[string]int{"👍": 17, "🔥": 2, "😂": 4, "🎉": 8}},
+	}
+	for _, tt := range tests {
+		got, err := bot.chatStats(chatID, tt.window, now)
+		if err != nil {
+			t.Errorf("%s: %v", tt.window, err)
+			continue
+		}
+		if got.Total != tt.wantTotal || !reflect.DeepEqual(got.Emoji, tt.wantEmoji) {
+			t.Errorf("%s: stats = %d %v, want %d %v", tt.window, got.Total, got.Emoji, tt.wantTotal, tt.wantEmoji)
+		}
+	}
+	if _, err := bot.chatStats(chatID, "month", now); err == nil {
+		t.Error("chatStats accepted an unknown window")
+	}
+}
+
+func TestTopCounts(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 3, "c": 3, "d": 2, "e": 0, "f": -1}
+	tests := []struct {
+		n    int
+		want []keyCount
+	}{
+		// ties are ranked by key
+		{2, []keyCount{{"b", 3}, {"c", 3}}},
+		{3, []keyCount{{"b", 3}, {"c", 3}, {"d", 2}}},
+		// only positive counts
+		{0, []keyCount{{"b", 3}, {"c", 3}, {"d", 2}, {"a", 1}}},
+		{10, []keyCount{{"b", 3}, {"c", 3}, {"d", 2}, {"a", 1}}},
+	}
+	for _, tt := range tests {
+		if got := topCounts(m, tt.n); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("topCounts(%d) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestMessageLink(t *testing.T) {
+	tests := []struct {
+		chat telegram.Chat
+		want string
+	}{
+		{telegram.Chat{ID: -1001234567890, Username: "group"}, "https://t.me/group/42"},
+		{telegram.Chat{ID: -1001234567890}, "https://t.me/c/1234567890/42"},
+		{telegram.Chat{ID: -1234567890}, ""},
+		{telegram.Chat{ID: 1234567890}, ""},
+	}
+	for _, tt := range tests {
+		if got := messageLink(&tt.chat, 42); got != tt.want {
+			t.Errorf("messageLink(%+v) = %q, want %q", tt.chat, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"container/list"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Store holds the bot's state as JSON values under (bucket, key) pairs.
+type Store interface {
+	Get(bucket, key string, value interface{}) (bool, error)
+	Put(bucket, key string, value interface{}) error
+	Delete(bucket, key string) error
+	// ForEach calls f for each value in the bucket whose key starts with prefix, in no particular order.
+	// It stops at the first error f returns, and returns it.
+	ForEach(bucket, prefix string, f func(key string, value json.RawMessage) error) error
+}
+
+// storeLimit bounds a bucket to MaxEntries values, evicting the least recently used
+// ones first, and evicts values not used for MaxAge. Zero means unbounded.
+type storeLimit struct {
+	MaxEntries int
+	MaxAge     time.Duration
+}
+
+type storeEviction struct {
+	Bucket string
+	Key    string
+	Reason string
+	Total  int64
+}
+
+const (
+	evictionReasonSize = "size"
+	evictionReasonAge  = "age"
+)
+
+type memoryStore struct {
+	buckets map[string]*memoryBucket
+	limits  map[string]storeLimit
+	now     func() time.Time
+	OnEvict func(storeEviction)
+	mu      sync.Mutex
+}
+
+type memoryBucket struct {
+	values  map[string]*list.Element
+	lru     *list.List
+	limit   storeLimit
+	evicted int64
+}
+
+type memoryEntry struct {
+	key  string
+	data []byte
+	used time.Time
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		buckets: make(map[string]*memoryBucket),
+		limits:  make(map[string]storeLimit),
+		now:     time.Now,
+	}
+}
+
+// Limit sets the eviction limits for a bucket. It must be called before the bucket is first written.
+func (s *memoryStore) Limit(bucket string, limit storeLimit) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.limits[bucket] = limit
+}
+
+func (s *memoryStore) Get(bucket, key string, value interface{}) (bool, error) {
+	data, ok, evicted := s.get(bucket, key)
+	s.notifyEvicted(evicted)
+	if !ok {
+		return false, nil
+	}
+	return true, json.Unmarshal(data, value)
+}
+
+func (s *memoryStore) Put(bucket, key string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	s.notifyEvicted(s.put(bucket, key, data, time.Time{}))
+	return nil
+}
+
+func (s *memoryStore) Delete(bucket, key string) error {
+	s.delete(bucket, key)
+	return nil
+}
+
+func (s *memoryStore) ForEach(bucket, prefix string, f func(key string, value json.RawMessage) error) error {
+	for _, e := range s.scan(bucket, prefix) {
+		if err := f(e.key, e.data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scan returns copies of the entries in the bucket whose key starts with prefix.
+// Scanning does not count as using the entries.
+func (s *memoryStore) scan(bucket, prefix string) (out []memoryEntry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.buckets[bucket]
+	if b == nil {
+		return nil
+	}
+	for key, el := range b.values {
+		if strings.HasPrefix(key, prefix) {
+			out = append(out, *el.Value.(*memoryEntry))
+		}
+	}
+	return out
+}
+
+// get returns the value of a key, and the values evicted meanwhile. The caller notifies of the evictions.
+func (s *memoryStore) get(bucket, key string) ([]byte, bool, []storeEviction) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.buckets[bucket]
+	if b == nil {
+		return nil, false, nil
+	}
+	now := s.now()
+	evicted := b.expire(bucket, now)
+	el, ok := b.values[key]
+	if !ok {
+		return nil, false, evicted
+	}
+	e := el.Value.(*memoryEntry)
+	e.used = now
+	b.lru.MoveToFront(el)
+	return e.data, true, evicted
+}
+
+// put sets the value of a key, last used at the given time (zero: now), and returns the values evicted meanwhile.
+// The caller notifies of the evictions.
+func (s *memoryStore) put(bucket, key string, data []byte, used time.Time) []storeEviction {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.buckets[bucket]
+	if b == nil {
+		b = &memoryBucket{
+			values: make(map[string]*list.Element),
+			lru:    list.New(),
+			limit:  s.limits[bucket],
+		}
+		s.buckets[bucket] = b
+	}
+	now := s.now()
+	if used.IsZero() {
+		used = now
+	}
+	if el, ok := b.values[key]; ok {
+		e := el.Value.(*memoryEntry)
+		e.data = data
+		e.used = used
+		b.lru.MoveToFront(el)
+	} else {
+		b.values[key] = b.lru.PushFront(&memoryEntry{key: key, data: data, used: used})
+	}
+	return append(b.expire(bucket, now), b.shrink(bucket)...)
+}
+
+func (s *memoryStore) delete(bucket, key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.buckets[bucket]
+	if b == nil {
+		return
+	}
+	if el, ok := b.values[key]; ok {
+		b.lru.Remove(el)
+		delete(b.values, key)
+	}
+}
+
+// len returns the number of values in the store.
+func (s *memoryStore) len() (n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, b := range s.buckets {
+		n += b.lru.Len()
+	}
+	return n
+}
+
+func (s *memoryStore) notifyEvicted(evicted []storeEviction) {
+	if s.OnEvict == nil {
+		return
+	}
+	for _, e := range evicted {
+		s.OnEvict(e)
+	}
+}
+
+func (b *memoryBucket) expire(bucket string, now time.Time) (out []storeEviction) {
+	if b.limit.MaxAge <= 0 {
+		return nil
+	}
+	for el := b.lru.Back(); el != nil && now.Sub(el.Value.(*memoryEntry).used) > b.limit.MaxAge; el = b.lru.Back() {
+		out = append(out, b.evict(bucket, el, evictionReasonAge))
+	}
+	return out
+}
+
+func (b *memoryBucket) shrink(bucket string) (out []storeEviction) {
+	if b.limit.MaxEntries <= 0 {
+		return nil
+	}
+	for b.lru.Len() > b.limit.MaxEntries {
+		out = append(out, b.evict(bucket, b.lru.Back(), evictionReasonSize))
+	}
+	return out
+}
+
+func (b *memoryBucket) evict(bucket string, el *list.Element, reason string) storeEviction {
+	e := el.Value.(*memoryEntry)
+	b.lru.Remove(el)
+	delete(b.values, e.key)
+	b.evicted++
+	return storeEviction{
+		Bucket: bucket,
+		Key:    e.key,
+		Reason: reason,
+		Total:  b.evicted,
+	}
+}
+
+const bucketSpill = "spill"
+
+// storeSpill keeps reaction state that does not fit into its message.
+type storeSpill struct {
+	Store Store
+}
+
+func (s storeSpill) Put(ref string, data []byte) error {
+	return s.Store.Put(bucketSpill, ref, data)
+}
+
+func (s storeSpill) Get(ref string) ([]byte, error) {
+	var data []byte
+	ok, err := s.Store.Get(bucketSpill, ref, &data)
+	if err == nil && !ok {
+		err = fmt.Errorf("not found")
+	}
+	return data, err
+}
@@ -0,0 +1,232 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// fileStore is a memoryStore backed by an append-only log of JSON records, one per line.
+// Values evicted from memory are logged as deleted. The log is compacted when it is opened,
+// and when it has grown to compactionFactor times the number of values in memory.
+type fileStore struct {
+	*memoryStore
+	path string
+	file *os.File
+	enc  *json.Encoder
+	// records is the number of records in the log.
+	records int
+	mu      sync.Mutex
+}
+
+const (
+	compactionFactor = 4
+	// compactionMinRecords keeps small logs from being compacted all the time.
+	compactionMinRecords = 1000
+)
+
+type fileStoreRecord struct {
+	Bucket  string          `json:"b"`
+	Key     string          `json:"k"`
+	Value   json.RawMessage `json:"v,omitempty"`
+	Deleted bool            `json:"d,omitempty"`
+	// Used is when the value was put, or last used before the log was compacted, in Unix seconds.
+	// Later uses are not logged, so a value may expire (see storeLimit) a little early after a restart.
+	Used int64 `json:"u,omitempty"`
+}
+
+func (r fileStoreRecord) used() time.Time {
+	if r.Used == 0 {
+		return time.Time{}
+	}
+	return time.Unix(r.Used, 0)
+}
+
+func openFileStore(path string, memoryStore *memoryStore) (*fileStore, error) {
+	s := &fileStore{
+		memoryStore: memoryStore,
+		path:        path,
+	}
+	if err := s.load(); err != nil {
+		return nil, fmt.Errorf("open store %s: %v", path, err)
+	}
+	if err := s.compact(); err != nil {
+		return nil, fmt.Errorf("open store %s: %v", path, err)
+	}
+	return s, nil
+}
+
+// load reads the log into memory. A broken final record is the torn write of a crash, and is skipped
+// (compaction drops it from the log); a broken record before it fails the load.
+func (s *fileStore) load() error {
+	f, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+	for line := 1; ; line++ {
+		data, err := r.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if len(bytes.TrimSpace(data)) > 0 {
+			var record fileStoreRecord
+			if decodeErr := json.Unmarshal(data, &record); decodeErr != nil {
+				if _, peekErr := r.Peek(1); peekErr == io.EOF {
+					log.Printf("store %s: line %d: skipping torn final record: %v", s.path, line, decodeErr)
+					return nil
+				}
+				return fmt.Errorf("line %d: %v", line, decodeErr)
+			}
+			if record.Deleted {
+				s.memoryStore.delete(record.Bucket, record.Key)
+			} else {
+				// values that expire or overflow while loading are dropped by the compaction that follows
+				s.memoryStore.put(record.Bucket, record.Key, record.Value, record.used())
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+func (s *fileStore) compact() error {
+	tmpPath := s.path + ".tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(tmp)
+	enc := json.NewEncoder(w)
+	records := 0
+	s.memoryStore.mu.Lock()
+	for bucket, b := range s.memoryStore.buckets {
+		// oldest first, so that loading the log restores the LRU order
+		for el := b.lru.Back(); el != nil; el = el.Prev() {
+			e := el.Value.(*memoryEntry)
+			if err := enc.Encode(fileStoreRecord{Bucket: bucket, Key: e.key, Value: e.data, Used: e.used.Unix()}); err != nil {
+				s.memoryStore.mu.Unlock()
+				tmp.Close()
+				return err
+			}
+			records++
+		}
+	}
+	s.memoryStore.mu.Unlock()
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		return err
+	}
+	// the new log must be on disk before it replaces the old one
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		return err
+	}
+	if err := syncDir(filepath.Dir(s.path)); err != nil {
+		return err
+	}
+	if s.file != nil {
+		s.file.Close()
+	}
+	s.file, err = os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	s.enc = json.NewEncoder(s.file)
+	s.records = records
+	return nil
+}
+
+// syncDir syncs a directory, so that a rename in it survives a crash.
+func syncDir(path string) error {
+	dir, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
+
+// appended counts a record appended to the log, and compacts the log once it is mostly
+// overwritten and deleted values. It must be called with s.mu held.
+func (s *fileStore) appended() {
+	s.records++
+	if s.records < compactionMinRecords || s.records < compactionFactor*s.memoryStore.len() {
+		return
+	}
+	if err := s.compact(); err != nil {
+		log.Printf("store %s: compact: %v", s.path, err)
+	}
+}
+
+func (s *fileStore) Get(bucket, key string, value interface{}) (bool, error) {
+	s.mu.Lock()
+	data, ok, evicted := s.memoryStore.get(bucket, key)
+	s.logEvicted(evicted)
+	s.mu.Unlock()
+	s.notifyEvicted(evicted)
+	if !ok {
+		return false, nil
+	}
+	return true, json.Unmarshal(data, value)
+}
+
+func (s *fileStore) Put(bucket, key string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	used := s.memoryStore.now()
+	if err := s.enc.Encode(fileStoreRecord{Bucket: bucket, Key: key, Value: data, Used: used.Unix()}); err != nil {
+		s.mu.Unlock()
+		return err
+	}
+	evicted := s.memoryStore.put(bucket, key, data, used)
+	s.appended()
+	s.logEvicted(evicted)
+	s.mu.Unlock()
+	s.notifyEvicted(evicted)
+	return nil
+}
+
+func (s *fileStore) Delete(bucket, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.enc.Encode(fileStoreRecord{Bucket: bucket, Key: key, Deleted: true}); err != nil {
+		return err
+	}
+	s.memoryStore.delete(bucket, key)
+	s.appended()
+	return nil
+}
+
+// logEvicted logs values evicted from memory as deleted, so that they stay evicted after a restart.
+// It must be called with s.mu held.
+func (s *fileStore) logEvicted(evicted []storeEviction) {
+	for _, e := range evicted {
+		if err := s.enc.Encode(fileStoreRecord{Bucket: e.Bucket, Key: e.Key, Deleted: true}); err != nil {
+			log.Printf("store %s: evict %s/%s: %v", s.path, e.Bucket, e.Key, err)
+			return
+		}
+		s.appended()
+	}
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// tempStorePath returns the path of a store file in a new temporary directory, and a func that removes it.
+func tempStorePath(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "store")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return filepath.Join(dir, "store.json"), func() { os.RemoveAll(dir) }
+}
+
+func mustOpenFileStore(t *testing.T, path string) *fileStore {
+	s, err := openFileStore(path, newMemoryStore())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func mustGet(t *testing.T, s Store, bucket, key string) (string, bool) {
+	var value string
+	ok, err := s.Get(bucket, key, &value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return value, ok
+}
+
+func countLines(t *testing.T, path string) int {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes.Count(data, []byte("\n"))
+}
+
+func TestFileStoreReopen(t *testing.T) {
+	path, remove := tempStorePath(t)
+	defer remove()
+	s := mustOpenFileStore(t, path)
+	for _, kv := range [][2]string{{"a", "1"}, {"b", "2"}, {"a", "3"}} {
+		if err := s.Put("bucket", kv[0], kv[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.Delete("bucket", "b"); err != nil {
+		t.Fatal(err)
+	}
+	s.file.Close()
+
+	s = mustOpenFileStore(t, path)
+	defer s.file.Close()
+	if value, ok := mustGet(t, s, "bucket", "a"); !ok || value != "3" {
+		t.Errorf("a = %q, %v; want %q", value, ok, "3")
+	}
+	if _, ok := mustGet(t, s, "bucket", "b"); ok {
+		t.Error("b was deleted, but is in the reopened store")
+	}
+	if n := countLines(t, path); n != 1 {
+		t.Errorf("log has %d records after reopening, want 1", n)
+	}
+}
+
+func TestFileStoreTornTail(t *testing.T) {
+	path, remove := tempStorePath(t)
+	defer remove()
+	log := `{"b":"bucket","k":"a","v":"1"}` + "\n" + `{"b":"bucket","k":"b","v":"2"}` + "\n" + `{"b":"buck`
+	if err := ioutil.WriteFile(path, []byte(log), 0600); err != nil {
+		t.Fatal(err)
+	}
+	s := mustOpenFileStore(t, path)
+	defer s.file.Close()
+	for key, want := range map[string]string{"a": "1", "b": "2"} {
+		if value, ok := mustGet(t, s, "bucket", key); !ok || value != want {
+			t.Errorf("%s = %q, %v; want %q", key, value, ok, want)
+		}
+	}
+	if n := countLines(t, path); n != 2 {
+		t.Errorf("log has %d records after reopening, want 2", n)
+	}
+}
+
+func TestFileStoreCorruptRecord(t *testing.T) {
+	path, remove := tempStorePath(t)
+	defer remove()
+	log := `{"b":"bucket","k":"a","v":"1"}` + "\n" + `{"b":"buck` + "\n" + `{"b":"bucket","k":"b","v":"2"}` + "\n"
+	if err := ioutil.WriteFile(path, []byte(log), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := openFileStore(path, newMemoryStore()); err == nil {
+		t.Fatal("opened a store with a corrupt record before its last one")
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != log {
+		t.Errorf("a failed open changed the log to %q", data)
+	}
+}
+
+func TestFileStoreCompaction(t *testing.T) {
+	path, remove := tempStorePath(t)
+	defer remove()
+	s := mustOpenFileStore(t, path)
+	const keys = 10
+	for i := 0; i < 10*compactionMinRecords; i++ {
+		if err := s.Put("bucket", string(rune('a'+i%keys)), i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := countLines(t, path); n > compactionMinRecords {
+		t.Errorf("log has %d records for %d values, want at most %d", n, keys, compactionMinRecords)
+	}
+	s.file.Close()
+
+	s = mustOpenFileStore(t, path)
+	defer s.file.Close()
+	var value int
+	if ok, err := s.Get("bucket", "a", &value); err != nil || !ok || value != 10*compactionMinRecords-keys {
+		t.Errorf("a = %d, %v, %v after compaction; want %d", value, ok, err, 10*compactionMinRecords-keys)
+	}
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+// fakeClock is a store clock that only moves when told to.
+type fakeClock struct{ t time.Time }
+
+func (c *fakeClock) now() time.Time { return c.t }
+
+func (c *fakeClock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+func newTestMemoryStore(limit storeLimit) (*memoryStore, *fakeClock, *[]storeEviction) {
+	clock := &fakeClock{t: time.Unix(1600000000, 0)}
+	evicted := &[]storeEviction{}
+	s := newMemoryStore()
+	s.now = clock.now
+	s.OnEvict = func(e storeEviction) { *evicted = append(*evicted, e) }
+	s.Limit("bucket", limit)
+	return s, clock, evicted
+}
+
+func keys(s *memoryStore, bucket string) []string {
+	var out []string
+	for _, e := range s.scan(bucket, "") {
+		out = append(out, e.key)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func TestMemoryStoreMaxEntries(t *testing.T) {
+	s, _, evicted := newTestMemoryStore(storeLimit{MaxEntries: 2})
+	s.Put("bucket", "a", 1)
+	s.Put("bucket", "b", 2)
+	s.Put("bucket", "c", 3)
+	if got, want := keys(s, "bucket"), []string{"b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("keys = %v, want %v", got, want)
+	}
+	want := []storeEviction{{Bucket: "bucket", Key: "a", Reason: evictionReasonSize, Total: 1}}
+	if !reflect.DeepEqual(*evicted, want) {
+		t.Errorf("evicted = %+v, want %+v", *evicted, want)
+	}
+}
+
+func TestMemoryStoreLRUOrder(t *testing.T) {
+	s, _, evicted := newTestMemoryStore(storeLimit{MaxEntries: 2})
+	s.Put("bucket", "a", 1)
+	s.Put("bucket", "b", 2)
+	var value int
+	if ok, _ := s.Get("bucket", "a", &value); !ok {
+		t.Fatal("a not found")
+	}
+	s.Put("bucket", "c", 3)
+	s.Put("bucket", "a", 4)
+	s.Put("bucket", "d", 5)
+	if got, want := keys(s, "bucket"), []string{"a", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("keys = %v, want %v", got, want)
+	}
+	var got []string
+	for _, e := range *evicted {
+		got = append(got, e.Key)
+	}
+	if want := []string{"b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("evicted %v, want %v", got, want)
+	}
+}
+
+func TestMemoryStoreMaxAge(t *testing.T) {
+	s, clock, evicted := newTestMemoryStore(storeLimit{MaxAge: time.Hour})
+	s.Put("bucket", "a", 1)
+	s.Put("bucket", "b", 2)
+	clock.advance(40 * time.Minute)
+	var value int
+	if ok, _ := s.Get("bucket", "a", &value); !ok {
+		t.Fatal("a expired early")
+	}
+	clock.advance(40 * time.Minute)
+	if ok, _ := s.Get("bucket", "b", &value); ok {
+		t.Error("b was not used for 80m, but did not expire")
+	}
+	if ok, _ := s.Get("bucket", "a", &value); !ok {
+		t.Error("a was used 40m ago, but expired")
+	}
+	want := []storeEviction{{Bucket: "bucket", Key: "b", Reason: evictionReasonAge, Total: 1}}
+	if !reflect.DeepEqual(*evicted, want) {
+		t.Errorf("evicted = %+v, want %+v", *evicted, want)
+	}
+}
+
+func TestMemoryStoreEvictionCounters(t *testing.T) {
+	s, clock, evicted := newTestMemoryStore(storeLimit{MaxEntries: 1, MaxAge: time.Hour})
+	s.Put("other", "x", 0)
+	s.Put("bucket", "a", 1)
+	s.Put("bucket", "b", 2)
+	clock.advance(2 * time.Hour)
+	s.Put("bucket", "c", 3)
+	var got []int64
+	for _, e := range *evicted {
+		got = append(got, e.Total)
+	}
+	if want := []int64{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("eviction totals = %v, want %v", got, want)
+	}
+	if got, want := keys(s, "other"), []string{"x"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unlimited bucket has keys %v, want %v", got, want)
+	}
+}
+
+func TestFileStoreKeepsEvictions(t *testing.T) {
+	path, remove := tempStorePath(t)
+	defer remove()
+	clock := &fakeClock{t: time.Unix(1600000000, 0)}
+	open := func(limit storeLimit) *fileStore {
+		m := newMemoryStore()
+		m.now = clock.now
+		m.Limit("bucket", limit)
+		s, err := openFileStore(path, m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	s := open(storeLimit{MaxEntries: 2})
+	s.Put("bucket", "a", 1)
+	s.Put("bucket", "b", 2)
+	s.Put("bucket", "c", 3)
+	clock.advance(40 * time.Minute)
+	s.Put("bucket", "d", 4)
+	s.file.Close()
+
+	s = open(storeLimit{})
+	if got, want := keys(s.memoryStore, "bucket"), []string{"c", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("keys after restart = %v, want %v", got, want)
+	}
+	s.file.Close()
+
+	clock.advance(40 * time.Minute)
+	s = open(storeLimit{MaxAge: time.Hour})
+	defer s.file.Close()
+	if got, want := keys(s.memoryStore, "bucket"), []string{"d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("keys after restart 80m later = %v, want %v", got, want)
+	}
+}
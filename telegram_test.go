@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"sync"
+	"testing"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+)
+
+// fakeTelegram is a Bot API server that records the calls made to it, and answers them
+// with just enough to keep the bot going.
+type fakeTelegram struct {
+	*httptest.Server
+	mu    sync.Mutex
+	calls []fakeCall
+	// fail makes calls of the method fail, with the given description.
+	fail map[string]string
+	// admins are the user IDs getChatAdministrators returns.
+	admins    []int
+	messageID int
+}
+
+// fakeCall is a call to a fakeTelegram, with its parameters as strings.
+type fakeCall struct {
+	Method string
+	Params map[string]string
+}
+
+const fakeBotID = 1
+
+// newFakeTelegramBot returns a bot talking to a new fakeTelegram.
+func newFakeTelegramBot(t *testing.T) (*emojiReactionBot, *fakeTelegram) {
+	jsonOut = json.NewEncoder(ioutil.Discard)
+	f := &fakeTelegram{fail: make(map[string]string), messageID: 1000}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serve))
+	api, err := telegram.NewBot(telegram.Settings{URL: f.URL, Token: "token"})
+	if err != nil {
+		f.Close()
+		t.Fatal(err)
+	}
+	return &emojiReactionBot{Bot: api, Store: newMemoryStore()}, f
+}
+
+func (f *fakeTelegram) serve(w http.ResponseWriter, r *http.Request) {
+	var raw map[string]interface{}
+	json.NewDecoder(r.Body).Decode(&raw)
+	call := fakeCall{Method: path.Base(r.URL.Path), Params: make(map[string]string)}
+	for k, v := range raw {
+		if s, ok := v.(string); ok {
+			call.Params[k] = s
+			continue
+		}
+		data, _ := json.Marshal(v)
+		call.Params[k] = string(data)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if call.Method != "getMe" {
+		f.calls = append(f.calls, call)
+	}
+	if description, ok := f.fail[call.Method]; ok {
+		fmt.Fprintf(w, `{"ok":false,"error_code":400,"description":%q}`, description)
+		return
+	}
+	var result interface{} = true
+	switch call.Method {
+	case "getMe":
+		result = telegram.User{ID: fakeBotID, IsBot: true, Username: "bot"}
+	case "getChatAdministrators":
+		var admins []telegram.ChatMember
+		for _, id := range f.admins {
+			admins = append(admins, telegram.ChatMember{User: &telegram.User{ID: id}, Role: telegram.Administrator})
+		}
+		result = admins
+	case "sendMessage", "forwardMessage", "editMessageText", "editMessageReplyMarkup":
+		if call.Params["inline_message_id"] != "" {
+			break
+		}
+		chatID, _ := strconv.ParseInt(call.Params["chat_id"], 10, 64)
+		id, _ := strconv.Atoi(call.Params["message_id"])
+		if call.Method == "sendMessage" || call.Method == "forwardMessage" {
+			f.messageID++
+			id = f.messageID
+		}
+		result = telegram.Message{
+			ID:     id,
+			Sender: &telegram.User{ID: fakeBotID, IsBot: true},
+			Chat:   &telegram.Chat{ID: chatID},
+			Text:   call.Params["text"],
+		}
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{"ok": true, "result": result})
+}
+
+// callsTo returns the calls of the given method so far.
+func (f *fakeTelegram) callsTo(method string) []fakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out []fakeCall
+	for _, c := range f.calls {
+		if c.Method == method {
+			out = append(out, c)
+		}
+	}
+	return out
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"unicode/utf8"
+
+	telegram "github.com/sgreben/telegram-emoji-reactions-bot/internal/telebot.v2"
+	emojirx "github.com/sgreben/telegram-emoji-reactions-bot/pkg/reactions"
+)
+
+const bucketUser = "user"
+
+// maxAlertLength is Telegram's limit for the text of a callback alert, in characters.
+const maxAlertLength = 200
+
+// directoryUser is what the bot remembers about a user it has seen.
+type directoryUser struct {
+	Name string `json:"n"`
+}
+
+func displayName(u *telegram.User) string {
+	if u.Username != "" {
+		return fmt.Sprintf("@%s", u.Username)
+	}
+	return strings.TrimSpace(fmt.Sprintf("%s %s", u.FirstName, u.LastName))
+}
+
+// UserWrite remembers the user's display name, if it changed.
+func (bot *emojiReactionBot) UserWrite(u *telegram.User) {
+	if u == nil || u.ID == 0 {
+		return
+	}
+	key := fmt.Sprintf("%x", u.ID)
+	entry := directoryUser{Name: displayName(u)}
+	var known directoryUser
+	if bot.storeGet(bucketUser, key, &known) && known == entry {
+		return
+	}
+	bot.storePut(bucketUser, key, entry)
+}
+
+// UserName returns the display name of a user seen before, or a placeholder.
+func (bot *emojiReactionBot) UserName(userID int) string {
+	var known directoryUser
+	if bot.storeGet(bucketUser, fmt.Sprintf("%x", userID), &known) && known.Name != "" {
+		return known.Name
+	}
+	return fmt.Sprintf("user %d", userID)
+}
+
+// handleWho answers the who-reacted button with an alert listing the reactors of each emoji.
+func (bot *emojiReactionBot) handleWho(c *telegram.Callback) {
+	jsonOut.Encode(c)
+	bot.UserWrite(c.Sender)
+	text := "No reactions yet."
+	if c.Message != nil || c.MessageID != "" {
+		reactions := bot.newReactionSet(bot.ChatSettings(callbackChatID(c)))
+		if _, err := bot.parseReactions(c, reactions); err != nil {
+			log.Printf("who %v: %v", c.ID, err)
+		}
+		if who := bot.whoReacted(reactions); who != "" {
+			text = who
+		}
+	}
+	if err := bot.Respond(c, &telegram.CallbackResponse{Text: text, ShowAlert: true}); err != nil {
+		log.Printf("who %v: respond: %v", c.ID, err)
+	}
+}
+
+// whoReacted lists the users who reacted with each emoji of the set, one line per emoji,
+// e.g. "👍 3: @alice, Bob (👍🏽), 1 more". Users dropped from the state are counted as "more".
+func (bot *emojiReactionBot) whoReacted(reactions *emojirx.Set) string {
+	var lines []string
+	for _, r := range reactions.Slice {
+		var names []string
+		for _, userID := range reactions.Users(r.Emoji) {
+			name := bot.UserName(userID)
+			if variant, ok := reactions.Variant(userID, r.Emoji); ok && variant != r.Emoji {
+				name = fmt.Sprintf("%s (%s)", name, variant)
+			}
+			names = append(names, name)
+		}
+		if more := int(r.Count) - len(names); more > 0 {
+			names = append(names, fmt.Sprintf("%d more", more))
+		}
+		lines = append(lines, fmt.Sprintf("%s %d: %s", r.Emoji, r.Count, strings.Join(names, ", ")))
+	}
+	return truncateRunes(strings.Join(lines, "\n"), maxAlertLength)
+}
+
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n-1]) + "…"
+}